@@ -1,8 +1,39 @@
 package main
 
-import "github.com/go-park-mail-ru/2023_2_OND_team/internal/app/auth"
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/app/auth"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/attempt"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/oidc"
+)
 
 var configAuth = auth.Config{
-	Addr:            "0.0.0.0:8085",
-	RedisFileConfig: "redis.conf",
+	Addr:                       "0.0.0.0:8085",
+	RedisFileConfig:            "redis.conf",
+	SessionIdleTimeout:         7 * 24 * time.Hour,
+	SessionAbsoluteTimeout:     30 * 24 * time.Hour,
+	PasswordResetURL:           "https://pinspire.online/password/reset",
+	EmailVerifyURL:             "https://pinspire.online/email/verify",
+	LoginUsernamePolicy:        attempt.DefaultUsernamePolicy,
+	LoginIPPolicy:              attempt.DefaultIPPolicy,
+	PasswordHashParams:         crypto.DefaultArgon2Params,
+	AccountDeletionGracePeriod: user.DefaultDeletionGracePeriod,
+	AccountPurgeInterval:       time.Hour,
+	OIDCProviders: []oidc.Config{
+		{
+			Name:        "google",
+			Issuer:      "https://accounts.google.com",
+			RedirectURL: "https://pinspire.online/oauth/callback",
+			Scopes:      []string{"email", "profile"},
+		},
+		{
+			Name:        "gitlab",
+			Issuer:      "https://gitlab.com",
+			RedirectURL: "https://pinspire.online/oauth/callback",
+			Scopes:      []string{"email", "profile"},
+		},
+	},
 }
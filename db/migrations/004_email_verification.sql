@@ -0,0 +1,3 @@
+SET search_path TO pinspire;
+
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS email_verified_at timestamptz;
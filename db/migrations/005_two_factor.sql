@@ -0,0 +1,12 @@
+SET search_path TO pinspire;
+
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS totp_secret text;
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS totp_enabled_at timestamptz;
+
+CREATE TABLE IF NOT EXISTS totp_recovery_code (
+	user_id int NOT NULL,
+	code_hash text NOT NULL,
+	created_at timestamptz NOT NULL DEFAULT now(),
+	PRIMARY KEY (user_id, code_hash),
+	FOREIGN KEY (user_id) REFERENCES profile (id) ON DELETE CASCADE
+);
@@ -0,0 +1,7 @@
+SET search_path TO pinspire;
+
+CREATE INDEX IF NOT EXISTS profile_deleted_at_index
+ON profile USING btree (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS comment_author_index
+ON comment USING btree (author);
@@ -0,0 +1,16 @@
+SET search_path TO pinspire;
+
+CREATE TABLE IF NOT EXISTS data_export (
+	id text PRIMARY KEY,
+	user_id int NOT NULL,
+	status text NOT NULL DEFAULT 'pending',
+	created_at timestamptz NOT NULL DEFAULT now(),
+	finished_at timestamptz,
+	FOREIGN KEY (user_id) REFERENCES profile (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS data_export_user_index
+ON data_export USING btree (user_id, created_at);
+
+CREATE INDEX IF NOT EXISTS like_pin_user_index
+ON like_pin USING btree (user_id);
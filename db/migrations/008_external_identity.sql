@@ -0,0 +1,12 @@
+SET search_path TO pinspire;
+
+CREATE TABLE IF NOT EXISTS external_identity (
+	provider text NOT NULL,
+	subject text NOT NULL,
+	user_id int NOT NULL,
+	email text,
+	created_at timestamptz NOT NULL DEFAULT now(),
+	PRIMARY KEY (provider, subject),
+	CONSTRAINT external_identity_user_provider_uniq UNIQUE (user_id, provider),
+	FOREIGN KEY (user_id) REFERENCES profile (id) ON DELETE CASCADE
+);
@@ -0,0 +1,17 @@
+SET search_path TO pinspire;
+
+CREATE TABLE IF NOT EXISTS api_token (
+	id serial PRIMARY KEY,
+	user_id int NOT NULL,
+	name text NOT NULL,
+	token_hash text NOT NULL,
+	scopes text[] NOT NULL,
+	created_at timestamptz NOT NULL DEFAULT now(),
+	last_used_at timestamptz,
+	expires_at timestamptz,
+	CONSTRAINT api_token_hash_uniq UNIQUE (token_hash),
+	FOREIGN KEY (user_id) REFERENCES profile (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS api_token_user_index
+ON api_token USING btree (user_id);
@@ -0,0 +1,44 @@
+SET search_path TO pinspire;
+
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS role text NOT NULL DEFAULT 'user';
+ALTER TABLE profile DROP CONSTRAINT IF EXISTS profile_role_check;
+ALTER TABLE profile ADD CONSTRAINT profile_role_check CHECK (role IN ('user', 'moderator', 'admin'));
+
+-- the suspension without the end lasts until it is lifted by an admin
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS suspended_at timestamptz;
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS suspended_until timestamptz;
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS suspension_reason text;
+
+-- the hidden content is also marked as deleted, so it disappears everywhere
+-- the deleted content does, hidden_at tells it apart to show it again
+ALTER TABLE pin ADD COLUMN IF NOT EXISTS hidden_at timestamptz;
+ALTER TABLE board ADD COLUMN IF NOT EXISTS hidden_at timestamptz;
+ALTER TABLE comment ADD COLUMN IF NOT EXISTS hidden_at timestamptz;
+
+CREATE TABLE IF NOT EXISTS report (
+	id serial PRIMARY KEY,
+	reporter_id int NOT NULL,
+	target_type text NOT NULL,
+	target_id int NOT NULL,
+	reason text NOT NULL,
+	created_at timestamptz NOT NULL DEFAULT now(),
+	resolved_at timestamptz,
+	resolved_by int,
+	CONSTRAINT report_target_type_check CHECK (target_type IN ('user', 'pin', 'board', 'comment')),
+	FOREIGN KEY (reporter_id) REFERENCES profile (id) ON DELETE CASCADE,
+	FOREIGN KEY (resolved_by) REFERENCES profile (id) ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS report_open_uniq
+ON report USING btree (reporter_id, target_type, target_id) WHERE resolved_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS audit_log (
+	id serial PRIMARY KEY,
+	actor_id int,
+	action text NOT NULL,
+	target_type text NOT NULL,
+	target_id int NOT NULL,
+	details text NOT NULL DEFAULT '',
+	created_at timestamptz NOT NULL DEFAULT now(),
+	FOREIGN KEY (actor_id) REFERENCES profile (id) ON DELETE SET NULL
+);
@@ -0,0 +1,15 @@
+SET search_path TO pinspire;
+
+CREATE TABLE IF NOT EXISTS user_block (
+	blocker_id int NOT NULL,
+	blocked_id int NOT NULL,
+	created_at timestamptz NOT NULL DEFAULT now(),
+	PRIMARY KEY (blocker_id, blocked_id),
+	CONSTRAINT user_block_self_check CHECK (blocker_id <> blocked_id),
+	FOREIGN KEY (blocker_id) REFERENCES profile (id) ON DELETE CASCADE,
+	FOREIGN KEY (blocked_id) REFERENCES profile (id) ON DELETE CASCADE
+);
+
+-- the block is checked in both directions
+CREATE INDEX IF NOT EXISTS user_block_blocked_id
+ON user_block USING btree (blocked_id, blocker_id);
@@ -0,0 +1,22 @@
+SET search_path TO pinspire;
+
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS private bool NOT NULL DEFAULT FALSE;
+
+-- the subscription to a private profile starts with the request approved or denied by the profile owner
+CREATE TABLE IF NOT EXISTS subscription_request (
+	id serial PRIMARY KEY,
+	who int NOT NULL,
+	whom int NOT NULL,
+	status text NOT NULL DEFAULT 'pending',
+	created_at timestamptz NOT NULL DEFAULT now(),
+	responded_at timestamptz,
+	CONSTRAINT subscription_request_status_check CHECK (status IN ('pending', 'approved', 'denied')),
+	FOREIGN KEY (who) REFERENCES profile (id) ON DELETE CASCADE,
+	FOREIGN KEY (whom) REFERENCES profile (id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS subscription_request_pending_uniq
+ON subscription_request USING btree (who, whom) WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS subscription_request_whom
+ON subscription_request USING btree (whom, id) WHERE status = 'pending';
@@ -0,0 +1,6 @@
+SET search_path TO pinspire;
+
+-- the primary key (whom, who) serves the followers of the user,
+-- this one serves the subscriptions of the user and the mutual follow checks
+CREATE INDEX IF NOT EXISTS subscription_user_who
+ON subscription_user USING btree (who, whom);
@@ -0,0 +1,6 @@
+SET search_path TO pinspire;
+
+-- the primary key (board_id, user_id) serves the subscribers of the board,
+-- this one serves the boards the user follows in the feed
+CREATE INDEX IF NOT EXISTS subscription_board_user
+ON subscription_board USING btree (user_id, board_id);
@@ -0,0 +1,8 @@
+SET search_path TO pinspire;
+
+-- the tags of the pins the user liked define the interests of the user in the following feed
+CREATE INDEX IF NOT EXISTS like_pin_user_pin_index
+ON like_pin USING btree (user_id, pin_id);
+
+CREATE INDEX IF NOT EXISTS pin_tag_tag_index
+ON pin_tag USING btree (tag_id, pin_id);
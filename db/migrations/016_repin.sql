@@ -0,0 +1,12 @@
+SET search_path TO pinspire;
+
+-- the repin keeps the pin it was saved from, so the chain of saves leads to the original pin and its author
+ALTER TABLE pin ADD COLUMN IF NOT EXISTS source_pin_id int;
+ALTER TABLE pin ADD COLUMN IF NOT EXISTS save_count int NOT NULL DEFAULT 0;
+
+ALTER TABLE pin DROP CONSTRAINT IF EXISTS pin_source_pin_id_fkey;
+ALTER TABLE pin ADD CONSTRAINT pin_source_pin_id_fkey
+FOREIGN KEY (source_pin_id) REFERENCES pin (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS pin_source_pin_index
+ON pin USING btree (source_pin_id);
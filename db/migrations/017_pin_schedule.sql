@@ -0,0 +1,9 @@
+SET search_path TO pinspire;
+
+-- the pin is published when it is neither a draft nor waiting for its publish_at time,
+-- the scheduler resets publish_at once the time has come
+ALTER TABLE pin ADD COLUMN IF NOT EXISTS draft bool NOT NULL DEFAULT false;
+ALTER TABLE pin ADD COLUMN IF NOT EXISTS publish_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS pin_publish_at_index
+ON pin USING btree (publish_at) WHERE publish_at IS NOT NULL AND NOT draft AND deleted_at IS NULL;
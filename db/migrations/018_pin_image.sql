@@ -0,0 +1,16 @@
+SET search_path TO pinspire;
+
+-- the ordered images of the pin, the first one is also kept in pin.picture as the cover for the feeds
+CREATE TABLE IF NOT EXISTS pin_image (
+	pin_id int NOT NULL,
+	position smallint NOT NULL,
+	picture text NOT NULL,
+	created_at timestamptz NOT NULL DEFAULT now(),
+	PRIMARY KEY (pin_id, position),
+	FOREIGN KEY (pin_id) REFERENCES pin (id) ON DELETE CASCADE,
+	CONSTRAINT pin_image_position_range CHECK (position >= 0 AND position < 10)
+);
+
+INSERT INTO pin_image (pin_id, position, picture)
+SELECT id, 0, picture FROM pin
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,15 @@
+SET search_path TO pinspire;
+
+ALTER TABLE pin ADD COLUMN IF NOT EXISTS source_url text;
+
+-- the preview of the page by the source url of the pin, it is shown only while
+-- its url matches the current source url of the pin
+CREATE TABLE IF NOT EXISTS link_preview (
+	pin_id int PRIMARY KEY,
+	url text NOT NULL,
+	title text NOT NULL DEFAULT '',
+	description text NOT NULL DEFAULT '',
+	image text NOT NULL DEFAULT '',
+	fetched_at timestamptz NOT NULL DEFAULT now(),
+	FOREIGN KEY (pin_id) REFERENCES pin (id) ON DELETE CASCADE
+);
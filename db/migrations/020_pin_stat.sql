@@ -0,0 +1,16 @@
+SET search_path TO pinspire;
+
+-- the daily aggregates of the events of the pin, the day is in UTC
+CREATE TABLE IF NOT EXISTS pin_stat_daily (
+	pin_id int NOT NULL,
+	day date NOT NULL,
+	impressions int NOT NULL DEFAULT 0,
+	closeups int NOT NULL DEFAULT 0,
+	likes int NOT NULL DEFAULT 0,
+	saves int NOT NULL DEFAULT 0,
+	clicks int NOT NULL DEFAULT 0,
+	PRIMARY KEY (pin_id, day),
+	FOREIGN KEY (pin_id) REFERENCES pin (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS pin_stat_daily_day_index ON pin_stat_daily USING btree (day);
@@ -0,0 +1,24 @@
+SET search_path TO pinspire;
+
+-- the number of the followers of the user, the pins of the users with too many followers
+-- are not pushed to the timelines of the following feed, they are pulled on reading
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS follower_count int NOT NULL DEFAULT 0;
+
+UPDATE profile SET follower_count = (SELECT COUNT(*) FROM subscription_user WHERE whom = profile.id);
+
+CREATE OR REPLACE FUNCTION count_profile_followers() RETURNS trigger AS $$
+BEGIN
+	IF TG_OP = 'INSERT' THEN
+		UPDATE profile SET follower_count = follower_count + 1 WHERE id = NEW.whom;
+	ELSE
+		UPDATE profile SET follower_count = follower_count - 1 WHERE id = OLD.whom;
+	END IF;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER count_subscription_user
+	AFTER INSERT OR DELETE
+	ON subscription_user
+	FOR EACH ROW
+EXECUTE PROCEDURE count_profile_followers();
@@ -0,0 +1,5 @@
+SET search_path TO pinspire;
+
+-- the time step of the last accepted totp code, the codes of the same or earlier steps are rejected,
+-- so the intercepted code can not be replayed while it is still valid
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS totp_last_step bigint NOT NULL DEFAULT 0;
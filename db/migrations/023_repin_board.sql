@@ -0,0 +1,14 @@
+SET search_path TO pinspire;
+
+-- the board the repin was saved on, the user saves the same pin on the board only once,
+-- so the repeated saves do not inflate the save counter of the pin
+ALTER TABLE pin ADD COLUMN IF NOT EXISTS repin_board_id int;
+
+ALTER TABLE pin DROP CONSTRAINT IF EXISTS pin_repin_board_id_fkey;
+ALTER TABLE pin ADD CONSTRAINT pin_repin_board_id_fkey
+FOREIGN KEY (repin_board_id) REFERENCES board (id) ON DELETE SET NULL;
+
+-- the repins saved before the column was added have no board and are not checked
+CREATE UNIQUE INDEX IF NOT EXISTS pin_repin_uniq
+ON pin USING btree (author, source_pin_id, repin_board_id)
+WHERE source_pin_id IS NOT NULL AND repin_board_id IS NOT NULL AND deleted_at IS NULL;
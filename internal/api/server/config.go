@@ -3,6 +3,7 @@ package server
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"go.uber.org/config"
 )
@@ -10,11 +11,12 @@ import (
 const ConfigName = "app.server"
 
 type Config struct {
-	Host     string
-	Port     string
-	CertFile string
-	KeyFile  string
-	https    bool
+	Host           string
+	Port           string
+	CertFile       string
+	KeyFile        string
+	TrustedProxies []string
+	https          bool
 }
 
 func NewConfig(filename string) (*Config, error) {
@@ -31,6 +33,16 @@ func NewConfig(filename string) (*Config, error) {
 		KeyFile:  value.Get("keyFile").String(),
 	}
 
+	var trustedProxies []string
+	if err = value.Get("trustedProxies").Populate(&trustedProxies); err != nil {
+		return nil, fmt.Errorf("parse param trustedProxies in server.Config: %w", err)
+	}
+	for _, proxy := range trustedProxies {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			c.TrustedProxies = append(c.TrustedProxies, proxy)
+		}
+	}
+
 	https, err := strconv.ParseBool(value.Get("https").String())
 	if err != nil {
 		return nil, fmt.Errorf("parse param https in server.Config: %w", err)
@@ -11,6 +11,8 @@ import (
 	_ "github.com/go-park-mail-ru/2023_2_OND_team/docs"
 	deliveryHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1"
 	deliveryWS "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/websocket"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/apitoken"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	mw "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/monitoring"
@@ -29,7 +31,8 @@ func New() Router {
 	return Router{chi.NewMux()}
 }
 
-func (r Router) RegisterRoute(handler *deliveryHTTP.HandlerHTTP, wsHandler *deliveryWS.HandlerWebSocket, ac authCase.Usecase, metrics monitoring.Metrics, log *logger.Logger) {
+func (r Router) RegisterRoute(handler *deliveryHTTP.HandlerHTTP, wsHandler *deliveryWS.HandlerWebSocket, ac authCase.Usecase,
+	metrics monitoring.Metrics, log *logger.Logger, trustedProxies []string) {
 	cfgCSRF := security.DefaultCSRFConfig()
 	cfgCSRF.PathToGet = "/api/v1/csrf"
 
@@ -38,11 +41,11 @@ func (r Router) RegisterRoute(handler *deliveryHTTP.HandlerHTTP, wsHandler *deli
 			"https://pinspire.online:1444", "https://pinspire.online:1445", "https://pinspire.online:1446", "https://pinspire.online:8081"},
 		AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodDelete, http.MethodPut},
 		AllowCredentials: true,
-		AllowedHeaders:   []string{"content-type", cfgCSRF.Header},
+		AllowedHeaders:   []string{"content-type", "authorization", cfgCSRF.Header},
 		ExposedHeaders:   []string{cfgCSRF.HeaderSet},
 	})
 
-	r.Mux.Use(mw.SetRequestTimeout(requestTimeout), mw.RequestID(log), mw.Logger(log),
+	r.Mux.Use(mw.RealIP(trustedProxies), mw.SetRequestTimeout(requestTimeout), mw.RequestID(log), mw.Logger(log),
 		monitoring.Monitoring("/metrics", metrics), c.Handler,
 		security.CSRF(cfgCSRF),
 		mw.SetResponseHeaders(map[string]string{
@@ -55,32 +58,93 @@ func (r Router) RegisterRoute(handler *deliveryHTTP.HandlerHTTP, wsHandler *deli
 
 		r.Route("/auth", func(r chi.Router) {
 			r.Post("/login", handler.Login)
+			r.Post("/login/2fa", handler.LoginSecondFactor)
 			r.Post("/signup", handler.Signup)
+			r.Post("/password/reset", handler.RequestPasswordReset)
+			r.Post("/password/reset/confirm", handler.ConfirmPasswordReset)
+			r.Post("/email/verify", handler.ConfirmEmail)
+			r.Post("/account/restore", handler.RestoreAccount)
+			r.Get("/oidc/providers", handler.OIDCProviders)
+			r.Post("/oidc/{provider}/login", handler.BeginOIDCLogin)
+			r.Post("/oidc/callback", handler.CompleteOIDCLogin)
 
 			r.With(auth.RequireAuth).Group(func(r chi.Router) {
 				r.Get("/login", handler.CheckLogin)
 				r.Delete("/logout", handler.Logout)
+				r.Delete("/logout/all", handler.LogoutAll)
+				r.Put("/password", handler.ChangePassword)
+				r.Post("/email/verify/resend", handler.ResendEmailVerification)
+				r.Post("/2fa/enroll", handler.EnrollTOTP)
+				r.Post("/2fa/activate", handler.ActivateTOTP)
+				r.Post("/2fa/disable", handler.DisableTOTP)
+				r.Delete("/account", handler.DeleteAccount)
+				r.Post("/oidc/{provider}/link", handler.BeginOIDCLink)
 			})
 		})
 
-		r.With(auth.RequireAuth).Route("/profile", func(r chi.Router) {
-			r.Get("/info", handler.GetProfileInfo)
-			r.Put("/edit", handler.ProfileEditInfo)
-			r.Put("/avatar", handler.ProfileEditAvatar)
-			r.Get("/header", handler.GetProfileHeaderInfo)
+		r.Route("/profile", func(r chi.Router) {
+			r.With(auth.RequireScope(apitoken.ScopeProfileRead), auth.RequireAuth).Group(func(r chi.Router) {
+				r.Get("/info", handler.GetProfileInfo)
+				r.Get("/header", handler.GetProfileHeaderInfo)
+			})
+			r.With(auth.RequireScope(apitoken.ScopeProfileWrite), auth.RequireAuth).Group(func(r chi.Router) {
+				r.Put("/edit", handler.ProfileEditInfo)
+				r.Put("/avatar", handler.ProfileEditAvatar)
+			})
+
+			r.With(auth.RequireAuth).Group(func(r chi.Router) {
+				r.Get("/sessions", handler.ListSessions)
+				r.Delete("/sessions/{sessionID:[0-9a-f]+}", handler.RevokeSession)
+				r.Post("/export", handler.RequestDataExport)
+				r.Get("/export/{exportID:[0-9a-f]+}", handler.GetDataExport)
+				r.Get("/export/{exportID:[0-9a-f]+}/download", handler.DownloadDataExport)
+				r.Get("/tokens", handler.ListAPITokens)
+				r.Post("/tokens", handler.CreateAPIToken)
+				r.Delete("/tokens/{tokenID:\\d+}", handler.RevokeAPIToken)
+				r.Get("/blocked", handler.GetBlockedUsers)
+				r.Get("/analytics", handler.GetAccountAnalytics)
+			})
 		})
 
 		r.Route("/user", func(r chi.Router) {
 			r.Get("/info/{userID:\\d+}", handler.GetUserInfo)
+
+			r.With(auth.RequireAuth).Group(func(r chi.Router) {
+				r.Put("/{userID:\\d+}/block", handler.BlockUser)
+				r.Delete("/{userID:\\d+}/block", handler.UnblockUser)
+				r.Get("/recommendations", handler.GetRecommendations)
+			})
+		})
+
+		r.With(auth.RequireAuth).Post("/report", handler.ReportContent)
+
+		r.With(auth.RequireAuth, auth.RequireRole(user.RoleModerator)).Route("/admin", func(r chi.Router) {
+			r.Get("/reports", handler.GetReports)
+			r.Put("/reports/{reportID:\\d+}/resolve", handler.ResolveReport)
+			r.Put("/{targetType:pin|board|comment}/{targetID:\\d+}/hide", handler.HideContent)
+			r.Delete("/{targetType:pin|board|comment}/{targetID:\\d+}/hide", handler.UnhideContent)
+
+			r.With(auth.RequireRole(user.RoleAdmin)).Group(func(r chi.Router) {
+				r.Put("/user/{userID:\\d+}/suspend", handler.SuspendUser)
+				r.Delete("/user/{userID:\\d+}/suspend", handler.UnsuspendUser)
+				r.Put("/user/{userID:\\d+}/role", handler.SetUserRole)
+				r.Get("/audit", handler.GetAuditLog)
+			})
 		})
 
 		r.Route("/subscription", func(r chi.Router) {
 			r.Route("/user", func(r chi.Router) {
-				r.With(auth.RequireAuth).Group(func(r chi.Router) {
+				r.With(auth.RequireScope(apitoken.ScopeSubscriptionsWrite), auth.RequireAuth).Group(func(r chi.Router) {
 					r.Post("/create", handler.Subscribe)
 					r.Delete("/delete", handler.Unsubscribe)
+					r.Get("/requests", handler.GetFollowRequests)
+					r.Put("/requests/{requestID:\\d+}/approve", handler.ApproveFollowRequest)
+					r.Delete("/requests/{requestID:\\d+}", handler.DenyFollowRequest)
 				})
 				r.Get("/get", handler.GetSubscriptionInfoForUser)
+				r.Get("/{userID:\\d+}/followers", handler.GetFollowers)
+				r.Get("/{userID:\\d+}/following", handler.GetFollowing)
+				r.With(auth.RequireAuth).Get("/{userID:\\d+}/followers/known", handler.GetKnownFollowers)
 			})
 		})
 
@@ -91,10 +155,14 @@ func (r Router) RegisterRoute(handler *deliveryHTTP.HandlerHTTP, wsHandler *deli
 		})
 
 		r.Route("/pin", func(r chi.Router) {
-			r.Get("/{pinID:\\d+}", handler.ViewPin)
-
-			r.With(auth.RequireAuth).Group(func(r chi.Router) {
-				r.Get("/like/isSet/{pinID:\\d+}", handler.IsSetLikePin)
+			r.With(auth.RequireScope(apitoken.ScopePinsRead)).Get("/{pinID:\\d+}", handler.ViewPin)
+			r.With(auth.RequireScope(apitoken.ScopePinsRead)).Get("/source/{pinID:\\d+}", handler.OpenPinSource)
+			r.With(auth.RequireScope(apitoken.ScopePinsRead), auth.RequireAuth).
+				Get("/analytics/{pinID:\\d+}", handler.GetPinAnalytics)
+
+			r.With(auth.RequireScope(apitoken.ScopePinsRead), auth.RequireAuth).
+				Get("/like/isSet/{pinID:\\d+}", handler.IsSetLikePin)
+			r.With(auth.RequireScope(apitoken.ScopePinsWrite), auth.RequireAuth).Group(func(r chi.Router) {
 				r.Post("/create", handler.CreateNewPin)
 				r.Post("/like/set/{pinID:\\d+}", handler.SetLikePin)
 				r.Put("/edit/{pinID:\\d+}", handler.EditPin)
@@ -105,7 +173,7 @@ func (r Router) RegisterRoute(handler *deliveryHTTP.HandlerHTTP, wsHandler *deli
 			r.Route("/comment", func(r chi.Router) {
 				r.Get("/feed/{pinID:\\d+}", handler.ViewFeedComment)
 
-				r.With(auth.RequireAuth).Group(func(r chi.Router) {
+				r.With(auth.RequireScope(apitoken.ScopeCommentsWrite), auth.RequireAuth).Group(func(r chi.Router) {
 					r.Post("/{pinID:\\d+}", handler.WriteComment)
 					r.Delete("/{commentID:\\d+}", handler.DeleteComment)
 				})
@@ -113,30 +181,37 @@ func (r Router) RegisterRoute(handler *deliveryHTTP.HandlerHTTP, wsHandler *deli
 		})
 
 		r.Route("/board", func(r chi.Router) {
-			r.Route("/get", func(r chi.Router) {
+			r.With(auth.RequireScope(apitoken.ScopeBoardsRead)).Route("/get", func(r chi.Router) {
 				r.Get("/user/{username}", handler.GetUserBoards)
 				r.Get("/{boardID:\\d+}", handler.GetCertainBoard)
 				r.Get("/forUpdate/{boardID:\\d+}", handler.GetBoardInfoForUpdate)
 			})
-			r.With(auth.RequireAuth).Group(func(r chi.Router) {
+			r.With(auth.RequireScope(apitoken.ScopeBoardsWrite), auth.RequireAuth).Group(func(r chi.Router) {
 				r.Post("/add/pins/{boardID:\\d+}", handler.AddPinsToBoard)
+				r.Post("/repin/{boardID:\\d+}", handler.RepinOnBoard)
 				r.Delete("/delete/pin/{boardID:\\d+}", handler.DeletePinFromBoard)
 				r.Post("/create", handler.CreateNewBoard)
 				r.Put("/update/{boardID:\\d+}", handler.UpdateBoardInfo)
 				r.Delete("/delete/{boardID:\\d+}", handler.DeleteBoard)
+				r.Post("/subscribe/{boardID:\\d+}", handler.SubscribeToBoard)
+				r.Delete("/subscribe/{boardID:\\d+}", handler.UnsubscribeFromBoard)
 			})
 		})
 
 		r.Route("/feed", func(r chi.Router) {
-			r.Get("/pin", handler.FeedPins)
+			r.With(auth.RequireScope(apitoken.ScopePinsRead)).Get("/pin", handler.FeedPins)
 		})
 
-		r.With(auth.RequireAuth).Route("/chat", func(r chi.Router) {
-			r.Get("/personal", handler.FeedChats)
-			r.Get("/get/{userID:\\d+}", handler.GetMessagesFromChat)
-			r.Post("/send/{userID:\\d+}", handler.SendMessageToUser)
-			r.Put("/update/{messageID:\\d+}", handler.UpdateMessage)
-			r.Delete("/delete/{messageID:\\d+}", handler.DeleteMessage)
+		r.Route("/chat", func(r chi.Router) {
+			r.With(auth.RequireScope(apitoken.ScopeMessagesRead), auth.RequireAuth).Group(func(r chi.Router) {
+				r.Get("/personal", handler.FeedChats)
+				r.Get("/get/{userID:\\d+}", handler.GetMessagesFromChat)
+			})
+			r.With(auth.RequireScope(apitoken.ScopeMessagesWrite), auth.RequireAuth).Group(func(r chi.Router) {
+				r.Post("/send/{userID:\\d+}", handler.SendMessageToUser)
+				r.Put("/update/{messageID:\\d+}", handler.UpdateMessage)
+				r.Delete("/delete/{messageID:\\d+}", handler.DeleteMessage)
+			})
 		})
 	})
 
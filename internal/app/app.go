@@ -22,35 +22,59 @@ import (
 	deliveryWS "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/websocket"
 	notify "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/notification"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/metrics"
+	boardNotify "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/notification/board"
 	commentNotify "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/notification/comment"
+	followNotify "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/notification/follow"
+	analyticsRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/analytics"
+	blockRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/block"
 	boardRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/board/postgres"
 	commentRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/comment"
+	exportRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/export"
 	imgRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/image"
+	messageRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/message"
+	moderationRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/moderation"
 	pinRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/pin"
+	previewRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/preview"
+	recRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/recommendation"
 	searchRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/search/postgres"
 	subRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/subscription/postgres"
+	timelineRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/timeline"
 	userRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/analytics"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/auth"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/block"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/board"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/comment"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/export"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/image"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/message"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/moderation"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/pin"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/preview"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/realtime"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/realtime/chat"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/realtime/notification"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/recommendation"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/search"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/subscription"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/timeline"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/linkpreview"
 	log "github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 )
 
 var (
 	_timeoutForConnPG     = 5 * time.Second
+	_timeoutForConnRedis  = 5 * time.Second
 	timeoutCloudVisionAPI = 10 * time.Second
+	intervalPublishPins   = time.Minute
+	intervalRemoveExports = time.Hour
 )
 
-const uploadFiles = "upload/"
+const (
+	uploadFiles = "upload/"
+	exportFiles = "export/"
+)
 
 func Run(ctx context.Context, log *log.Logger, cfg ConfigFiles) {
 	metrics := metrics.New("pinspire")
@@ -60,16 +84,29 @@ func Run(ctx context.Context, log *log.Logger, cfg ConfigFiles) {
 		return
 	}
 
-	ctx, cancelCtxPG := context.WithTimeout(ctx, _timeoutForConnPG)
+	ctxPG, cancelCtxPG := context.WithTimeout(ctx, _timeoutForConnPG)
 	defer cancelCtxPG()
 
-	pool, err := NewPoolPG(ctx)
+	pool, err := NewPoolPG(ctxPG)
 	if err != nil {
 		log.Error(err.Error())
 		return
 	}
 	defer pool.Close()
 
+	ctxRedis, cancelCtxRedis := context.WithTimeout(ctx, _timeoutForConnRedis)
+	defer cancelCtxRedis()
+
+	redisCl, err := NewRedisClient(ctxRedis, RedisConfig{
+		Addr:     os.Getenv("REDIS_HOST") + ":" + os.Getenv("REDIS_PORT"),
+		Password: os.Getenv("REDIS_PASSWORD"),
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	defer redisCl.Close()
+
 	connMessMS, err := grpc.Dial(os.Getenv("MESSENGER_SERVICE_HOST")+":"+os.Getenv("MESSENGER_SERVICE_PORT"), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		log.Error(err.Error())
@@ -104,9 +141,19 @@ func Run(ctx context.Context, log *log.Logger, cfg ConfigFiles) {
 		return
 	}
 
-	imgCase := image.New(log, imgRepo.NewImageRepoFS(uploadFiles), image.NewFilter(visionClient))
-	messageCase := message.New(log, messenger.NewMessengerClient(connMessMS), chat.New(realtime.NewRealTimeChatClient(rtClient), log))
-	pinCase := pin.New(log, imgCase, pinRepo.NewPinRepoPG(pool))
+	imgRepository := imgRepo.NewImageRepoFS(uploadFiles)
+	imgCase := image.New(log, imgRepository, image.NewFilter(visionClient))
+	userCase := user.New(log, imgCase, userRepo.NewUserRepoPG(pool))
+	messageCase := message.New(log, messenger.NewMessengerClient(connMessMS), chat.New(realtime.NewRealTimeChatClient(rtClient), log), userCase)
+	pinRepository := pinRepo.NewPinRepoPG(pool)
+	timelineCase := timeline.New(log, timelineRepo.NewTimelineRepoPG(pool), timelineRepo.NewCacheRepo(redisCl), pinRepository)
+	previewCase := preview.New(log, previewRepo.NewPreviewRepoPG(pool), linkpreview.New(), bluemonday.StrictPolicy())
+	analyticsCase := analytics.New(log, analyticsRepo.NewAnalyticsRepoPG(pool))
+	go analyticsCase.Run(ctx)
+	pinCase := pin.New(log, imgCase, pinRepository, userCase, timelineCase, previewCase, analyticsCase)
+	go publishScheduledPins(ctx, log, pinCase, intervalPublishPins)
+	recommendationCase := recommendation.New(log, recRepo.NewRecommendationRepoPG(pool), recRepo.NewCacheRepo(redisCl), bluemonday.UGCPolicy())
+	blockCase := block.New(log, blockRepo.NewBlockRepoPG(pool), userRepo.NewUserRepoPG(pool), recommendationCase, timelineCase, bluemonday.UGCPolicy())
 
 	notifyBuilder, err := notify.NewWithType(notify.NotifyComment)
 	if err != nil {
@@ -114,8 +161,31 @@ func Run(ctx context.Context, log *log.Logger, cfg ConfigFiles) {
 		return
 	}
 
+	followRequestBuilder, err := notify.NewWithType(notify.NotifyFollowRequest)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	followApprovedBuilder, err := notify.NewWithType(notify.NotifyFollowApproved)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	boardPinsBuilder, err := notify.NewWithType(notify.NotifyBoardPins)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	subscriptionRepository := subRepo.NewSubscriptionRepoPG(pool)
+	boardRepository := boardRepo.NewBoardRepoPG(pool)
 	notifyCase := notification.New(realtime.NewRealTimeNotificationClient(rtClient), log,
-		notification.Register(commentNotify.NewCommentNotify(notifyBuilder, comment.New(commentRepository, pinCase, nil), pinCase)))
+		notification.Register(commentNotify.NewCommentNotify(notifyBuilder, comment.New(commentRepository, pinCase, blockCase, nil), pinCase)),
+		notification.Register(followNotify.NewRequestNotify(followRequestBuilder, subscriptionRepository)),
+		notification.Register(followNotify.NewApprovedNotify(followApprovedBuilder, subscriptionRepository)),
+		notification.Register(boardNotify.NewPinsNotify(boardPinsBuilder, boardRepository)))
 
 	conn, err := grpc.Dial(cfg.AddrAuthServer, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
@@ -125,15 +195,32 @@ func Run(ctx context.Context, log *log.Logger, cfg ConfigFiles) {
 	defer conn.Close()
 	ac := auth.New(authProto.NewAuthClient(conn))
 
+	exportCase := export.New(log, exportRepo.NewExportRepoPG(pool), exportRepo.NewArchiveStorageFS(exportFiles), export.Sources{
+		User:         userRepo.NewUserRepoPG(pool),
+		Pin:          pinRepository,
+		Board:        boardRepository,
+		Comment:      commentRepository,
+		Subscription: subscriptionRepository,
+		Message:      messageRepo.NewMessageRepo(pool),
+		Image:        imgRepository,
+	})
+	go removeExpiredExports(ctx, log, exportCase, intervalRemoveExports)
+
 	handler := deliveryHTTP.New(log, deliveryHTTP.UsecaseHub{
 		AuhtCase:         ac,
-		UserCase:         user.New(log, imgCase, userRepo.NewUserRepoPG(pool)),
+		UserCase:         userCase,
 		PinCase:          pinCase,
-		BoardCase:        board.New(log, boardRepo.NewBoardRepoPG(pool), userRepo.NewUserRepoPG(pool), bluemonday.UGCPolicy()),
-		SubscriptionCase: subscription.New(log, subRepo.NewSubscriptionRepoPG(pool), userRepo.NewUserRepoPG(pool), bluemonday.UGCPolicy()),
+		BoardCase:        board.New(log, boardRepository, userRepo.NewUserRepoPG(pool), notifyCase, bluemonday.UGCPolicy(), analyticsCase, timelineCase, userCase),
+		SubscriptionCase: subscription.New(log, subscriptionRepository, userRepo.NewUserRepoPG(pool), blockCase, recommendationCase, timelineCase, notifyCase, bluemonday.UGCPolicy()),
 		SearchCase:       search.New(log, searchRepo.NewSearchRepoPG(pool), bluemonday.UGCPolicy()),
 		MessageCase:      messageCase,
-		CommentCase:      comment.New(commentRepo.NewCommentRepoPG(pool), pinCase, notifyCase),
+		CommentCase:      comment.New(commentRepo.NewCommentRepoPG(pool), pinCase, blockCase, notifyCase),
+		ExportCase:       exportCase,
+		ModerationCase:   moderation.New(log, moderationRepo.NewModerationRepoPG(pool)),
+		BlockCase:        blockCase,
+		AnalyticsCase:    analyticsCase,
+
+		RecommendationCase: recommendationCase,
 	})
 
 	wsHandler := deliveryWS.New(log, messageCase, notifyCase,
@@ -146,10 +233,44 @@ func Run(ctx context.Context, log *log.Logger, cfg ConfigFiles) {
 	}
 	server := server.New(log, cfgServ)
 	router := router.New()
-	router.RegisterRoute(handler, wsHandler, ac, metrics, log)
+	router.RegisterRoute(handler, wsHandler, ac, metrics, log, cfgServ.TrustedProxies)
 
 	if err := server.Run(router.Mux); err != nil {
 		log.Error(err.Error())
 		return
 	}
 }
+
+// publishScheduledPins periodically publishes the pins whose publication time has come.
+func publishScheduledPins(ctx context.Context, log *log.Logger, pinCase pin.Usecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := pinCase.PublishScheduledPins(ctx); err != nil {
+				log.Error(err.Error())
+			}
+		}
+	}
+}
+
+// removeExpiredExports periodically deletes the data exports whose archives can not be downloaded anymore.
+func removeExpiredExports(ctx context.Context, log *log.Logger, exportCase export.Usecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := exportCase.RemoveExpiredExports(ctx); err != nil {
+				log.Error(err.Error())
+			}
+		}
+	}
+}
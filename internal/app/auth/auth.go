@@ -3,7 +3,9 @@ package auth
 import (
 	"context"
 	"net"
+	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -14,16 +16,30 @@ import (
 	authMS "github.com/go-park-mail-ru/2023_2_OND_team/internal/microservices/auth/delivery/grpc"
 	grpcMetrics "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/metrics/grpc"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/grpc/interceptor"
+	apiTokenRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/apitoken"
+	attemptRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/attempt"
+	identityRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/identity"
 	sessRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/session"
+	tokenRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/token"
+	twoFactorRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/twofactor"
 	userRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/apitoken"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/attempt"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/identity"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/password"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/session"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/twofactor"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/verification"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/mail"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/oidc"
 )
 
 var (
 	_timeoutForConnPG    = 5 * time.Second
 	_timeoutForConnRedis = 5 * time.Second
+	_timeoutOIDC         = 10 * time.Second
 )
 
 func Run(ctx context.Context, log *logger.Logger, cfg Config) {
@@ -67,14 +83,39 @@ func Run(ctx context.Context, log *logger.Logger, cfg Config) {
 	}
 	defer redisCl.Close()
 
-	sm := session.New(log, sessRepo.NewSessionRepo(redisCl))
-	u := user.New(log, nil, userRepo.NewUserRepoPG(pool))
+	sm := session.New(log, sessRepo.NewSessionRepo(redisCl),
+		session.IdleTimeout(cfg.SessionIdleTimeout),
+		session.AbsoluteTimeout(cfg.SessionAbsoluteTimeout))
+	var sender mail.Sender = mail.NewLogSender(log)
+	if cfg.MailDir != "" {
+		sender, err = mail.NewFileSender(cfg.MailDir)
+		if err != nil {
+			log.Error(err.Error())
+			return
+		}
+	}
+
+	uRepo := userRepo.NewUserRepoPG(pool)
+	u := user.New(log, nil, uRepo,
+		user.PasswordHashParams(cfg.PasswordHashParams),
+		user.DeletionGracePeriod(cfg.AccountDeletionGracePeriod))
+	go purgeDeletedAccounts(ctx, log, u, cfg.AccountPurgeInterval)
+
+	p := password.New(log, uRepo, tokenRepo.NewTokenRepo(redisCl, "password_reset:"), u, sender, cfg.PasswordResetURL)
 
 	s := grpc.NewServer(grpc.ChainUnaryInterceptor(
 		interceptor.Monitoring(metrics, "0.0.0.0:8086"),
 		interceptor.Logger(log),
 	))
-	authProto.RegisterAuthServer(s, authMS.New(log, sm, u))
+	limiter := attempt.New(log, attemptRepo.NewAttemptRepo(redisCl),
+		attempt.UsernamePolicy(cfg.LoginUsernamePolicy),
+		attempt.IPPolicy(cfg.LoginIPPolicy))
+	v := verification.New(log, uRepo, tokenRepo.NewTokenRepo(redisCl, "email_verification:"), sender, cfg.EmailVerifyURL)
+	tf := twofactor.New(log, twoFactorRepo.NewTwoFactorRepoPG(pool), tokenRepo.NewTokenRepo(redisCl, "2fa_challenge:"))
+	ic := identity.New(log, identityRepo.NewIdentityRepoPG(pool), identityRepo.NewStateRepo(redisCl), uRepo, u,
+		oidcProviders(log, cfg.OIDCProviders))
+	tc := apitoken.New(log, apiTokenRepo.NewAPITokenRepoPG(pool))
+	authProto.RegisterAuthServer(s, authMS.New(log, sm, u, p, limiter, v, tf, ic, tc))
 
 	log.Info("service auht start", logger.F{"addr", cfg.Addr})
 	if err = s.Serve(l); err != nil {
@@ -82,3 +123,37 @@ func Run(ctx context.Context, log *logger.Logger, cfg Config) {
 		return
 	}
 }
+
+// purgeDeletedAccounts periodically removes the accounts whose deletion grace period has passed.
+func purgeDeletedAccounts(ctx context.Context, log *logger.Logger, u user.Usecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := u.PurgeDeletedAccounts(ctx); err != nil {
+				log.Error(err.Error())
+			}
+		}
+	}
+}
+
+// oidcProviders returns the providers whose client credentials are set in the environment.
+func oidcProviders(log *logger.Logger, cfgs []oidc.Config) map[string]identity.Provider {
+	client := &http.Client{Timeout: _timeoutOIDC}
+	providers := make(map[string]identity.Provider, len(cfgs))
+	for _, cfg := range cfgs {
+		envPrefix := "OIDC_" + strings.ToUpper(cfg.Name)
+		cfg.ClientID = os.Getenv(envPrefix + "_CLIENT_ID")
+		cfg.ClientSecret = os.Getenv(envPrefix + "_CLIENT_SECRET")
+		if cfg.ClientID == "" {
+			log.Info("oidc provider is disabled, no client id", logger.F{"provider", cfg.Name})
+			continue
+		}
+		providers[cfg.Name] = oidc.NewProvider(cfg, client)
+	}
+	return providers
+}
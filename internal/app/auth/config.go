@@ -1,6 +1,29 @@
 package auth
 
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/attempt"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/oidc"
+)
+
 type Config struct {
-	Addr            string
-	RedisFileConfig string
+	Addr                   string
+	RedisFileConfig        string
+	SessionIdleTimeout     time.Duration
+	SessionAbsoluteTimeout time.Duration
+	PasswordResetURL       string
+	EmailVerifyURL         string
+	// MailDir is the directory for outgoing emails, if it is empty the emails are written to the log
+	MailDir             string
+	LoginUsernamePolicy attempt.Policy
+	LoginIPPolicy       attempt.Policy
+	PasswordHashParams  crypto.Argon2Params
+	// AccountDeletionGracePeriod is the time during which a deleted account can be restored
+	AccountDeletionGracePeriod time.Duration
+	AccountPurgeInterval       time.Duration
+	// OIDCProviders are enabled when the client credentials are set in the environment
+	// variables OIDC_<NAME>_CLIENT_ID and OIDC_<NAME>_CLIENT_SECRET
+	OIDCProviders []oidc.Config
 }
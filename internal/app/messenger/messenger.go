@@ -14,6 +14,7 @@ import (
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/microservices/messenger/usecase/message"
 	grpcMetrics "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/metrics/grpc"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/grpc/interceptor"
+	blockRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/block"
 	mesRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/message"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 )
@@ -39,7 +40,7 @@ func Run(ctx context.Context, log *logger.Logger) {
 	}
 	defer pool.Close()
 
-	messageCase := message.New(mesRepo.NewMessageRepo(pool))
+	messageCase := message.New(mesRepo.NewMessageRepo(pool), blockRepo.NewBlockRepoPG(pool))
 
 	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
 		interceptor.Monitoring(metrics, "0.0.0.0:8096"),
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authProto "github.com/go-park-mail-ru/2023_2_OND_team/internal/api/auth"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/attempt"
+	userUsecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
+)
+
+func (as AuthServer) DeleteAccount(ctx context.Context, req *authProto.DeleteAccountRequest) (*empty.Empty, error) {
+	userID, err := as.sm.GetUserIDBySessionKey(ctx, req.Session.GetKey())
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	err = as.userCase.DeleteAccount(ctx, userID, req.Password)
+	switch {
+	case errors.Is(err, userUsecase.ErrUserAuthentication):
+		return nil, status.Error(codes.PermissionDenied, "wrong password")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "delete account")
+	}
+
+	err = as.sm.DeleteAllUserSessions(ctx, userID)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "delete user sessions after account deletion")
+	}
+	return &empty.Empty{}, nil
+}
+
+// RestoreAccount undoes the deletion of the account and logs the user in, the failed
+// attempts are throttled the same way as the failed logins. If the user has enabled
+// two-factor authentication, the account is restored only after the second factor is passed.
+func (as AuthServer) RestoreAccount(ctx context.Context, cred *authProto.Credentials) (*authProto.LoginResponse, error) {
+	var tooMany *attempt.ErrTooManyAttempts
+	err := as.limiter.Check(ctx, cred.Username, cred.Ip)
+	if errors.As(err, &tooMany) {
+		as.log.Warn(err.Error())
+		return nil, tooManyAttemptsStatus(tooMany.RetryAfter)
+	}
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to check login attempts")
+	}
+
+	user, err := as.userCase.AuthenticateDeletedAccount(ctx, userUsecase.UserCredentials{
+		Username: cred.Username,
+		Password: cred.Password,
+	})
+	if errors.Is(err, userUsecase.ErrUserAuthentication) {
+		if err = as.limiter.AddFailure(ctx, cred.Username, cred.Ip); err != nil {
+			as.log.Error(err.Error())
+		}
+		return nil, status.Error(codes.Unauthenticated, "failed authentication")
+	}
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "restore account")
+	}
+
+	enabled, err := as.twoFactorCase.IsEnabled(ctx, user.ID)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to check two-factor authentication")
+	}
+	if enabled {
+		token, err := as.twoFactorCase.NewRestoreChallenge(ctx, user.ID)
+		if err != nil {
+			as.log.Error(err.Error())
+			return nil, status.Error(codes.Internal, "failed to create a second factor challenge")
+		}
+		return &authProto.LoginResponse{SecondFactorToken: token}, nil
+	}
+
+	if err = as.restoreAccount(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return as.completeLogin(ctx, user.ID, cred)
+}
+
+func (as AuthServer) restoreAccount(ctx context.Context, userID int) error {
+	err := as.userCase.RestoreAccount(ctx, userID)
+	switch {
+	case errors.Is(err, userUsecase.ErrUserAuthentication):
+		return status.Error(codes.Unauthenticated, "failed authentication")
+	case err != nil:
+		as.log.Error(err.Error())
+		return status.Error(codes.Internal, "restore account")
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authProto "github.com/go-park-mail-ru/2023_2_OND_team/internal/api/auth"
+	tokenEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/apitoken"
+	apiTokenRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/apitoken"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/apitoken"
+)
+
+func (as AuthServer) CreateAPIToken(ctx context.Context, req *authProto.CreateAPITokenRequest) (*authProto.APIToken, error) {
+	userID, err := as.sm.GetUserIDBySessionKey(ctx, req.Session.GetKey())
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	var (
+		invalidScopes *apitoken.ErrInvalidScopes
+		tooMany       *apitoken.ErrTooManyTokens
+	)
+	secret, token, err := as.apiTokenCase.Create(ctx, userID, req.Name, req.Scopes, req.Lifetime.AsDuration())
+	switch {
+	case errors.As(err, &invalidScopes):
+		return nil, status.Error(codes.InvalidArgument, invalidScopes.Error())
+	case errors.As(err, &tooMany):
+		return nil, status.Error(codes.ResourceExhausted, tooMany.Error())
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "create api token")
+	}
+
+	res := convertAPIToken(token)
+	res.Secret = secret
+	return res, nil
+}
+
+func (as AuthServer) ListAPITokens(ctx context.Context, sess *authProto.Session) (*authProto.APITokenList, error) {
+	userID, err := as.sm.GetUserIDBySessionKey(ctx, sess.Key)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	tokens, err := as.apiTokenCase.List(ctx, userID)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "list api tokens")
+	}
+
+	list := &authProto.APITokenList{Tokens: make([]*authProto.APIToken, 0, len(tokens))}
+	for i := range tokens {
+		list.Tokens = append(list.Tokens, convertAPIToken(&tokens[i]))
+	}
+	return list, nil
+}
+
+func (as AuthServer) RevokeAPIToken(ctx context.Context, req *authProto.RevokeAPITokenRequest) (*empty.Empty, error) {
+	userID, err := as.sm.GetUserIDBySessionKey(ctx, req.Session.GetKey())
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	var notFound *apiTokenRepo.ErrTokenNotFound
+	err = as.apiTokenCase.Revoke(ctx, userID, int(req.Id))
+	switch {
+	case errors.As(err, &notFound):
+		return nil, status.Error(codes.NotFound, "api token to revoke not found")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "revoke api token")
+	}
+	return &empty.Empty{}, nil
+}
+
+func (as AuthServer) CheckAPIToken(ctx context.Context, secret *authProto.APITokenSecret) (*authProto.APITokenOwner, error) {
+	token, err := as.apiTokenCase.Check(ctx, secret.Secret)
+	switch {
+	case errors.Is(err, apitoken.ErrInvalidToken):
+		return nil, status.Error(codes.Unauthenticated, "invalid api token")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "check api token")
+	}
+	return &authProto.APITokenOwner{
+		UserId: int64(token.UserID),
+		Scopes: token.Scopes,
+	}, nil
+}
+
+func convertAPIToken(t *tokenEntity.Token) *authProto.APIToken {
+	res := &authProto.APIToken{
+		Id:        int64(t.ID),
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+	if t.LastUsedAt != nil {
+		res.LastUsedAt = timestamppb.New(*t.LastUsedAt)
+	}
+	if t.ExpiresAt != nil {
+		res.ExpiresAt = timestamppb.New(*t.ExpiresAt)
+	}
+	return res
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authProto "github.com/go-park-mail-ru/2023_2_OND_team/internal/api/auth"
+	identityRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/identity"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/identity"
+)
+
+func (as AuthServer) OIDCProviders(ctx context.Context, _ *empty.Empty) (*authProto.OIDCProviderList, error) {
+	return &authProto.OIDCProviderList{Providers: as.identityCase.Providers()}, nil
+}
+
+// BeginOIDCLogin returns the url of the provider, with the session the identity is linked to its user.
+func (as AuthServer) BeginOIDCLogin(ctx context.Context, req *authProto.OIDCBeginRequest) (*authProto.OIDCAuthURL, error) {
+	var (
+		authURL, state string
+		userID         int
+		err            error
+	)
+	if req.Session.GetKey() != "" {
+		userID, err = as.sm.GetUserIDBySessionKey(ctx, req.Session.GetKey())
+		if err != nil {
+			as.log.Error(err.Error())
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		authURL, state, err = as.identityCase.BeginLink(ctx, req.Provider, userID)
+	} else {
+		authURL, state, err = as.identityCase.BeginLogin(ctx, req.Provider)
+	}
+	if err != nil {
+		return nil, as.oidcStatus(err, "begin oidc login")
+	}
+	return &authProto.OIDCAuthURL{Url: authURL, State: state}, nil
+}
+
+// CompleteOIDCLogin logs the user in the same way as Login after the password check,
+// if the identity has been linked to the logged in user no session is created.
+func (as AuthServer) CompleteOIDCLogin(ctx context.Context, cb *authProto.OIDCCallback) (*authProto.OIDCLoginResponse, error) {
+	sessionUserID := 0
+	if cb.Session.GetKey() != "" {
+		// the expired session completes only the login, the link flow is refused then
+		if id, err := as.sm.GetUserIDBySessionKey(ctx, cb.Session.GetKey()); err == nil {
+			sessionUserID = id
+		}
+	}
+
+	userID, linked, err := as.identityCase.Complete(ctx, cb.State, cb.Code, sessionUserID)
+	if err != nil {
+		return nil, as.oidcStatus(err, "complete oidc login")
+	}
+	if linked {
+		return &authProto.OIDCLoginResponse{Linked: true}, nil
+	}
+
+	resp, err := as.completeLogin(ctx, userID, &authProto.Credentials{
+		UserAgent: cb.UserAgent,
+		Ip:        cb.Ip,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &authProto.OIDCLoginResponse{Login: resp}, nil
+}
+
+func (as AuthServer) oidcStatus(err error, op string) error {
+	var (
+		unknownProvider *identity.ErrUnknownProvider
+		authRequest     *identityRepo.ErrAuthRequestNotFound
+		external        *identity.ErrExternalAuthentication
+		notVerified     *identity.ErrEmailNotVerified
+		emailTaken      *identity.ErrEmailTaken
+		alreadyLinked   *identityRepo.ErrIdentityAlreadyLinked
+		linkMismatch    *identity.ErrLinkSessionMismatch
+	)
+	switch {
+	case errors.As(err, &unknownProvider):
+		return status.Error(codes.NotFound, unknownProvider.Error())
+	case errors.As(err, &authRequest):
+		return status.Error(codes.Unauthenticated, authRequest.Error())
+	case errors.As(err, &external):
+		return status.Error(codes.Unauthenticated, external.Error())
+	case errors.As(err, &notVerified):
+		return status.Error(codes.PermissionDenied, notVerified.Error())
+	case errors.As(err, &emailTaken):
+		return status.Error(codes.AlreadyExists, emailTaken.Error())
+	case errors.As(err, &alreadyLinked):
+		return status.Error(codes.AlreadyExists, alreadyLinked.Error())
+	case errors.As(err, &linkMismatch):
+		return status.Error(codes.PermissionDenied, linkMismatch.Error())
+	}
+	as.log.Error(err.Error())
+	return status.Error(codes.Internal, op)
+}
@@ -2,38 +2,69 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	authProto "github.com/go-park-mail-ru/2023_2_OND_team/internal/api/auth"
+	sessionEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/apitoken"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/attempt"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/identity"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/password"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/session"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/twofactor"
 	userUsecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/verification"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 )
 
 type Usecase interface {
 	Register(ctx context.Context, user *user.User) error
 	Authentication(ctx context.Context, credentials userUsecase.UserCredentials) (*user.User, error)
+	ChangePassword(ctx context.Context, userID int, oldPassword, newPassword string) error
+	FindOutUsernameAndAvatar(ctx context.Context, userID int) (username string, avatar string, err error)
+	DeleteAccount(ctx context.Context, userID int, password string) error
+	AuthenticateDeletedAccount(ctx context.Context, credentials userUsecase.UserCredentials) (*user.User, error)
+	RestoreAccount(ctx context.Context, userID int) error
+	GetRole(ctx context.Context, userID int) (string, error)
 }
 
 type AuthServer struct {
 	authProto.UnimplementedAuthServer
 
-	log      *logger.Logger
-	sm       session.SessionManager
-	userCase Usecase
+	log           *logger.Logger
+	sm            session.SessionManager
+	userCase      Usecase
+	passwordCase  password.Usecase
+	limiter       attempt.Limiter
+	verifyCase    verification.Usecase
+	twoFactorCase twofactor.Usecase
+	identityCase  identity.Usecase
+	apiTokenCase  apitoken.Usecase
 }
 
-func New(log *logger.Logger, sm session.SessionManager, userCase Usecase) AuthServer {
+func New(log *logger.Logger, sm session.SessionManager, userCase Usecase, passwordCase password.Usecase,
+	limiter attempt.Limiter, verifyCase verification.Usecase, twoFactorCase twofactor.Usecase,
+	identityCase identity.Usecase, apiTokenCase apitoken.Usecase) AuthServer {
 	return AuthServer{
 		UnimplementedAuthServer: authProto.UnimplementedAuthServer{},
 		log:                     log,
 		sm:                      sm,
 		userCase:                userCase,
+		passwordCase:            passwordCase,
+		limiter:                 limiter,
+		verifyCase:              verifyCase,
+		twoFactorCase:           twoFactorCase,
+		identityCase:            identityCase,
+		apiTokenCase:            apiTokenCase,
 	}
 }
 
@@ -49,30 +80,84 @@ func (as AuthServer) Register(ctx context.Context, rd *authProto.RegisterData) (
 		as.log.Error(err.Error())
 		return nil, status.Error(codes.Internal, "")
 	}
+
+	// the user is registered even if the email was not sent, it can be requested again
+	if err = as.verifyCase.SendVerification(ctx, user.ID); err != nil {
+		as.log.Error(err.Error())
+	}
 	return &empty.Empty{}, nil
 }
 
-func (as AuthServer) Login(ctx context.Context, cred *authProto.Credentials) (*authProto.Session, error) {
+func (as AuthServer) Login(ctx context.Context, cred *authProto.Credentials) (*authProto.LoginResponse, error) {
+	var tooMany *attempt.ErrTooManyAttempts
+	err := as.limiter.Check(ctx, cred.Username, cred.Ip)
+	if errors.As(err, &tooMany) {
+		as.log.Warn(err.Error())
+		return nil, tooManyAttemptsStatus(tooMany.RetryAfter)
+	}
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to check login attempts")
+	}
+
 	user, err := as.userCase.Authentication(ctx, userUsecase.UserCredentials{
 		Username: cred.Username,
 		Password: cred.Password,
 	})
 	if err != nil {
 		as.log.Error(err.Error())
+		if err = as.limiter.AddFailure(ctx, cred.Username, cred.Ip); err != nil {
+			as.log.Error(err.Error())
+		}
 		return nil, status.Error(codes.Unauthenticated, "failed authentication")
 	}
+	return as.completeLogin(ctx, user.ID, cred)
+}
+
+// completeLogin starts a session for the user who has passed the password check,
+// or a second factor challenge if the user has enabled two-factor authentication.
+// The suspended user is not logged in.
+func (as AuthServer) completeLogin(ctx context.Context, userID int, cred *authProto.Credentials) (*authProto.LoginResponse, error) {
+	_, err := as.userCase.GetRole(ctx, userID)
+	switch {
+	case errors.Is(err, userUsecase.ErrUserSuspended):
+		return nil, status.Error(codes.PermissionDenied, "the account is suspended")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to check the account")
+	}
 
-	session, err := as.sm.CreateNewSessionForUser(ctx, user.ID)
+	enabled, err := as.twoFactorCase.IsEnabled(ctx, userID)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to check two-factor authentication")
+	}
+	if enabled {
+		token, err := as.twoFactorCase.NewChallenge(ctx, userID)
+		if err != nil {
+			as.log.Error(err.Error())
+			return nil, status.Error(codes.Internal, "failed to create a second factor challenge")
+		}
+		return &authProto.LoginResponse{SecondFactorToken: token}, nil
+	}
+
+	// there is no username on the login with the identity provider
+	if cred.Username != "" {
+		if err = as.limiter.Reset(ctx, cred.Username); err != nil {
+			as.log.Error(err.Error())
+		}
+	}
+
+	session, err := as.sm.CreateNewSessionForUser(ctx, userID, sessionEntity.Metadata{
+		UserAgent: cred.UserAgent,
+		IP:        cred.Ip,
+	})
 	if err != nil {
 		as.log.Error(err.Error())
 		return nil, status.Error(codes.Internal, "failed to create a session for the user")
 	}
 
-	return &authProto.Session{
-		Key:    session.Key,
-		UserID: int64(session.UserID),
-		Expire: timestamppb.New(session.Expire),
-	}, nil
+	return &authProto.LoginResponse{Session: convertSession(session)}, nil
 }
 
 func (as AuthServer) Logout(ctx context.Context, sess *authProto.Session) (*empty.Empty, error) {
@@ -84,11 +169,188 @@ func (as AuthServer) Logout(ctx context.Context, sess *authProto.Session) (*empt
 	return &empty.Empty{}, nil
 }
 
+// GetUserID returns the owner of the session with the role, the sessions of the suspended user are not accepted.
 func (as AuthServer) GetUserID(ctx context.Context, sess *authProto.Session) (*authProto.UserID, error) {
+	session, err := as.sm.RefreshSession(ctx, sess.Key, sessionEntity.Metadata{
+		UserAgent: sess.UserAgent,
+		IP:        sess.Ip,
+	})
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	role, err := as.userCase.GetRole(ctx, session.UserID)
+	switch {
+	case errors.Is(err, userUsecase.ErrUserSuspended):
+		return nil, status.Error(codes.PermissionDenied, "the account is suspended")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "get role of the user")
+	}
+	return &authProto.UserID{
+		Id:     int64(session.UserID),
+		Expire: timestamppb.New(session.Expire),
+		Role:   role,
+	}, nil
+}
+
+func (as AuthServer) LogoutAll(ctx context.Context, sess *authProto.Session) (*empty.Empty, error) {
+	userID, err := as.sm.GetUserIDBySessionKey(ctx, sess.Key)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	err = as.sm.DeleteAllUserSessions(ctx, userID)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "delete all user sessions")
+	}
+	return &empty.Empty{}, nil
+}
+
+func (as AuthServer) ListSessions(ctx context.Context, sess *authProto.Session) (*authProto.SessionList, error) {
+	userID, err := as.sm.GetUserIDBySessionKey(ctx, sess.Key)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	sessions, err := as.sm.GetUserSessions(ctx, userID)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "get user sessions")
+	}
+
+	list := &authProto.SessionList{Sessions: make([]*authProto.Session, 0, len(sessions))}
+	for _, s := range sessions {
+		list.Sessions = append(list.Sessions, convertSession(s))
+	}
+	return list, nil
+}
+
+func (as AuthServer) RevokeSession(ctx context.Context, req *authProto.RevokeSessionRequest) (*empty.Empty, error) {
+	userID, err := as.sm.GetUserIDBySessionKey(ctx, req.Current.GetKey())
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	err = as.sm.DeleteUserSessionByID(ctx, userID, req.Id)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, "session to revoke not found")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "revoke user session")
+	}
+	return &empty.Empty{}, nil
+}
+
+func (as AuthServer) ChangePassword(ctx context.Context, req *authProto.ChangePasswordRequest) (*empty.Empty, error) {
+	userID, err := as.sm.GetUserIDBySessionKey(ctx, req.Session.GetKey())
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	err = as.userCase.ChangePassword(ctx, userID, req.OldPassword, req.NewPassword)
+	switch {
+	case errors.Is(err, userUsecase.ErrUserAuthentication):
+		return nil, status.Error(codes.PermissionDenied, "wrong old password")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "change password")
+	}
+
+	err = as.sm.DeleteOtherUserSessions(ctx, userID, req.Session.GetKey())
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "delete other user sessions after password change")
+	}
+	return &empty.Empty{}, nil
+}
+
+func (as AuthServer) RequestPasswordReset(ctx context.Context, req *authProto.PasswordResetRequest) (*empty.Empty, error) {
+	err := as.passwordCase.RequestReset(ctx, req.Email)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "request password reset")
+	}
+	return &empty.Empty{}, nil
+}
+
+func (as AuthServer) ConfirmPasswordReset(ctx context.Context, req *authProto.PasswordResetConfirm) (*empty.Empty, error) {
+	userID, err := as.passwordCase.ConfirmReset(ctx, req.Token, req.NewPassword)
+	switch {
+	case errors.Is(err, password.ErrInvalidResetToken):
+		return nil, status.Error(codes.InvalidArgument, "invalid password reset token")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "confirm password reset")
+	}
+
+	err = as.sm.DeleteAllUserSessions(ctx, userID)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "delete user sessions after password reset")
+	}
+	return &empty.Empty{}, nil
+}
+
+func (as AuthServer) ResendEmailVerification(ctx context.Context, sess *authProto.Session) (*empty.Empty, error) {
 	userID, err := as.sm.GetUserIDBySessionKey(ctx, sess.Key)
 	if err != nil {
 		as.log.Error(err.Error())
 		return nil, status.Error(codes.NotFound, "session not found")
 	}
-	return &authProto.UserID{Id: int64(userID)}, nil
+
+	err = as.verifyCase.SendVerification(ctx, userID)
+	switch {
+	case errors.Is(err, verification.ErrEmailAlreadyVerified):
+		return nil, status.Error(codes.AlreadyExists, "email already verified")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "send email verification")
+	}
+	return &empty.Empty{}, nil
+}
+
+func (as AuthServer) ConfirmEmail(ctx context.Context, token *authProto.EmailVerificationToken) (*empty.Empty, error) {
+	_, err := as.verifyCase.ConfirmEmail(ctx, token.Token)
+	switch {
+	case errors.Is(err, verification.ErrInvalidVerificationToken):
+		return nil, status.Error(codes.InvalidArgument, "invalid email verification token")
+	case errors.Is(err, verification.ErrEmailAlreadyVerified):
+		return nil, status.Error(codes.AlreadyExists, "email already verified")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "confirm email")
+	}
+	return &empty.Empty{}, nil
+}
+
+func convertSession(s *sessionEntity.Session) *authProto.Session {
+	return &authProto.Session{
+		Key:       s.Key,
+		UserID:    int64(s.UserID),
+		Expire:    timestamppb.New(s.Expire),
+		Id:        s.ID,
+		UserAgent: s.UserAgent,
+		Ip:        s.IP,
+		CreatedAt: timestamppb.New(s.CreatedAt),
+		LastSeen:  timestamppb.New(s.LastSeen),
+	}
+}
+
+// tooManyAttemptsStatus returns the status of a throttled login, the time
+// after which the client can try again is passed in the RetryInfo details.
+func tooManyAttemptsStatus(retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, "too many failed login attempts")
+	st, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, "too many failed login attempts")
+	}
+	return st.Err()
 }
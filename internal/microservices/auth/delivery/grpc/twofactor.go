@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authProto "github.com/go-park-mail-ru/2023_2_OND_team/internal/api/auth"
+	sessionEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
+	twoFactorRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/twofactor"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/twofactor"
+	userUsecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
+)
+
+func (as AuthServer) LoginSecondFactor(ctx context.Context, sf *authProto.SecondFactor) (*authProto.Session, error) {
+	userID, err := as.twoFactorCase.PassChallenge(ctx, sf.Token, sf.Code)
+	switch {
+	case errors.Is(err, twofactor.ErrInvalidChallenge):
+		return nil, status.Error(codes.Unauthenticated, "invalid second factor challenge")
+	case errors.Is(err, twofactor.ErrInvalidCode):
+		as.addLoginFailure(ctx, userID, sf.Ip)
+		return nil, status.Error(codes.Unauthenticated, "invalid second factor code")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to pass second factor challenge")
+	}
+
+	if twofactor.IsRestoreChallenge(sf.Token) {
+		if err = as.restoreAccount(ctx, userID); err != nil {
+			return nil, err
+		}
+		// the suspension is checked on the first factor only for the active accounts
+		_, err = as.userCase.GetRole(ctx, userID)
+		switch {
+		case errors.Is(err, userUsecase.ErrUserSuspended):
+			return nil, status.Error(codes.PermissionDenied, "the account is suspended")
+		case err != nil:
+			as.log.Error(err.Error())
+			return nil, status.Error(codes.Internal, "failed to check the account")
+		}
+	}
+
+	if username, _, err := as.userCase.FindOutUsernameAndAvatar(ctx, userID); err != nil {
+		as.log.Error(err.Error())
+	} else if err = as.limiter.Reset(ctx, username); err != nil {
+		as.log.Error(err.Error())
+	}
+
+	session, err := as.sm.CreateNewSessionForUser(ctx, userID, sessionEntity.Metadata{
+		UserAgent: sf.UserAgent,
+		IP:        sf.Ip,
+	})
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to create a session for the user")
+	}
+	return convertSession(session), nil
+}
+
+func (as AuthServer) EnrollTOTP(ctx context.Context, sess *authProto.Session) (*authProto.TOTPEnrollment, error) {
+	userID, err := as.sm.GetUserIDBySessionKey(ctx, sess.Key)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	username, _, err := as.userCase.FindOutUsernameAndAvatar(ctx, userID)
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "get username for totp enrollment")
+	}
+
+	var alreadyEnabled *twoFactorRepo.ErrAlreadyEnabled
+	secret, uri, err := as.twoFactorCase.Enroll(ctx, userID, username)
+	switch {
+	case errors.As(err, &alreadyEnabled):
+		return nil, status.Error(codes.AlreadyExists, "two-factor authentication is already enabled")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "enroll totp")
+	}
+	return &authProto.TOTPEnrollment{Secret: secret, Uri: uri}, nil
+}
+
+func (as AuthServer) ActivateTOTP(ctx context.Context, code *authProto.TOTPCode) (*authProto.RecoveryCodes, error) {
+	userID, err := as.sm.GetUserIDBySessionKey(ctx, code.Session.GetKey())
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	var alreadyEnabled *twoFactorRepo.ErrAlreadyEnabled
+	recoveryCodes, err := as.twoFactorCase.Activate(ctx, userID, code.Code)
+	switch {
+	case errors.Is(err, twofactor.ErrInvalidCode):
+		return nil, status.Error(codes.InvalidArgument, "invalid totp code")
+	case errors.Is(err, twofactor.ErrNotEnrolled):
+		return nil, status.Error(codes.FailedPrecondition, "totp has not been enrolled")
+	case errors.As(err, &alreadyEnabled):
+		return nil, status.Error(codes.AlreadyExists, "two-factor authentication is already enabled")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "activate totp")
+	}
+	return &authProto.RecoveryCodes{Codes: recoveryCodes}, nil
+}
+
+func (as AuthServer) DisableTOTP(ctx context.Context, code *authProto.TOTPCode) (*empty.Empty, error) {
+	userID, err := as.sm.GetUserIDBySessionKey(ctx, code.Session.GetKey())
+	if err != nil {
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	err = as.twoFactorCase.Disable(ctx, userID, code.Code)
+	switch {
+	case errors.Is(err, twofactor.ErrInvalidCode):
+		return nil, status.Error(codes.InvalidArgument, "invalid totp code")
+	case errors.Is(err, twofactor.ErrNotEnabled):
+		return nil, status.Error(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	case err != nil:
+		as.log.Error(err.Error())
+		return nil, status.Error(codes.Internal, "disable totp")
+	}
+	return &empty.Empty{}, nil
+}
+
+// addLoginFailure counts a wrong second factor code as a failed login attempt,
+// otherwise the code could be guessed by the one who knows the password.
+func (as AuthServer) addLoginFailure(ctx context.Context, userID int, ip string) {
+	username, _, err := as.userCase.FindOutUsernameAndAvatar(ctx, userID)
+	if err != nil {
+		as.log.Error(err.Error())
+		return
+	}
+	if err = as.limiter.AddFailure(ctx, username, ip); err != nil {
+		as.log.Error(err.Error())
+	}
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	mess "github.com/go-park-mail-ru/2023_2_OND_team/internal/api/messenger"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/microservices/messenger/usecase/message"
@@ -50,6 +51,9 @@ func (m MessengerServer) SendMessage(ctx context.Context, msg *mess.Message) (*m
 		Content: pgtype.Text{String: msg.GetContent(), Valid: true},
 	})
 
+	if errors.Is(err, message.ErrBlocked) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
 	if err != nil {
 		m.log.Error(err.Error())
 		return nil, status.Error(codes.Internal, "send message error")
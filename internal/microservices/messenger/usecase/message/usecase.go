@@ -6,10 +6,14 @@ import (
 	"fmt"
 
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/message"
+	blockRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/block"
 	mesRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/message"
 )
 
-var ErrNoAccess = errors.New("there is no access to perform this action")
+var (
+	ErrNoAccess = errors.New("there is no access to perform this action")
+	ErrBlocked  = errors.New("the message can't be sent because of the block between the users")
+)
 
 //go:generate mockgen -destination=./mock/message_mock.go -package=mock -source=usecase.go Usecase
 type Usecase interface {
@@ -22,14 +26,22 @@ type Usecase interface {
 }
 
 type messageCase struct {
-	repo mesRepo.Repository
+	repo      mesRepo.Repository
+	blockRepo blockRepo.Repository
 }
 
-func New(repo mesRepo.Repository) *messageCase {
-	return &messageCase{repo}
+func New(repo mesRepo.Repository, blockRepo blockRepo.Repository) *messageCase {
+	return &messageCase{repo, blockRepo}
 }
 
 func (m *messageCase) SendMessage(ctx context.Context, mes *entity.Message) (int, error) {
+	blocked, err := m.blockRepo.IsBlockedBetween(ctx, mes.From, mes.To)
+	if err != nil {
+		return 0, fmt.Errorf("send message: %w", err)
+	}
+	if blocked {
+		return 0, ErrBlocked
+	}
 	return m.repo.AddNewMessage(ctx, mes)
 }
 
@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+	authUsecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/auth"
+	usecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
+	"github.com/mailru/easyjson"
+)
+
+// DeleteAccount godoc
+//
+//	@Description	Delete the account of the current user with the pins, boards and comments, all sessions are ended.
+//	@Description	The account can be restored during the grace period, after it the account is deleted for good
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			session_key	header		string					false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			body		body		structs.AccountDeletion	true	"Password of the user"
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Header			200			{string}	session_key	"Auth cookie with expired session id"
+//	@Router			/api/v1/auth/account [delete]
+func (h *HandlerHTTP) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	if contentType := r.Header.Get("Content-Type"); contentType != ApplicationJson {
+		h.responseErr(w, r, &errHTTP.ErrInvalidContentType{PreferredType: ApplicationJson})
+		return
+	}
+
+	sess, err := currentSession(r)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	params := structs.AccountDeletion{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &params); err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBody{})
+		return
+	}
+	defer r.Body.Close()
+	if err := params.Validate(); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if err := h.authCase.DeleteAccount(r.Context(), sess, *params.Password); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	http.SetCookie(w, auth.NewSessionCookie("", time.Now().UTC().AddDate(0, -1, 0)))
+	if err := responseOk(http.StatusOK, w, "the account has been deleted", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// RestoreAccount godoc
+//
+//	@Description	Restore the deleted account during the grace period and log in
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			username	body		string	true	"Username"	example(clicker123)
+//	@Param			password	body		string	true	"Password"	example(safe_pass)
+//	@Success		201			{object}	JsonResponse
+//	@Success		202			{object}	JsonResponse{body=structs.SecondFactorChallenge}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		429			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Header			201			{string}	session_key	"Auth cookie with new valid session id"
+//	@Header			429			{integer}	Retry-After	"Seconds until the next attempt is allowed"
+//	@Router			/api/v1/auth/account/restore [post]
+func (h *HandlerHTTP) RestoreAccount(w http.ResponseWriter, r *http.Request) {
+	params := &usecase.UserCredentials{}
+	if err := easyjson.UnmarshalFromReader(r.Body, params); err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBody{})
+		return
+	}
+	defer r.Body.Close()
+	if !isValidPassword(params.Password) || !isValidUsername(params.Username) {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBodyParams{Params: []string{"username", "password"}})
+		return
+	}
+
+	sess, secondFactorToken, err := h.authCase.RestoreAccount(r.Context(), params.Username, params.Password, auth.RequestMetadata(r))
+	var tooMany *authUsecase.ErrTooManyAttempts
+	if errors.As(err, &tooMany) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(tooMany.RetryAfter.Seconds()))))
+	}
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if secondFactorToken != "" {
+		err = responseOk(http.StatusAccepted, w, "the account has been restored, the second factor is required to log in",
+			structs.SecondFactorChallenge{Token: secondFactorToken})
+	} else {
+		http.SetCookie(w, auth.NewSessionCookie(sess.Key, sess.Expire))
+		err = responseOk(http.StatusCreated, w, "the account has been restored and a new session has been created", nil)
+	}
+	if err != nil {
+		h.responseErr(w, r, err)
+	}
+}
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+)
+
+const defaultAnalyticsDays = 30
+
+// GetPinAnalytics godoc
+//
+//	@Description	Get the daily impressions, closeups, likes, saves and outbound clicks of the pin
+//	@Description	for the last 7, 30 or 90 days, available only to the author of the pin
+//	@Tags			Analytics
+//	@Produce		json
+//	@Param			pinID		path		int		true	"Id of the pin"
+//	@Param			days		query		int		false	"Number of the last days: 7, 30 or 90"	default(30)
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse{body=analytics.Report}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/pin/analytics/{pinID} [get]
+func (h *HandlerHTTP) GetPinAnalytics(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	pinID, err := h.urlParamID(r, "pinID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+	days, err := fetchAnalyticsDays(r)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	report, err := h.analyticsCase.GetPinAnalytics(r.Context(), pinID, userID, days)
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "got pin analytics successfully", report); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// GetAccountAnalytics godoc
+//
+//	@Description	Get the daily impressions, closeups, likes, saves and outbound clicks summed up
+//	@Description	over all the pins of the current user for the last 7, 30 or 90 days
+//	@Tags			Analytics
+//	@Produce		json
+//	@Param			days		query		int		false	"Number of the last days: 7, 30 or 90"	default(30)
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse{body=analytics.Report}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/profile/analytics [get]
+func (h *HandlerHTTP) GetAccountAnalytics(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	days, err := fetchAnalyticsDays(r)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	report, err := h.analyticsCase.GetAccountAnalytics(r.Context(), userID, days)
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "got account analytics successfully", report); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+func fetchAnalyticsDays(r *http.Request) (int, error) {
+	daysParam := r.URL.Query().Get("days")
+	if daysParam == "" {
+		return defaultAnalyticsDays, nil
+	}
+	days, err := strconv.Atoi(daysParam)
+	if err != nil {
+		return 0, &errHTTP.ErrInvalidQueryParam{Params: map[string]string{"days": daysParam}}
+	}
+	return days, nil
+}
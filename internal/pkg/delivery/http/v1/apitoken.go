@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mailru/easyjson"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/apitoken"
+)
+
+// CreateAPIToken godoc
+//
+//	@Description	Create a personal API token with the scopes, it is passed in the Authorization: Bearer header.
+//	@Description	The token is shown only in this response
+//	@Tags			Profile
+//	@Accept			json
+//	@Produce		json
+//	@Param			session_key	header		string					false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			body		body		structs.APITokenCreate	true	"Name, scopes and lifetime of the token"
+//	@Success		201			{object}	JsonResponse{body=structs.CreatedAPIToken}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/profile/tokens [post]
+func (h *HandlerHTTP) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+	if contentType := r.Header.Get("Content-Type"); contentType != ApplicationJson {
+		h.responseErr(w, r, &errHTTP.ErrInvalidContentType{PreferredType: ApplicationJson})
+		return
+	}
+
+	sess, err := currentSession(r)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	params := structs.APITokenCreate{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &params); err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBody{})
+		return
+	}
+	defer r.Body.Close()
+	if err := params.Validate(); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	secret, token, err := h.authCase.CreateAPIToken(r.Context(), sess, *params.Name, params.Scopes, params.Lifetime())
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusCreated, w, "the api token has been created",
+		structs.CreatedAPIToken{APIToken: convertAPIToken(token), Token: secret}); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// ListAPITokens godoc
+//
+//	@Description	Get the personal API tokens of the user which have not expired
+//	@Tags			Profile
+//	@Produce		json
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse{body=[]structs.APIToken}
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/profile/tokens [get]
+func (h *HandlerHTTP) ListAPITokens(w http.ResponseWriter, r *http.Request) {
+	sess, err := currentSession(r)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	tokens, err := h.authCase.ListAPITokens(r.Context(), sess)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	res := make([]structs.APIToken, 0, len(tokens))
+	for i := range tokens {
+		res = append(res, convertAPIToken(&tokens[i]))
+	}
+	if err := responseOk(http.StatusOK, w, "got api tokens successfully", res); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// RevokeAPIToken godoc
+//
+//	@Description	Revoke the personal API token, the requests with it are no longer accepted
+//	@Tags			Profile
+//	@Produce		json
+//	@Param			tokenID		path		int		true	"Token id"			example(7)
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/profile/tokens/{tokenID} [delete]
+func (h *HandlerHTTP) RevokeAPIToken(w http.ResponseWriter, r *http.Request) {
+	tokenIDParam := chi.URLParam(r, "tokenID")
+	tokenID, err := strconv.Atoi(tokenIDParam)
+	if err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidUrlParams{Params: map[string]string{"tokenID": tokenIDParam}})
+		return
+	}
+
+	sess, err := currentSession(r)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if err := h.authCase.RevokeAPIToken(r.Context(), sess, tokenID); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the api token has been revoked", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+func convertAPIToken(t *apitoken.Token) structs.APIToken {
+	return structs.APIToken{
+		ID:         t.ID,
+		Name:       t.Name,
+		Scopes:     t.Scopes,
+		CreatedAt:  t.CreatedAt,
+		LastUsedAt: t.LastUsedAt,
+		ExpiresAt:  t.ExpiresAt,
+	}
+}
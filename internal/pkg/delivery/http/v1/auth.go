@@ -1,12 +1,20 @@
 package v1
 
 import (
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
+	"github.com/go-chi/chi/v5"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+	authUsecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/auth"
 	usecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
 	log "github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 	"github.com/mailru/easyjson"
@@ -48,10 +56,13 @@ func (h *HandlerHTTP) CheckLogin(w http.ResponseWriter, r *http.Request) {
 //	@Param			username	body		string	true	"Username"	example(clicker123)
 //	@Param			password	body		string	true	"Password"	example(safe_pass)
 //	@Success		200			{object}	JsonResponse
+//	@Success		202			{object}	JsonResponse{body=structs.SecondFactorChallenge}
 //	@Failure		400			{object}	JsonErrResponse
 //	@Failure		404			{object}	JsonErrResponse
+//	@Failure		429			{object}	JsonErrResponse
 //	@Failure		500			{object}	JsonErrResponse
 //	@Header			200			{string}	session_key	"Auth cookie with new valid session id"
+//	@Header			429			{integer}	Retry-After	"Seconds until the next login attempt is allowed"
 //	@Router			/api/v1/auth/login [post]
 func (h *HandlerHTTP) Login(w http.ResponseWriter, r *http.Request) {
 	logger := h.getRequestLogger(r)
@@ -77,7 +88,19 @@ func (h *HandlerHTTP) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	session, err := h.authCase.Login(r.Context(), params.Username, params.Password)
+	session, secondFactorToken, err := h.authCase.Login(r.Context(), params.Username, params.Password, auth.RequestMetadata(r))
+	var tooMany *authUsecase.ErrTooManyAttempts
+	if errors.As(err, &tooMany) {
+		logger.Warn(err.Error())
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(tooMany.RetryAfter.Seconds()))))
+		h.responseErr(w, r, err)
+		return
+	}
+	var suspended *authUsecase.ErrAccountSuspended
+	if errors.As(err, &suspended) {
+		h.responseErr(w, r, err)
+		return
+	}
 	if err != nil {
 		logger.Error(err.Error())
 		err = responseError(w, "session", "failed to create a session for the user")
@@ -87,16 +110,16 @@ func (h *HandlerHTTP) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cookie := &http.Cookie{
-		Name:     "session_key",
-		Value:    session.Key,
-		HttpOnly: true,
-		Secure:   true,
-		Path:     "/",
-		Expires:  session.Expire,
-		SameSite: http.SameSiteStrictMode,
+	if secondFactorToken != "" {
+		err = responseOk(http.StatusAccepted, w, "the second factor is required to log in",
+			structs.SecondFactorChallenge{Token: secondFactorToken})
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return
 	}
-	http.SetCookie(w, cookie)
+
+	http.SetCookie(w, auth.NewSessionCookie(session.Key, session.Expire))
 
 	err = responseOk(http.StatusCreated, w, "a new session has been created for the user", nil)
 	if err != nil {
@@ -200,3 +223,142 @@ func (h *HandlerHTTP) Logout(w http.ResponseWriter, r *http.Request) {
 		logger.Error(err.Error())
 	}
 }
+
+// LogoutAll godoc
+//
+//	@Description	User logout on all devices, deletion of all user sessions
+//	@Tags			Auth
+//	@Produce		json
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Header			200			{string}	Session-id	"Auth cookie with expired session id"
+//	@Router			/api/v1/auth/logout/all [delete]
+func (h *HandlerHTTP) LogoutAll(w http.ResponseWriter, r *http.Request) {
+	logger := h.getRequestLogger(r)
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+
+	cookie, err := r.Cookie("session_key")
+	if err != nil {
+		logger.Info("no cookie", log.F{"error", err.Error()})
+		err = responseError(w, "no_auth", "to log out, you must first log in")
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return
+	}
+
+	err = h.authCase.LogoutAll(r.Context(), &session.Session{
+		Key:    cookie.Value,
+		UserID: userID,
+		Expire: cookie.Expires,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		err = responseError(w, "session", "failed to end the user sessions")
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return
+	}
+
+	cookie.Expires = time.Now().UTC().AddDate(0, -1, 0)
+	cookie.Path = "/"
+	http.SetCookie(w, cookie)
+
+	err = responseOk(http.StatusOK, w, "the user has successfully logged out on all devices", nil)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+}
+
+// ListSessions godoc
+//
+//	@Description	Get active sessions of the user
+//	@Tags			Auth
+//	@Produce		json
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse{body=[]structs.SessionInfo}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/profile/sessions [get]
+func (h *HandlerHTTP) ListSessions(w http.ResponseWriter, r *http.Request) {
+	logger := h.getRequestLogger(r)
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+
+	cookie, err := r.Cookie("session_key")
+	if err != nil {
+		logger.Info("no cookie", log.F{"error", err.Error()})
+		err = responseError(w, "no_auth", "to get sessions, you must first log in")
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return
+	}
+
+	sessions, err := h.authCase.ListSessions(r.Context(), &session.Session{
+		Key:    cookie.Value,
+		UserID: userID,
+		Expire: cookie.Expires,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		err = responseError(w, "session", "failed to get the user sessions")
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return
+	}
+
+	infos := make([]structs.SessionInfo, 0, len(sessions))
+	for _, s := range sessions {
+		infos = append(infos, structs.SessionInfo{
+			ID:        s.ID,
+			UserAgent: s.UserAgent,
+			IP:        s.IP,
+			CreatedAt: s.CreatedAt,
+			LastSeen:  s.LastSeen,
+			Expire:    s.Expire,
+			Current:   s.Key == cookie.Value,
+		})
+	}
+
+	err = responseOk(http.StatusOK, w, "got user sessions successfully", infos)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+}
+
+// RevokeSession godoc
+//
+//	@Description	End one of the user sessions by its id
+//	@Tags			Profile
+//	@Produce		json
+//	@Param			sessionID	path		string	true	"Session id"		example(3f5a9c0e1b7d2a46)
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/profile/sessions/{sessionID} [delete]
+func (h *HandlerHTTP) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	cookie, err := r.Cookie("session_key")
+	if err != nil {
+		h.responseErr(w, r, &errPkg.ErrNotAuthenticated{})
+		return
+	}
+
+	err = h.authCase.RevokeSession(r.Context(), &session.Session{
+		Key:    cookie.Value,
+		UserID: r.Context().Value(auth.KeyCurrentUserID).(int),
+	}, chi.URLParam(r, "sessionID"))
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the session has been ended successfully", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
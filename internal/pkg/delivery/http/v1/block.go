@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"net/http"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+)
+
+// BlockUser godoc
+//
+//	@Description	Block the user, the users stop being subscribed to each other, can not message each other,
+//	@Description	the blocked user can not comment the pins of the current user
+//	@Description	and the users disappear from the search and feeds of each other
+//	@Tags			Block
+//	@Produce		json
+//	@Param			userID		path		int		true	"Id of the user"
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		409			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/user/{userID}/block [put]
+func (h *HandlerHTTP) BlockUser(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	blockedID, err := h.urlParamID(r, "userID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if err := h.blockCase.BlockUser(r.Context(), userID, blockedID); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the user has been blocked", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// UnblockUser godoc
+//
+//	@Description	Unblock the user, the subscriptions dropped by the block are not restored
+//	@Tags			Block
+//	@Produce		json
+//	@Param			userID		path		int		true	"Id of the user"
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/user/{userID}/block [delete]
+func (h *HandlerHTTP) UnblockUser(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	blockedID, err := h.urlParamID(r, "userID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if err := h.blockCase.UnblockUser(r.Context(), userID, blockedID); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the user has been unblocked", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// GetBlockedUsers godoc
+//
+//	@Description	Get the users blocked by the current user
+//	@Tags			Block
+//	@Produce		json
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			count		query		int		true	"Count of the users"
+//	@Param			lastID		query		int		false	"Id of the last user of the previous page"
+//	@Success		200			{object}	JsonResponse{body=structs.BlockedUsers}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/profile/blocked [get]
+func (h *HandlerHTTP) GetBlockedUsers(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	count, lastID, err := FetchValidParamForLoadFeed(r.URL)
+	if err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidQueryParam{Params: map[string]string{
+			"count": r.URL.Query().Get("count"), "lastID": r.URL.Query().Get("lastID"),
+		}})
+		return
+	}
+
+	users, err := h.blockCase.GetBlockedUsers(r.Context(), userID, lastID, count)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	res := structs.BlockedUsers{Users: users, LastID: lastID}
+	if len(users) != 0 {
+		res.LastID = users[len(users)-1].ID
+	}
+	if err := responseOk(http.StatusOK, w, "got blocked users successfully", res); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
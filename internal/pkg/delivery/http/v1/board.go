@@ -2,6 +2,7 @@ package v1
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -12,6 +13,7 @@ import (
 	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+	userUsecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
 	log "github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 )
 
@@ -41,6 +43,7 @@ func ToCertainBoardUsernameFromService(board entity.BoardWithContent, username s
 		PinsNumber:     board.PinsNumber,
 		Pins:           board.Pins,
 		Tags:           board.TagTitles,
+		Subscribers:    board.SubscribersNumber,
 	}
 }
 
@@ -330,6 +333,54 @@ func (h *HandlerHTTP) AddPinsToBoard(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *HandlerHTTP) RepinOnBoard(w http.ResponseWriter, r *http.Request) {
+	logger := h.getRequestLogger(r)
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+
+	boardID, err := strconv.ParseInt(chi.URLParam(r, "boardID"), 10, 64)
+	if err != nil {
+		logger.Info("repin on board", log.F{"message", err.Error()})
+		code, message := errHTTP.GetErrCodeMessage(errHTTP.ErrBadUrlParam)
+		responseError(w, code, message)
+		return
+	}
+
+	if contentType := r.Header.Get("Content-Type"); contentType != ApplicationJson {
+		code, message := errHTTP.GetErrCodeMessage(errHTTP.ErrBadContentType)
+		responseError(w, code, message)
+		return
+	}
+
+	repin := structs.Repin{}
+	err = easyjson.UnmarshalFromReader(r.Body, &repin)
+	defer r.Body.Close()
+	if err != nil {
+		code, message := errHTTP.GetErrCodeMessage(errHTTP.ErrBadBody)
+		responseError(w, code, message)
+		return
+	}
+
+	newPinID, err := h.boardCase.RepinOnBoard(r.Context(), int(boardID), repin.PinID, userID)
+	var notVerified *userUsecase.ErrEmailNotVerified
+	if errors.As(err, &notVerified) {
+		h.responseErr(w, r, err)
+		return
+	}
+	if err != nil {
+		logger.Info("repin on board", log.F{"message", err.Error()})
+		code, message := errHTTP.GetErrCodeMessage(err)
+		responseError(w, code, message)
+		return
+	}
+
+	err = responseOk(http.StatusCreated, w, "pin has been saved to the board", map[string]int{"new_pin_id": newPinID})
+	if err != nil {
+		logger.Error(err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errHTTP.ErrInternalError.Error()))
+	}
+}
+
 func (h *HandlerHTTP) DeletePinFromBoard(w http.ResponseWriter, r *http.Request) {
 	logger := h.getRequestLogger(r)
 
@@ -371,3 +422,59 @@ func (h *HandlerHTTP) DeletePinFromBoard(w http.ResponseWriter, r *http.Request)
 		w.Write([]byte(errHTTP.ErrInternalError.Error()))
 	}
 }
+
+func (h *HandlerHTTP) SubscribeToBoard(w http.ResponseWriter, r *http.Request) {
+	logger := h.getRequestLogger(r)
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+
+	boardID, err := strconv.ParseInt(chi.URLParam(r, "boardID"), 10, 64)
+	if err != nil {
+		logger.Info("subscribe to board", log.F{"message", err.Error()})
+		code, message := errHTTP.GetErrCodeMessage(errHTTP.ErrBadUrlParam)
+		responseError(w, code, message)
+		return
+	}
+
+	err = h.boardCase.SubscribeToBoard(r.Context(), int(boardID), userID)
+	if err != nil {
+		logger.Info("subscribe to board", log.F{"message", err.Error()})
+		code, message := errHTTP.GetErrCodeMessage(err)
+		responseError(w, code, message)
+		return
+	}
+
+	err = responseOk(http.StatusOK, w, "subscribed to board successfully", nil)
+	if err != nil {
+		logger.Error(err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errHTTP.ErrInternalError.Error()))
+	}
+}
+
+func (h *HandlerHTTP) UnsubscribeFromBoard(w http.ResponseWriter, r *http.Request) {
+	logger := h.getRequestLogger(r)
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+
+	boardID, err := strconv.ParseInt(chi.URLParam(r, "boardID"), 10, 64)
+	if err != nil {
+		logger.Info("unsubscribe from board", log.F{"message", err.Error()})
+		code, message := errHTTP.GetErrCodeMessage(errHTTP.ErrBadUrlParam)
+		responseError(w, code, message)
+		return
+	}
+
+	err = h.boardCase.UnsubscribeFromBoard(r.Context(), int(boardID), userID)
+	if err != nil {
+		logger.Info("unsubscribe from board", log.F{"message", err.Error()})
+		code, message := errHTTP.GetErrCodeMessage(err)
+		responseError(w, code, message)
+		return
+	}
+
+	err = responseOk(http.StatusOK, w, "unsubscribed from board successfully", nil)
+	if err != nil {
+		logger.Error(err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errHTTP.ErrInternalError.Error()))
+	}
+}
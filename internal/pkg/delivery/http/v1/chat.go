@@ -1,10 +1,13 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/message"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/block"
+	userUsecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
 )
 
 func (h *HandlerHTTP) FeedChats(w http.ResponseWriter, r *http.Request) {
@@ -64,6 +67,14 @@ func (h *HandlerHTTP) SendMessageToUser(w http.ResponseWriter, r *http.Request)
 	mes.To = toUserID
 
 	idNewMessage, err := h.messageCase.SendMessage(r.Context(), userID, mes)
+	var (
+		notVerified *userUsecase.ErrEmailNotVerified
+		blocked     *block.ErrBlocked
+	)
+	if errors.As(err, &notVerified) || errors.As(err, &blocked) {
+		h.responseErr(w, r, err)
+		return
+	}
 	if err != nil {
 		logger.Error(err.Error())
 		err = responseError(w, "send_message", "failed to send message")
@@ -1,11 +1,13 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/comment"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/block"
 	"github.com/mailru/easyjson"
 )
 
@@ -37,6 +39,11 @@ func (h *HandlerHTTP) WriteComment(w http.ResponseWriter, r *http.Request) {
 
 	comment.PinID = pinID
 	_, err = h.commentCase.PutCommentOnPin(r.Context(), userID, comment)
+	var blocked *block.ErrBlocked
+	if errors.As(err, &blocked) {
+		h.responseErr(w, r, err)
+		return
+	}
 	if err != nil {
 		logger.Error(err.Error())
 		err = responseError(w, "create_comment", "couldn't leave a comment under the selected pin")
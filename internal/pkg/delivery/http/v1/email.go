@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"net/http"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+	"github.com/mailru/easyjson"
+)
+
+// ResendEmailVerification godoc
+//
+//	@Description	Send the email verification link to the email of the current user again
+//	@Tags			Auth
+//	@Produce		json
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		409			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/auth/email/verify/resend [post]
+func (h *HandlerHTTP) ResendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	cookie, err := r.Cookie("session_key")
+	if err != nil {
+		h.responseErr(w, r, &errPkg.ErrNotAuthenticated{})
+		return
+	}
+
+	err = h.authCase.ResendEmailVerification(r.Context(), &session.Session{
+		Key:    cookie.Value,
+		UserID: r.Context().Value(auth.KeyCurrentUserID).(int),
+	})
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the verification link has been sent to the email", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// ConfirmEmail godoc
+//
+//	@Description	Confirm the email of the user with the token from the verification link
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		structs.EmailVerification	true	"Verification token"
+//	@Success		200		{object}	JsonResponse
+//	@Failure		400		{object}	JsonErrResponse
+//	@Failure		409		{object}	JsonErrResponse
+//	@Failure		500		{object}	JsonErrResponse
+//	@Router			/api/v1/auth/email/verify [post]
+func (h *HandlerHTTP) ConfirmEmail(w http.ResponseWriter, r *http.Request) {
+	if contentType := r.Header.Get("Content-Type"); contentType != ApplicationJson {
+		h.responseErr(w, r, &errHTTP.ErrInvalidContentType{PreferredType: ApplicationJson})
+		return
+	}
+
+	params := structs.EmailVerification{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &params); err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBody{})
+		return
+	}
+	defer r.Body.Close()
+	if err := params.Validate(); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if err := h.authCase.ConfirmEmail(r.Context(), *params.Token); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the email has been verified successfully", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
@@ -26,6 +26,10 @@ var (
 		bCase.ErrNoSuchBoard:     "no_board",
 		bCase.ErrNoAccess:        "no_access",
 		bCase.ErrNoPinOnBoard:    "no_pin",
+		bCase.ErrAlreadyFollowed: "already_followed",
+		bCase.ErrNotFollowed:     "not_followed",
+		bCase.ErrPinNotSavable:   "pin_not_savable",
+		bCase.ErrPinAlreadySaved: "pin_already_saved",
 	}
 )
 
@@ -87,6 +87,28 @@ func (e *ErrMissingBodyParams) Type() errPkg.Type {
 	return errPkg.ErrInvalidInput
 }
 
+type ErrInvalidBodyParams struct {
+	Params []string
+}
+
+func (e *ErrInvalidBodyParams) Error() string {
+	return fmt.Sprintf("invalid body params: %v", e.Params)
+}
+
+func (e *ErrInvalidBodyParams) Type() errPkg.Type {
+	return errPkg.ErrInvalidInput
+}
+
+type ErrOIDCStateMismatch struct{}
+
+func (e *ErrOIDCStateMismatch) Error() string {
+	return "the login with the identity provider was started in another browser"
+}
+
+func (e *ErrOIDCStateMismatch) Type() errPkg.Type {
+	return errPkg.ErrNoAuth
+}
+
 func GetCodeStatusHttp(err error) (ErrCode string, httpStatus int) {
 
 	var declaredErr errPkg.DeclaredError
@@ -104,6 +126,8 @@ func GetCodeStatusHttp(err error) (ErrCode string, httpStatus int) {
 			return "no_access", http.StatusForbidden
 		case errPkg.ErrTimeout:
 			return "timeout", http.StatusRequestTimeout
+		case errPkg.ErrTooManyRequests:
+			return "too_many_requests", http.StatusTooManyRequests
 		}
 	}
 
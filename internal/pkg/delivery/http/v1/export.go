@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/export"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+)
+
+const pathExportDownload = "/api/v1/profile/export/%s/download"
+
+// RequestDataExport godoc
+//
+//	@Description	Start to assemble the archive with all the data of the user: profile, pins with images, boards,
+//	@Description	comments, likes, subscriptions and messages. If the previous export is in progress, it is returned
+//	@Tags			Profile
+//	@Produce		json
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		202			{object}	JsonResponse{body=structs.DataExport}
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/profile/export [post]
+func (h *HandlerHTTP) RequestDataExport(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+
+	export, err := h.exportCase.RequestExport(r.Context(), userID)
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusAccepted, w, "the data export has been started", convertExport(export)); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// GetDataExport godoc
+//
+//	@Description	Get the status of the data export, the download url is set when the archive is ready
+//	@Tags			Profile
+//	@Produce		json
+//	@Param			exportID	path		string	true	"Id of the export"
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse{body=structs.DataExport}
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/profile/export/{exportID} [get]
+func (h *HandlerHTTP) GetDataExport(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+
+	export, err := h.exportCase.GetExport(r.Context(), userID, chi.URLParam(r, "exportID"))
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "got the data export successfully", convertExport(export)); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// DownloadDataExport godoc
+//
+//	@Description	Download the zip archive of the ready data export
+//	@Tags			Profile
+//	@Produce		application/zip
+//	@Param			exportID	path		string	true	"Id of the export"
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{file}		file
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		409			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/profile/export/{exportID}/download [get]
+func (h *HandlerHTTP) DownloadDataExport(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+
+	archive, err := h.exportCase.OpenArchive(r.Context(), userID, chi.URLParam(r, "exportID"))
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+	defer archive.Close()
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="pinspire-data.zip"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(w, archive); err != nil {
+		h.getRequestLogger(r).Error(err.Error())
+	}
+}
+
+func convertExport(export *entity.Export) structs.DataExport {
+	res := structs.DataExport{
+		ID:         export.ID,
+		Status:     string(export.Status),
+		CreatedAt:  export.CreatedAt,
+		FinishedAt: export.FinishedAt,
+	}
+	if export.Status == entity.StatusReady {
+		res.DownloadURL = fmt.Sprintf(pathExportDownload, export.ID)
+	}
+	return res
+}
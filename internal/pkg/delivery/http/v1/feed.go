@@ -119,6 +119,22 @@ func parseFeedConfig(u *url.URL) (pin.FeedPinConfig, error) {
 		cfg.Liked = ok
 	}
 
+	if u.Query().Has("followedBoards") {
+		ok, err = strconv.ParseBool(u.Query().Get("followedBoards"))
+		if err != nil {
+			return cfg, fmt.Errorf("pars feed config: %w", err)
+		}
+		cfg.FollowedBoards = ok
+	}
+
+	if u.Query().Has("following") {
+		ok, err = strconv.ParseBool(u.Query().Get("following"))
+		if err != nil {
+			return cfg, fmt.Errorf("pars feed config: %w", err)
+		}
+		cfg.Following = ok
+	}
+
 	switch u.Query().Get("protection") {
 	case "all":
 		cfg.Protection = pin.FeedAll
@@ -1,11 +1,16 @@
 package v1
 
 import (
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/analytics"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/auth"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/block"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/board"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/comment"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/export"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/message"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/moderation"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/pin"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/recommendation"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/search"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/subscription"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
@@ -13,28 +18,40 @@ import (
 )
 
 type HandlerHTTP struct {
-	log         *logger.Logger
-	authCase    auth.Usecase
-	userCase    user.Usecase
-	pinCase     pin.Usecase
-	boardCase   board.Usecase
-	subCase     subscription.Usecase
-	searchCase  search.Usecase
-	messageCase message.Usecase
-	commentCase comment.Usecase
+	log            *logger.Logger
+	authCase       auth.Usecase
+	userCase       user.Usecase
+	pinCase        pin.Usecase
+	boardCase      board.Usecase
+	subCase        subscription.Usecase
+	searchCase     search.Usecase
+	messageCase    message.Usecase
+	commentCase    comment.Usecase
+	exportCase     export.Usecase
+	moderationCase moderation.Usecase
+	blockCase      block.Usecase
+	analyticsCase  analytics.Usecase
+
+	recommendationCase recommendation.Usecase
 }
 
 func New(log *logger.Logger, hub UsecaseHub) *HandlerHTTP {
 	return &HandlerHTTP{
-		log:         log,
-		authCase:    hub.AuhtCase,
-		userCase:    hub.UserCase,
-		pinCase:     hub.PinCase,
-		boardCase:   hub.BoardCase,
-		subCase:     hub.SubscriptionCase,
-		searchCase:  hub.SearchCase,
-		messageCase: hub.MessageCase,
-		commentCase: hub.CommentCase,
+		log:            log,
+		authCase:       hub.AuhtCase,
+		userCase:       hub.UserCase,
+		pinCase:        hub.PinCase,
+		boardCase:      hub.BoardCase,
+		subCase:        hub.SubscriptionCase,
+		searchCase:     hub.SearchCase,
+		messageCase:    hub.MessageCase,
+		commentCase:    hub.CommentCase,
+		exportCase:     hub.ExportCase,
+		moderationCase: hub.ModerationCase,
+		blockCase:      hub.BlockCase,
+		analyticsCase:  hub.AnalyticsCase,
+
+		recommendationCase: hub.RecommendationCase,
 	}
 }
 
@@ -47,4 +64,10 @@ type UsecaseHub struct {
 	SearchCase       search.Usecase
 	MessageCase      message.Usecase
 	CommentCase      comment.Usecase
+	ExportCase       export.Usecase
+	ModerationCase   moderation.Usecase
+	BlockCase        block.Usecase
+	AnalyticsCase    analytics.Usecase
+
+	RecommendationCase recommendation.Usecase
 }
@@ -0,0 +1,366 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/moderation"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+)
+
+// ReportContent godoc
+//
+//	@Description	Report the pin, board, comment or user to the moderators
+//	@Tags			Moderation
+//	@Accept			json
+//	@Produce		json
+//	@Param			session_key	header		string					false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			body		body		structs.ReportCreate	true	"Target and reason of the report"
+//	@Success		201			{object}	JsonResponse{body=structs.Report}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		409			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/report [post]
+func (h *HandlerHTTP) ReportContent(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+
+	params := structs.ReportCreate{}
+	if err := decodeValidBody(r, &params); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	report, err := h.moderationCase.Report(r.Context(), userID, *params.TargetType, *params.TargetID, *params.Reason)
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusCreated, w, "the report has been sent to the moderators", convertReport(report)); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// GetReports godoc
+//
+//	@Description	Get the reports from the newest, the open ones by default
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			count		query		int		true	"Count of the reports"
+//	@Param			lastID		query		int		false	"Id of the last report of the previous page"
+//	@Param			resolved	query		bool	false	"Get the resolved reports"
+//	@Success		200			{object}	JsonResponse{body=structs.ReportFeed}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/admin/reports [get]
+func (h *HandlerHTTP) GetReports(w http.ResponseWriter, r *http.Request) {
+	count, lastID, err := FetchValidParamForLoadFeed(r.URL)
+	if err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidQueryParam{Params: map[string]string{
+			"count": r.URL.Query().Get("count"), "lastID": r.URL.Query().Get("lastID"),
+		}})
+		return
+	}
+	resolved, _ := strconv.ParseBool(r.URL.Query().Get("resolved"))
+
+	reports, err := h.moderationCase.GetReports(r.Context(), resolved, lastID, count)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	feed := structs.ReportFeed{Reports: make([]structs.Report, 0, len(reports)), LastID: lastID}
+	for i := range reports {
+		feed.Reports = append(feed.Reports, convertReport(&reports[i]))
+		feed.LastID = reports[i].ID
+	}
+	if err := responseOk(http.StatusOK, w, "got reports successfully", feed); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// ResolveReport godoc
+//
+//	@Description	Close the report after it has been handled
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			reportID	path		int							true	"Id of the report"
+//	@Param			session_key	header		string						false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			body		body		structs.ModerationReason	true	"How the report has been handled"
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/admin/reports/{reportID}/resolve [put]
+func (h *HandlerHTTP) ResolveReport(w http.ResponseWriter, r *http.Request) {
+	actorID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	reportID, err := h.urlParamID(r, "reportID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	params := structs.ModerationReason{}
+	if err := decodeValidBody(r, &params); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if err := h.moderationCase.ResolveReport(r.Context(), actorID, reportID, *params.Reason); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the report has been resolved", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// HideContent godoc
+//
+//	@Description	Hide the pin, board or comment from everyone including the author
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			targetType	path		string						true	"pin, board or comment"
+//	@Param			targetID	path		int							true	"Id of the content"
+//	@Param			session_key	header		string						false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			body		body		structs.ModerationReason	true	"Reason to hide"
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/admin/{targetType}/{targetID}/hide [put]
+func (h *HandlerHTTP) HideContent(w http.ResponseWriter, r *http.Request) {
+	actorID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	targetID, err := h.urlParamID(r, "targetID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	params := structs.ModerationReason{}
+	if err := decodeValidBody(r, &params); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	err = h.moderationCase.Hide(r.Context(), actorID, chi.URLParam(r, "targetType"), targetID, *params.Reason)
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the content has been hidden", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// UnhideContent godoc
+//
+//	@Description	Show the hidden pin, board or comment again
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			targetType	path		string	true	"pin, board or comment"
+//	@Param			targetID	path		int		true	"Id of the content"
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/admin/{targetType}/{targetID}/hide [delete]
+func (h *HandlerHTTP) UnhideContent(w http.ResponseWriter, r *http.Request) {
+	actorID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	targetID, err := h.urlParamID(r, "targetID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	err = h.moderationCase.Unhide(r.Context(), actorID, chi.URLParam(r, "targetType"), targetID)
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the content has been shown again", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// SuspendUser godoc
+//
+//	@Description	Suspend the user for the days or until the suspension is lifted, the user can not log in
+//	@Description	and the sessions and api tokens of the user are not accepted
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			userID		path		int						true	"Id of the user"
+//	@Param			session_key	header		string					false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			body		body		structs.UserSuspension	true	"Reason and days of the suspension"
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/admin/user/{userID}/suspend [put]
+func (h *HandlerHTTP) SuspendUser(w http.ResponseWriter, r *http.Request) {
+	actorID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	userID, err := h.urlParamID(r, "userID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	params := structs.UserSuspension{}
+	if err := decodeValidBody(r, &params); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	err = h.moderationCase.SuspendUser(r.Context(), actorID, userID, params.Duration(), *params.Reason)
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the user has been suspended", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// UnsuspendUser godoc
+//
+//	@Description	Lift the suspension of the user
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			userID		path		int		true	"Id of the user"
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/admin/user/{userID}/suspend [delete]
+func (h *HandlerHTTP) UnsuspendUser(w http.ResponseWriter, r *http.Request) {
+	actorID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	userID, err := h.urlParamID(r, "userID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if err := h.moderationCase.UnsuspendUser(r.Context(), actorID, userID); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the suspension has been lifted", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// SetUserRole godoc
+//
+//	@Description	Change the global role of the user: user, moderator or admin
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			userID		path		int					true	"Id of the user"
+//	@Param			session_key	header		string				false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			body		body		structs.RoleChange	true	"New role"
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/admin/user/{userID}/role [put]
+func (h *HandlerHTTP) SetUserRole(w http.ResponseWriter, r *http.Request) {
+	actorID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	userID, err := h.urlParamID(r, "userID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	params := structs.RoleChange{}
+	if err := decodeValidBody(r, &params); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if err := h.moderationCase.SetRole(r.Context(), actorID, userID, *params.Role); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the role of the user has been changed", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// GetAuditLog godoc
+//
+//	@Description	Get the actions of the staff from the newest
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			count		query		int		true	"Count of the entries"
+//	@Param			lastID		query		int		false	"Id of the last entry of the previous page"
+//	@Success		200			{object}	JsonResponse{body=structs.AuditFeed}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/admin/audit [get]
+func (h *HandlerHTTP) GetAuditLog(w http.ResponseWriter, r *http.Request) {
+	count, lastID, err := FetchValidParamForLoadFeed(r.URL)
+	if err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidQueryParam{Params: map[string]string{
+			"count": r.URL.Query().Get("count"), "lastID": r.URL.Query().Get("lastID"),
+		}})
+		return
+	}
+
+	entries, err := h.moderationCase.GetAuditLog(r.Context(), lastID, count)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	feed := structs.AuditFeed{Entries: make([]structs.AuditEntry, 0, len(entries)), LastID: lastID}
+	for _, e := range entries {
+		feed.Entries = append(feed.Entries, structs.AuditEntry{
+			ID:         e.ID,
+			ActorID:    e.ActorID,
+			Action:     e.Action,
+			TargetType: e.TargetType,
+			TargetID:   e.TargetID,
+			Details:    e.Details,
+			CreatedAt:  e.CreatedAt,
+		})
+		feed.LastID = e.ID
+	}
+	if err := responseOk(http.StatusOK, w, "got audit log successfully", feed); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+func (h *HandlerHTTP) urlParamID(r *http.Request, param string) (int, error) {
+	id, err := fetchURLParamInt(r, param)
+	if err != nil {
+		return 0, &errHTTP.ErrInvalidUrlParams{Params: map[string]string{param: chi.URLParam(r, param)}}
+	}
+	return id, nil
+}
+
+func convertReport(r *entity.Report) structs.Report {
+	return structs.Report{
+		ID:         r.ID,
+		ReporterID: r.ReporterID,
+		TargetType: r.TargetType,
+		TargetID:   r.TargetID,
+		Reason:     r.Reason,
+		CreatedAt:  r.CreatedAt,
+		ResolvedAt: r.ResolvedAt,
+		ResolvedBy: r.ResolvedBy,
+	}
+}
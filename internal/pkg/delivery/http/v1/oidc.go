@@ -0,0 +1,199 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mailru/easyjson"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+)
+
+// OIDCProviders godoc
+//
+//	@Description	Get the names of the identity providers with which the user can log in
+//	@Tags			Auth
+//	@Produce		json
+//	@Success		200	{object}	JsonResponse{body=structs.OIDCProviders}
+//	@Failure		500	{object}	JsonErrResponse
+//	@Router			/api/v1/auth/oidc/providers [get]
+func (h *HandlerHTTP) OIDCProviders(w http.ResponseWriter, r *http.Request) {
+	providers, err := h.authCase.OIDCProviders(r.Context())
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "got identity providers successfully",
+		structs.OIDCProviders{Providers: providers}); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// BeginOIDCLogin godoc
+//
+//	@Description	Start the login with the identity provider, the user must be redirected to the returned url.
+//	@Description	After the login the provider redirects the user to the frontend with the state and code
+//	@Tags			Auth
+//	@Produce		json
+//	@Param			provider	path		string	true	"Name of the identity provider"	example(google)
+//	@Success		200			{object}	JsonResponse{body=structs.OIDCAuthURL}
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Header			200			{string}	oidc_state	"Cookie binding the flow to the browser"
+//	@Router			/api/v1/auth/oidc/{provider}/login [post]
+func (h *HandlerHTTP) BeginOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	authURL, state, err := h.authCase.BeginOIDCLogin(r.Context(), chi.URLParam(r, "provider"), nil)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	http.SetCookie(w, newOIDCStateCookie(state))
+	if err := responseOk(http.StatusOK, w, "the login with the identity provider has been started",
+		structs.OIDCAuthURL{URL: authURL}); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// BeginOIDCLink godoc
+//
+//	@Description	Start linking the account at the identity provider to the current user, the flow is the same as the login
+//	@Tags			Auth
+//	@Produce		json
+//	@Param			provider	path		string	true	"Name of the identity provider"	example(google)
+//	@Param			session_key	header		string	false	"Auth session id"				example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse{body=structs.OIDCAuthURL}
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Header			200			{string}	oidc_state	"Cookie binding the flow to the browser"
+//	@Router			/api/v1/auth/oidc/{provider}/link [post]
+func (h *HandlerHTTP) BeginOIDCLink(w http.ResponseWriter, r *http.Request) {
+	sess, err := currentSession(r)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	authURL, state, err := h.authCase.BeginOIDCLogin(r.Context(), chi.URLParam(r, "provider"), sess)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	http.SetCookie(w, newOIDCStateCookie(state))
+	if err := responseOk(http.StatusOK, w, "linking of the identity provider has been started",
+		structs.OIDCAuthURL{URL: authURL}); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// CompleteOIDCLogin godoc
+//
+//	@Description	Finish the login or linking with the state and code passed by the identity provider
+//	@Description	in the browser which started the flow, the linking is finished only by the session which started it.
+//	@Description	A new user is registered if nobody has logged in with this identity yet
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		structs.OIDCCallback	true	"State and code from the redirect of the provider"
+//	@Success		200		{object}	JsonResponse			"The identity has been linked"
+//	@Success		201		{object}	JsonResponse
+//	@Success		202		{object}	JsonResponse{body=structs.SecondFactorChallenge}
+//	@Failure		400		{object}	JsonErrResponse
+//	@Failure		401		{object}	JsonErrResponse
+//	@Failure		403		{object}	JsonErrResponse
+//	@Failure		409		{object}	JsonErrResponse
+//	@Failure		500		{object}	JsonErrResponse
+//	@Header			201		{string}	session_key	"Auth cookie with new valid session id"
+//	@Router			/api/v1/auth/oidc/callback [post]
+func (h *HandlerHTTP) CompleteOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	if contentType := r.Header.Get("Content-Type"); contentType != ApplicationJson {
+		h.responseErr(w, r, &errHTTP.ErrInvalidContentType{PreferredType: ApplicationJson})
+		return
+	}
+
+	params := structs.OIDCCallback{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &params); err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBody{})
+		return
+	}
+	defer r.Body.Close()
+	if err := params.Validate(); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	// the state must come back to the browser which started the flow
+	stateCookie, err := r.Cookie(oidcStateCookieName)
+	http.SetCookie(w, expiredOIDCStateCookie())
+	if err != nil || subtle.ConstantTimeCompare([]byte(stateCookie.Value), []byte(hashOIDCState(*params.State))) != 1 {
+		h.responseErr(w, r, &errHTTP.ErrOIDCStateMismatch{})
+		return
+	}
+
+	var current *session.Session
+	if cookie, err := r.Cookie(auth.SessionCookieName); err == nil {
+		current = &session.Session{Key: cookie.Value}
+	}
+
+	sess, secondFactorToken, linked, err := h.authCase.CompleteOIDCLogin(r.Context(), *params.State, *params.Code,
+		current, auth.RequestMetadata(r))
+	switch {
+	case err != nil:
+		h.responseErr(w, r, err)
+		return
+	case linked:
+		err = responseOk(http.StatusOK, w, "the identity provider has been linked to the user", nil)
+	case secondFactorToken != "":
+		err = responseOk(http.StatusAccepted, w, "the second factor is required to log in",
+			structs.SecondFactorChallenge{Token: secondFactorToken})
+	default:
+		http.SetCookie(w, auth.NewSessionCookie(sess.Key, sess.Expire))
+		err = responseOk(http.StatusCreated, w, "a new session has been created for the user", nil)
+	}
+	if err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+const (
+	oidcStateCookieName = "oidc_state"
+	oidcStateCookiePath = "/api/v1/auth/oidc"
+	// oidcStateLifeTime is the same as the life time of the auth request of the flow
+	oidcStateLifeTime = 10 * time.Minute
+)
+
+// newOIDCStateCookie binds the flow to the browser, the cookie keeps only the hash of the state.
+func newOIDCStateCookie(state string) *http.Cookie {
+	return &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    hashOIDCState(state),
+		HttpOnly: true,
+		Secure:   true,
+		Path:     oidcStateCookiePath,
+		MaxAge:   int(oidcStateLifeTime / time.Second),
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+func expiredOIDCStateCookie() *http.Cookie {
+	return &http.Cookie{
+		Name:     oidcStateCookieName,
+		HttpOnly: true,
+		Secure:   true,
+		Path:     oidcStateCookiePath,
+		MaxAge:   -1,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+func hashOIDCState(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(sum[:])
+}
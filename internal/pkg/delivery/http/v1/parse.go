@@ -7,8 +7,29 @@ import (
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/mailru/easyjson"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
 )
 
+type validatedBody interface {
+	easyjson.Unmarshaler
+	Validate() error
+}
+
+// decodeValidBody decodes the JSON body of the request into params and validates them.
+func decodeValidBody(r *http.Request, params validatedBody) error {
+	if contentType := r.Header.Get("Content-Type"); contentType != ApplicationJson {
+		return &errHTTP.ErrInvalidContentType{PreferredType: ApplicationJson}
+	}
+	defer r.Body.Close()
+
+	if err := easyjson.UnmarshalFromReader(r.Body, params); err != nil {
+		return &errHTTP.ErrInvalidBody{}
+	}
+	return params.Validate()
+}
+
 func fetchURLParamInt(r *http.Request, param string) (int, error) {
 	paramInt64, err := strconv.ParseInt(chi.URLParam(r, param), 10, 64)
 	if err != nil {
@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"net/http"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+	"github.com/mailru/easyjson"
+)
+
+// ChangePassword godoc
+//
+//	@Description	Change the password of the current user, other sessions of the user are ended
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			session_key	header		string					false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			body		body		structs.PasswordChange	true	"Old and new passwords"
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/auth/password [put]
+func (h *HandlerHTTP) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	if contentType := r.Header.Get("Content-Type"); contentType != ApplicationJson {
+		h.responseErr(w, r, &errHTTP.ErrInvalidContentType{PreferredType: ApplicationJson})
+		return
+	}
+
+	cookie, err := r.Cookie("session_key")
+	if err != nil {
+		h.responseErr(w, r, &errPkg.ErrNotAuthenticated{})
+		return
+	}
+
+	params := structs.PasswordChange{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &params); err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBody{})
+		return
+	}
+	defer r.Body.Close()
+	if err := params.Validate(); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+	if !isValidPassword(*params.NewPassword) {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBodyParams{Params: []string{"new_password"}})
+		return
+	}
+
+	err = h.authCase.ChangePassword(r.Context(), &session.Session{
+		Key:    cookie.Value,
+		UserID: r.Context().Value(auth.KeyCurrentUserID).(int),
+	}, *params.OldPassword, *params.NewPassword)
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the password has been changed successfully", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// RequestPasswordReset godoc
+//
+//	@Description	Send a password reset link to the email, if there is an account with it
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		structs.PasswordResetRequest	true	"Email of the account"
+//	@Success		200		{object}	JsonResponse
+//	@Failure		400		{object}	JsonErrResponse
+//	@Failure		500		{object}	JsonErrResponse
+//	@Router			/api/v1/auth/password/reset [post]
+func (h *HandlerHTTP) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	if contentType := r.Header.Get("Content-Type"); contentType != ApplicationJson {
+		h.responseErr(w, r, &errHTTP.ErrInvalidContentType{PreferredType: ApplicationJson})
+		return
+	}
+
+	params := structs.PasswordResetRequest{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &params); err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBody{})
+		return
+	}
+	defer r.Body.Close()
+	if err := params.Validate(); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+	if !isValidEmail(*params.Email) {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBodyParams{Params: []string{"email"}})
+		return
+	}
+
+	if err := h.authCase.RequestPasswordReset(r.Context(), *params.Email); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "if the account exists, a reset link has been sent to its email", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// ConfirmPasswordReset godoc
+//
+//	@Description	Set a new password using the token from the reset link, all sessions of the user are ended
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		structs.PasswordResetConfirm	true	"Reset token and new password"
+//	@Success		200		{object}	JsonResponse
+//	@Failure		400		{object}	JsonErrResponse
+//	@Failure		500		{object}	JsonErrResponse
+//	@Router			/api/v1/auth/password/reset/confirm [post]
+func (h *HandlerHTTP) ConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	if contentType := r.Header.Get("Content-Type"); contentType != ApplicationJson {
+		h.responseErr(w, r, &errHTTP.ErrInvalidContentType{PreferredType: ApplicationJson})
+		return
+	}
+
+	params := structs.PasswordResetConfirm{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &params); err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBody{})
+		return
+	}
+	defer r.Body.Close()
+	if err := params.Validate(); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+	if !isValidPassword(*params.NewPassword) {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBodyParams{Params: []string{"new_password"}})
+		return
+	}
+
+	if err := h.authCase.ConfirmPasswordReset(r.Context(), *params.Token, *params.NewPassword); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the password has been reset successfully", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
@@ -1,9 +1,12 @@
 package v1
 
 import (
+	"errors"
+	"mime/multipart"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	chi "github.com/go-chi/chi/v5"
 	"github.com/mailru/easyjson"
@@ -13,6 +16,7 @@ import (
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
 	img "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/image"
 	usecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/pin"
+	userUsecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
 )
 
 const MaxMemoryParseFormData = 12 * 1 << 20
@@ -61,7 +65,27 @@ func (h *HandlerHTTP) CreateNewPin(w http.ResponseWriter, r *http.Request) {
 	}
 	newPin.Public = isPublic
 
-	picture, mime, err := r.FormFile("picture")
+	if draft := r.FormValue("draft"); draft != "" {
+		if newPin.Draft, err = strconv.ParseBool(draft); err != nil {
+			responseError(w, "bad_body", "parameter draft should have boolean value")
+			return
+		}
+	}
+
+	if publishAt := r.FormValue("publish_at"); publishAt != "" {
+		publishTime, err := time.Parse(time.RFC3339, publishAt)
+		if err != nil {
+			responseError(w, "bad_body", "parameter publish_at should be the time in RFC3339 format")
+			return
+		}
+		newPin.SetPublishAt(publishTime)
+	}
+
+	if sourceURL := r.FormValue("source_url"); sourceURL != "" {
+		newPin.SetSourceURL(sourceURL)
+	}
+
+	pictures, closePictures, err := openPictures(r.MultipartForm)
 	if err != nil {
 		err = responseError(w, "bad_body", "unable to get an image from the request body")
 		if err != nil {
@@ -69,13 +93,24 @@ func (h *HandlerHTTP) CreateNewPin(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
-	defer picture.Close()
+	defer closePictures()
 
-	err = h.pinCase.CreateNewPin(r.Context(), newPin, mime.Header.Get("Content-Type"), mime.Size, picture)
+	err = h.pinCase.CreateNewPin(r.Context(), newPin, pictures)
+	var notVerified *userUsecase.ErrEmailNotVerified
+	if errors.As(err, &notVerified) {
+		h.responseErr(w, r, err)
+		return
+	}
 	if err != nil {
 		logger.Error(err.Error())
 		if err == img.ErrExplicitImage {
 			err = responseError(w, "explicit_pin", err.Error())
+		} else if err == usecase.ErrPicturesNumber {
+			err = responseError(w, "bad_pictures", err.Error())
+		} else if err == usecase.ErrBadPublishTime {
+			err = responseError(w, "bad_publish_at", err.Error())
+		} else if err == usecase.ErrBadSourceURL {
+			err = responseError(w, "bad_source_url", err.Error())
 		} else {
 			err = responseError(w, "add_pin", "failed to create pin")
 		}
@@ -134,17 +169,43 @@ func (h *HandlerHTTP) EditPin(w http.ResponseWriter, r *http.Request) {
 	_, _ = userID, pinID
 
 	pinUpdate := &usecase.PinUpdateData{}
-	err = easyjson.UnmarshalFromReader(r.Body, pinUpdate)
 	defer r.Body.Close()
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		// the new pictures come as the files, the rest of the data as the json in the data field
+		err = r.ParseMultipartForm(MaxMemoryParseFormData)
+		if err == nil && r.FormValue("data") != "" {
+			err = easyjson.Unmarshal([]byte(r.FormValue("data")), pinUpdate)
+		}
+		if err == nil && len(r.MultipartForm.File) != 0 {
+			var closePictures func()
+			pinUpdate.Pictures, closePictures, err = openPictures(r.MultipartForm)
+			if err == nil {
+				defer closePictures()
+			}
+		}
+	} else {
+		err = easyjson.UnmarshalFromReader(r.Body, pinUpdate)
+	}
 	if err != nil {
 		logger.Info(err.Error())
 		err = responseError(w, "parse_body", "could not read the data to change")
 		if err != nil {
 			logger.Error(err.Error())
 		}
+		return
 	}
 	err = h.pinCase.EditPinByID(r.Context(), int(pinID), userID, pinUpdate)
-	if err != nil {
+	if err == img.ErrExplicitImage {
+		err = responseError(w, "explicit_pin", err.Error())
+	} else if err == usecase.ErrPicturesNumber {
+		err = responseError(w, "bad_pictures", err.Error())
+	} else if err == usecase.ErrBadPublishTime {
+		err = responseError(w, "bad_publish_at", err.Error())
+	} else if err == usecase.ErrBadSourceURL {
+		err = responseError(w, "bad_source_url", err.Error())
+	} else if err == usecase.ErrForbiddenAction {
+		err = responseError(w, "no_access", "the pin is not available for editing")
+	} else if err != nil {
 		logger.Error(err.Error())
 		err = responseError(w, "edit_pin", "internal error")
 	} else {
@@ -185,3 +246,69 @@ func (h *HandlerHTTP) ViewPin(w http.ResponseWriter, r *http.Request) {
 		logger.Error(err.Error())
 	}
 }
+
+// OpenPinSource redirects to the source url of the pin counting the outbound click.
+func (h *HandlerHTTP) OpenPinSource(w http.ResponseWriter, r *http.Request) {
+	logger := h.getRequestLogger(r)
+
+	pinID, err := strconv.ParseInt(chi.URLParam(r, "pinID"), 10, 64)
+	if err != nil {
+		logger.Error(err.Error())
+		err = responseError(w, "parse_url", "internal error")
+		if err != nil {
+			logger.Error(err.Error())
+		}
+		return
+	}
+
+	userID, ok := r.Context().Value(auth.KeyCurrentUserID).(int)
+	if !ok {
+		userID = user.UserUnknown
+	}
+	sourceURL, err := h.pinCase.OpenSourceURL(r.Context(), int(pinID), userID)
+	if err == nil {
+		http.Redirect(w, r, sourceURL, http.StatusFound)
+		return
+	}
+
+	if err == usecase.ErrNoSourceURL {
+		err = responseError(w, "no_source_url", err.Error())
+	} else if err == usecase.ErrPinNotAccess || err == usecase.ErrPinDeleted {
+		err = responseError(w, "pin_not_available", err.Error())
+	} else {
+		logger.Error(err.Error())
+		err = responseError(w, "open_source", "internal error")
+	}
+	if err != nil {
+		logger.Error(err.Error())
+	}
+}
+
+// openPictures opens the uploaded images of the pin in the order they were sent,
+// the single image may come in the picture field as before.
+func openPictures(form *multipart.Form) ([]usecase.Picture, func(), error) {
+	headers := append(form.File["picture"], form.File["pictures"]...)
+	pictures := make([]usecase.Picture, 0, len(headers))
+	closePictures := func() {
+		for _, picture := range pictures {
+			picture.Content.(multipart.File).Close()
+		}
+	}
+
+	for _, header := range headers {
+		file, err := header.Open()
+		if err != nil {
+			closePictures()
+			return nil, nil, err
+		}
+		pictures = append(pictures, usecase.Picture{
+			MimeType: header.Header.Get("Content-Type"),
+			Size:     header.Size,
+			Content:  file,
+		})
+	}
+	if len(pictures) == 0 {
+		return nil, nil, http.ErrMissingFile
+	}
+	return pictures, closePictures, nil
+}
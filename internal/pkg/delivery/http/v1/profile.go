@@ -25,6 +25,7 @@ func ToUserInfoFromService(user *userEntity.User, isSubscribed bool, subsCount i
 		About:        user.AboutMe.String,
 		IsSubscribed: isSubscribed,
 		SubsCount:    subsCount,
+		Private:      user.Private,
 	}
 }
 
@@ -34,6 +35,7 @@ func ToProfileInfoFromService(user *userEntity.User, subsCount int) structs.Prof
 		Username:  user.Username,
 		Avatar:    user.Avatar,
 		SubsCount: subsCount,
+		Private:   user.Private,
 	}
 }
 
@@ -71,7 +73,7 @@ func (h *HandlerHTTP) ProfileEditInfo(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		logger.Info("json decode: " + err.Error())
 		err = responseError(w, "parse_body",
-			"the request body must contain json with any of the fields: username, email, name, surname, password")
+			"the request body must contain json with any of the fields: username, email, name, surname, password, private")
 		if err != nil {
 			logger.Error(err.Error())
 		}
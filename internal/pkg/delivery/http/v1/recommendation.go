@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+)
+
+const (
+	defaultRecommendationCount = 10
+	maxRecommendationCount     = 50
+)
+
+// GetRecommendations godoc
+//
+//	@Description	Get the accounts suggested to the current user to follow, the most relevant go first.
+//	@Description	They are the followings of the followings, the authors of the liked pins and the co-authors of the boards
+//	@Tags			Subscription
+//	@Produce		json
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			count		query		int		false	"Count of the accounts"
+//	@Param			offset		query		int		false	"Offset returned with the previous page"
+//	@Success		200			{object}	JsonResponse{body=structs.Recommendations}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/user/recommendations [get]
+func (h *HandlerHTTP) GetRecommendations(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+
+	count, offset := defaultRecommendationCount, 0
+	invalidParams := map[string]string{}
+	if countParam := r.URL.Query().Get("count"); countParam != "" {
+		if c, err := strconv.ParseInt(countParam, 10, 64); err != nil || c <= 0 {
+			invalidParams["count"] = countParam
+		} else {
+			count = min(int(c), maxRecommendationCount)
+		}
+	}
+	if offsetParam := r.URL.Query().Get("offset"); offsetParam != "" {
+		if o, err := strconv.ParseInt(offsetParam, 10, 64); err != nil || o < 0 {
+			invalidParams["offset"] = offsetParam
+		} else {
+			offset = int(o)
+		}
+	}
+	if len(invalidParams) > 0 {
+		h.responseErr(w, r, &errHTTP.ErrInvalidQueryParam{Params: invalidParams})
+		return
+	}
+
+	recs, err := h.recommendationCase.GetRecommendations(r.Context(), userID, count, offset)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	body := structs.Recommendations{Users: recs, Offset: offset + len(recs)}
+	if err := responseOk(http.StatusOK, w, "got recommendations successfully", body); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
@@ -19,7 +19,7 @@ var (
 var (
 	userSortOpts  = []string{"id", "subscribers"}
 	boardSortOpts = []string{"id", "pins"}
-	pinSortOpts   = []string{"id", "likes"}
+	pinSortOpts   = []string{"id", "likes", "saves"}
 )
 
 var (
@@ -0,0 +1,17 @@
+package structs
+
+import errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+
+//go:generate easyjson account.go
+
+//easyjson:json
+type AccountDeletion struct {
+	Password *string `json:"password" example:"safe_pass"`
+}
+
+func (a *AccountDeletion) Validate() error {
+	if a.Password == nil {
+		return &errHTTP.ErrMissingBodyParams{Params: []string{"password"}}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package structs
+
+import (
+	"time"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+)
+
+//go:generate easyjson apitoken.go
+
+const (
+	maxAPITokenNameLen  = 64
+	maxAPITokenLifetime = 365
+)
+
+//easyjson:json
+type APITokenCreate struct {
+	Name          *string  `json:"name" example:"backup script"`
+	Scopes        []string `json:"scopes" example:"pins:read,boards:read"`
+	ExpiresInDays int      `json:"expires_in_days,omitempty" example:"30"`
+}
+
+func (a *APITokenCreate) Validate() error {
+	missing := []string{}
+	if a.Name == nil {
+		missing = append(missing, "name")
+	}
+	if a.Scopes == nil {
+		missing = append(missing, "scopes")
+	}
+	if len(missing) > 0 {
+		return &errHTTP.ErrMissingBodyParams{Params: missing}
+	}
+
+	invalid := []string{}
+	if len(*a.Name) == 0 || len(*a.Name) > maxAPITokenNameLen {
+		invalid = append(invalid, "name")
+	}
+	if a.ExpiresInDays < 0 || a.ExpiresInDays > maxAPITokenLifetime {
+		invalid = append(invalid, "expires_in_days")
+	}
+	if len(invalid) > 0 {
+		return &errHTTP.ErrInvalidBodyParams{Params: invalid}
+	}
+	return nil
+}
+
+// Lifetime is zero for the token which does not expire.
+func (a *APITokenCreate) Lifetime() time.Duration {
+	return time.Duration(a.ExpiresInDays) * 24 * time.Hour
+}
+
+//easyjson:json
+type APIToken struct {
+	ID         int        `json:"id" example:"7"`
+	Name       string     `json:"name" example:"backup script"`
+	Scopes     []string   `json:"scopes" example:"pins:read,boards:read"`
+	CreatedAt  time.Time  `json:"created_at" example:"2023-11-01T15:04:05Z"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" example:"2023-11-20T10:00:00Z"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty" example:"2023-12-01T15:04:05Z"`
+}
+
+// CreatedAPIToken has the secret which is shown only once.
+//
+//easyjson:json
+type CreatedAPIToken struct {
+	APIToken
+	Token string `json:"token" example:"pnsp_3f5a9c0e1b7d2a463f5a9c0e1b7d2a463f5a9c0e"`
+}
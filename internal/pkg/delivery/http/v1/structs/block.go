@@ -0,0 +1,11 @@
+package structs
+
+import "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+
+//go:generate easyjson block.go
+
+//easyjson:json
+type BlockedUsers struct {
+	Users  []user.User `json:"users"`
+	LastID int         `json:"lastID" example:"12"`
+}
@@ -44,6 +44,7 @@ type CertainBoardWithUsername struct {
 	PinsNumber     int      `json:"pins_number" example:"12"`
 	Pins           []string `json:"pins" example:"['/pic1', '/pic2']"`
 	Tags           []string `json:"tags" example:"['love', 'green']"`
+	Subscribers    int      `json:"subscribers_number" example:"5"`
 }
 
 //easyjson:json
@@ -51,6 +52,11 @@ type DeletePinFromBoard struct {
 	PinID int `json:"pin_id" example:"22"`
 }
 
+//easyjson:json
+type Repin struct {
+	PinID int `json:"pin_id" example:"22"`
+}
+
 func (data *BoardData) Validate() error {
 	if data.Title == nil || *data.Title == "" {
 		return errHTTP.ErrInvalidBoardTitle
@@ -0,0 +1,17 @@
+package structs
+
+import errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+
+//go:generate easyjson email.go
+
+//easyjson:json
+type EmailVerification struct {
+	Token *string `json:"token" example:"12.9c1185a5c5e9fc54612808977ee8f548"`
+}
+
+func (e *EmailVerification) Validate() error {
+	if e.Token == nil {
+		return &errHTTP.ErrMissingBodyParams{Params: []string{"token"}}
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package structs
+
+import "time"
+
+//go:generate easyjson export.go
+
+//easyjson:json
+type DataExport struct {
+	ID          string     `json:"id" example:"9b1c4e7f2a8d3f60"`
+	Status      string     `json:"status" example:"ready"`
+	CreatedAt   time.Time  `json:"created_at" example:"2023-11-01T15:04:05Z"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty" example:"2023-11-01T15:05:00Z"`
+	DownloadURL string     `json:"download_url,omitempty" example:"/api/v1/profile/export/9b1c4e7f2a8d3f60/download"`
+}
@@ -0,0 +1,139 @@
+package structs
+
+import (
+	"time"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+)
+
+//go:generate easyjson moderation.go
+
+const (
+	maxReasonLen      = 1000
+	maxSuspensionDays = 3650
+)
+
+//easyjson:json
+type ReportCreate struct {
+	TargetType *string `json:"target_type" example:"pin"`
+	TargetID   *int    `json:"target_id" example:"12"`
+	Reason     *string `json:"reason" example:"spam"`
+}
+
+func (r *ReportCreate) Validate() error {
+	missing := []string{}
+	if r.TargetType == nil {
+		missing = append(missing, "target_type")
+	}
+	if r.TargetID == nil {
+		missing = append(missing, "target_id")
+	}
+	if r.Reason == nil {
+		missing = append(missing, "reason")
+	}
+	if len(missing) > 0 {
+		return &errHTTP.ErrMissingBodyParams{Params: missing}
+	}
+	if !isValidReason(*r.Reason) {
+		return &errHTTP.ErrInvalidBodyParams{Params: []string{"reason"}}
+	}
+	return nil
+}
+
+//easyjson:json
+type Report struct {
+	ID         int        `json:"id" example:"3"`
+	ReporterID int        `json:"reporter_id" example:"41"`
+	TargetType string     `json:"target_type" example:"pin"`
+	TargetID   int        `json:"target_id" example:"12"`
+	Reason     string     `json:"reason" example:"spam"`
+	CreatedAt  time.Time  `json:"created_at" example:"2023-11-01T15:04:05Z"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty" example:"2023-11-02T10:00:00Z"`
+	ResolvedBy *int       `json:"resolved_by,omitempty" example:"1"`
+}
+
+//easyjson:json
+type ReportFeed struct {
+	Reports []Report `json:"reports"`
+	LastID  int      `json:"lastID" example:"3"`
+}
+
+// ModerationReason is recorded in the audit log with the action.
+//
+//easyjson:json
+type ModerationReason struct {
+	Reason *string `json:"reason" example:"spam"`
+}
+
+func (m *ModerationReason) Validate() error {
+	if m.Reason == nil {
+		return &errHTTP.ErrMissingBodyParams{Params: []string{"reason"}}
+	}
+	if !isValidReason(*m.Reason) {
+		return &errHTTP.ErrInvalidBodyParams{Params: []string{"reason"}}
+	}
+	return nil
+}
+
+// UserSuspension without days suspends the user until the suspension is lifted.
+//
+//easyjson:json
+type UserSuspension struct {
+	Reason *string `json:"reason" example:"spam"`
+	Days   int     `json:"days,omitempty" example:"7"`
+}
+
+func (u *UserSuspension) Validate() error {
+	if u.Reason == nil {
+		return &errHTTP.ErrMissingBodyParams{Params: []string{"reason"}}
+	}
+
+	invalid := []string{}
+	if !isValidReason(*u.Reason) {
+		invalid = append(invalid, "reason")
+	}
+	if u.Days < 0 || u.Days > maxSuspensionDays {
+		invalid = append(invalid, "days")
+	}
+	if len(invalid) > 0 {
+		return &errHTTP.ErrInvalidBodyParams{Params: invalid}
+	}
+	return nil
+}
+
+func (u *UserSuspension) Duration() time.Duration {
+	return time.Duration(u.Days) * 24 * time.Hour
+}
+
+//easyjson:json
+type RoleChange struct {
+	Role *string `json:"role" example:"moderator"`
+}
+
+func (r *RoleChange) Validate() error {
+	if r.Role == nil {
+		return &errHTTP.ErrMissingBodyParams{Params: []string{"role"}}
+	}
+	return nil
+}
+
+//easyjson:json
+type AuditEntry struct {
+	ID         int       `json:"id" example:"17"`
+	ActorID    *int      `json:"actor_id" example:"1"`
+	Action     string    `json:"action" example:"hide"`
+	TargetType string    `json:"target_type" example:"pin"`
+	TargetID   int       `json:"target_id" example:"12"`
+	Details    string    `json:"details" example:"spam"`
+	CreatedAt  time.Time `json:"created_at" example:"2023-11-01T15:04:05Z"`
+}
+
+//easyjson:json
+type AuditFeed struct {
+	Entries []AuditEntry `json:"entries"`
+	LastID  int          `json:"lastID" example:"17"`
+}
+
+func isValidReason(reason string) bool {
+	return len(reason) > 0 && len(reason) <= maxReasonLen
+}
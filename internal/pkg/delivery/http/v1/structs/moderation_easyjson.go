@@ -0,0 +1,844 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package structs
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs(in *jlexer.Lexer, out *UserSuspension) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reason":
+			if in.IsNull() {
+				in.Skip()
+				out.Reason = nil
+			} else {
+				if out.Reason == nil {
+					out.Reason = new(string)
+				}
+				*out.Reason = string(in.String())
+			}
+		case "days":
+			out.Days = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs(out *jwriter.Writer, in UserSuspension) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix[1:])
+		if in.Reason == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Reason))
+		}
+	}
+	if in.Days != 0 {
+		const prefix string = ",\"days\":"
+		out.RawString(prefix)
+		out.Int(int(in.Days))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserSuspension) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserSuspension) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserSuspension) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserSuspension) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs(l, v)
+}
+func easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs1(in *jlexer.Lexer, out *RoleChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "role":
+			if in.IsNull() {
+				in.Skip()
+				out.Role = nil
+			} else {
+				if out.Role == nil {
+					out.Role = new(string)
+				}
+				*out.Role = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs1(out *jwriter.Writer, in RoleChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix[1:])
+		if in.Role == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Role))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoleChange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoleChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoleChange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoleChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs1(l, v)
+}
+func easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs2(in *jlexer.Lexer, out *ReportFeed) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reports":
+			if in.IsNull() {
+				in.Skip()
+				out.Reports = nil
+			} else {
+				in.Delim('[')
+				if out.Reports == nil {
+					if !in.IsDelim(']') {
+						out.Reports = make([]Report, 0, 0)
+					} else {
+						out.Reports = []Report{}
+					}
+				} else {
+					out.Reports = (out.Reports)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 Report
+					(v1).UnmarshalEasyJSON(in)
+					out.Reports = append(out.Reports, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lastID":
+			out.LastID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs2(out *jwriter.Writer, in ReportFeed) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reports\":"
+		out.RawString(prefix[1:])
+		if in.Reports == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Reports {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"lastID\":"
+		out.RawString(prefix)
+		out.Int(int(in.LastID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReportFeed) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReportFeed) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReportFeed) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReportFeed) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs2(l, v)
+}
+func easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs3(in *jlexer.Lexer, out *ReportCreate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "target_type":
+			if in.IsNull() {
+				in.Skip()
+				out.TargetType = nil
+			} else {
+				if out.TargetType == nil {
+					out.TargetType = new(string)
+				}
+				*out.TargetType = string(in.String())
+			}
+		case "target_id":
+			if in.IsNull() {
+				in.Skip()
+				out.TargetID = nil
+			} else {
+				if out.TargetID == nil {
+					out.TargetID = new(int)
+				}
+				*out.TargetID = int(in.Int())
+			}
+		case "reason":
+			if in.IsNull() {
+				in.Skip()
+				out.Reason = nil
+			} else {
+				if out.Reason == nil {
+					out.Reason = new(string)
+				}
+				*out.Reason = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs3(out *jwriter.Writer, in ReportCreate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"target_type\":"
+		out.RawString(prefix[1:])
+		if in.TargetType == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.TargetType))
+		}
+	}
+	{
+		const prefix string = ",\"target_id\":"
+		out.RawString(prefix)
+		if in.TargetID == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.TargetID))
+		}
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		if in.Reason == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Reason))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReportCreate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReportCreate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReportCreate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReportCreate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs3(l, v)
+}
+func easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs4(in *jlexer.Lexer, out *Report) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "reporter_id":
+			out.ReporterID = int(in.Int())
+		case "target_type":
+			out.TargetType = string(in.String())
+		case "target_id":
+			out.TargetID = int(in.Int())
+		case "reason":
+			out.Reason = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "resolved_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ResolvedAt = nil
+			} else {
+				if out.ResolvedAt == nil {
+					out.ResolvedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ResolvedAt).UnmarshalJSON(data))
+				}
+			}
+		case "resolved_by":
+			if in.IsNull() {
+				in.Skip()
+				out.ResolvedBy = nil
+			} else {
+				if out.ResolvedBy == nil {
+					out.ResolvedBy = new(int)
+				}
+				*out.ResolvedBy = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs4(out *jwriter.Writer, in Report) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"reporter_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReporterID))
+	}
+	{
+		const prefix string = ",\"target_type\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetType))
+	}
+	{
+		const prefix string = ",\"target_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.TargetID))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if in.ResolvedAt != nil {
+		const prefix string = ",\"resolved_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ResolvedAt).MarshalJSON())
+	}
+	if in.ResolvedBy != nil {
+		const prefix string = ",\"resolved_by\":"
+		out.RawString(prefix)
+		out.Int(int(*in.ResolvedBy))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Report) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Report) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Report) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Report) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs4(l, v)
+}
+func easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs5(in *jlexer.Lexer, out *ModerationReason) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reason":
+			if in.IsNull() {
+				in.Skip()
+				out.Reason = nil
+			} else {
+				if out.Reason == nil {
+					out.Reason = new(string)
+				}
+				*out.Reason = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs5(out *jwriter.Writer, in ModerationReason) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix[1:])
+		if in.Reason == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Reason))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationReason) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationReason) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationReason) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationReason) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs5(l, v)
+}
+func easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs6(in *jlexer.Lexer, out *AuditFeed) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "entries":
+			if in.IsNull() {
+				in.Skip()
+				out.Entries = nil
+			} else {
+				in.Delim('[')
+				if out.Entries == nil {
+					if !in.IsDelim(']') {
+						out.Entries = make([]AuditEntry, 0, 0)
+					} else {
+						out.Entries = []AuditEntry{}
+					}
+				} else {
+					out.Entries = (out.Entries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 AuditEntry
+					(v4).UnmarshalEasyJSON(in)
+					out.Entries = append(out.Entries, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lastID":
+			out.LastID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs6(out *jwriter.Writer, in AuditFeed) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"entries\":"
+		out.RawString(prefix[1:])
+		if in.Entries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Entries {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"lastID\":"
+		out.RawString(prefix)
+		out.Int(int(in.LastID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AuditFeed) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AuditFeed) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AuditFeed) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AuditFeed) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs6(l, v)
+}
+func easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs7(in *jlexer.Lexer, out *AuditEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "actor_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ActorID = nil
+			} else {
+				if out.ActorID == nil {
+					out.ActorID = new(int)
+				}
+				*out.ActorID = int(in.Int())
+			}
+		case "action":
+			out.Action = string(in.String())
+		case "target_type":
+			out.TargetType = string(in.String())
+		case "target_id":
+			out.TargetID = int(in.Int())
+		case "details":
+			out.Details = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs7(out *jwriter.Writer, in AuditEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"actor_id\":"
+		out.RawString(prefix)
+		if in.ActorID == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.ActorID))
+		}
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"target_type\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetType))
+	}
+	{
+		const prefix string = ",\"target_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.TargetID))
+	}
+	{
+		const prefix string = ",\"details\":"
+		out.RawString(prefix)
+		out.String(string(in.Details))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AuditEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AuditEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE913b498EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AuditEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AuditEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE913b498DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryHttpV1Structs7(l, v)
+}
@@ -0,0 +1,35 @@
+package structs
+
+import errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+
+//go:generate easyjson oidc.go
+
+//easyjson:json
+type OIDCProviders struct {
+	Providers []string `json:"providers" example:"google,gitlab"`
+}
+
+//easyjson:json
+type OIDCAuthURL struct {
+	URL string `json:"url" example:"https://accounts.google.com/o/oauth2/v2/auth?client_id=pinspire&state=7f3d9a1c"`
+}
+
+//easyjson:json
+type OIDCCallback struct {
+	State *string `json:"state" example:"7f3d9a1c5e2b8d40a6c1f9e3b7d2a5c8"`
+	Code  *string `json:"code" example:"4/0AfJohXn2"`
+}
+
+func (o *OIDCCallback) Validate() error {
+	missing := []string{}
+	if o.State == nil {
+		missing = append(missing, "state")
+	}
+	if o.Code == nil {
+		missing = append(missing, "code")
+	}
+	if len(missing) > 0 {
+		return &errHTTP.ErrMissingBodyParams{Params: missing}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package structs
+
+import errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+
+//go:generate easyjson password.go
+
+//easyjson:json
+type PasswordChange struct {
+	OldPassword *string `json:"old_password" example:"safe_pass"`
+	NewPassword *string `json:"new_password" example:"safer_pass"`
+}
+
+func (p *PasswordChange) Validate() error {
+	missing := []string{}
+	if p.OldPassword == nil {
+		missing = append(missing, "old_password")
+	}
+	if p.NewPassword == nil {
+		missing = append(missing, "new_password")
+	}
+	if len(missing) > 0 {
+		return &errHTTP.ErrMissingBodyParams{Params: missing}
+	}
+	return nil
+}
+
+//easyjson:json
+type PasswordResetRequest struct {
+	Email *string `json:"email" example:"clickkk@gmail.com"`
+}
+
+func (p *PasswordResetRequest) Validate() error {
+	if p.Email == nil {
+		return &errHTTP.ErrMissingBodyParams{Params: []string{"email"}}
+	}
+	return nil
+}
+
+//easyjson:json
+type PasswordResetConfirm struct {
+	Token       *string `json:"token" example:"9c1185a5c5e9fc54612808977ee8f548"`
+	NewPassword *string `json:"new_password" example:"safer_pass"`
+}
+
+func (p *PasswordResetConfirm) Validate() error {
+	missing := []string{}
+	if p.Token == nil {
+		missing = append(missing, "token")
+	}
+	if p.NewPassword == nil {
+		missing = append(missing, "new_password")
+	}
+	if len(missing) > 0 {
+		return &errHTTP.ErrMissingBodyParams{Params: missing}
+	}
+	return nil
+}
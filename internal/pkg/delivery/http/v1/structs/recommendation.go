@@ -0,0 +1,11 @@
+package structs
+
+import "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+
+//go:generate easyjson recommendation.go
+
+//easyjson:json
+type Recommendations struct {
+	Users  []user.Recommendation `json:"users"`
+	Offset int                   `json:"offset" example:"20"`
+}
@@ -0,0 +1,16 @@
+package structs
+
+import "time"
+
+//go:generate easyjson session.go
+
+//easyjson:json
+type SessionInfo struct {
+	ID        string    `json:"id" example:"3f5a9c0e1b7d2a46"`
+	UserAgent string    `json:"user_agent" example:"Mozilla/5.0 (X11; Linux x86_64)"`
+	IP        string    `json:"ip" example:"93.184.216.34"`
+	CreatedAt time.Time `json:"created_at" example:"2023-11-01T15:04:05Z"`
+	LastSeen  time.Time `json:"last_seen" example:"2023-11-20T10:00:00Z"`
+	Expire    time.Time `json:"expire" example:"2023-12-01T15:04:05Z"`
+	Current   bool      `json:"current" example:"true"`
+}
@@ -1,6 +1,11 @@
 package structs
 
-import errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+import (
+	"time"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+	userEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+)
 
 //go:generate easyjson subscription.go
 
@@ -15,3 +20,35 @@ func (s *SubscriptionAction) Validate() error {
 	}
 	return nil
 }
+
+//easyjson:json
+type SubscriptionStatus struct {
+	Requested bool `json:"requested" example:"true"`
+}
+
+//easyjson:json
+type FollowRequest struct {
+	ID        int       `json:"id" example:"7"`
+	UserID    int       `json:"user_id" example:"2"`
+	Username  string    `json:"username" example:"baobab"`
+	Avatar    string    `json:"avatar" example:"/pic1"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+//easyjson:json
+type FollowRequestFeed struct {
+	Requests []FollowRequest `json:"requests"`
+	LastID   int             `json:"lastID" example:"7"`
+}
+
+//easyjson:json
+type FollowList struct {
+	Users  []userEntity.SubscriptionUser `json:"users"`
+	LastID int                           `json:"lastID" example:"12"`
+}
+
+//easyjson:json
+type KnownFollowers struct {
+	Users []userEntity.SubscriptionUser `json:"users"`
+	Count int                           `json:"count" example:"5"`
+}
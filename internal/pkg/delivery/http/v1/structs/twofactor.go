@@ -0,0 +1,53 @@
+package structs
+
+import errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+
+//go:generate easyjson twofactor.go
+
+//easyjson:json
+type SecondFactorChallenge struct {
+	Token string `json:"second_factor_token" example:"d8d1f3c3b1e04a0c9c1185a5c5e9fc54"`
+}
+
+//easyjson:json
+type SecondFactorLogin struct {
+	Token *string `json:"second_factor_token" example:"d8d1f3c3b1e04a0c9c1185a5c5e9fc54"`
+	Code  *string `json:"code" example:"287082"`
+}
+
+func (s *SecondFactorLogin) Validate() error {
+	missing := []string{}
+	if s.Token == nil {
+		missing = append(missing, "second_factor_token")
+	}
+	if s.Code == nil {
+		missing = append(missing, "code")
+	}
+	if len(missing) > 0 {
+		return &errHTTP.ErrMissingBodyParams{Params: missing}
+	}
+	return nil
+}
+
+//easyjson:json
+type TOTPCode struct {
+	Code *string `json:"code" example:"287082"`
+}
+
+func (t *TOTPCode) Validate() error {
+	if t.Code == nil {
+		return &errHTTP.ErrMissingBodyParams{Params: []string{"code"}}
+	}
+	return nil
+}
+
+//easyjson:json
+type TOTPEnrollment struct {
+	Secret string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	URI    string `json:"uri" example:"otpauth://totp/Pinspire:clicker123?issuer=Pinspire&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+}
+
+//easyjson:json
+type RecoveryCodes struct {
+	Codes []string `json:"recovery_codes" example:"9c1185a5c5,612808977e"`
+}
@@ -12,6 +12,7 @@ type UserInfo struct {
 	About        string `json:"about" example:"Cool guy"`
 	IsSubscribed bool   `json:"is_subscribed" example:"true"`
 	SubsCount    int    `json:"subscribers" example:"23"`
+	Private      bool   `json:"private" example:"false"`
 }
 
 //easyjson:json
@@ -20,4 +21,5 @@ type ProfileInfo struct {
 	Username  string `json:"username" example:"baobab"`
 	Avatar    string `json:"avatar" example:"/pic1"`
 	SubsCount int    `json:"subscribers" example:"12"`
+	Private   bool   `json:"private" example:"false"`
 }
@@ -52,6 +52,8 @@ func easyjson9e1087fdDecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryH
 			out.IsSubscribed = bool(in.Bool())
 		case "subscribers":
 			out.SubsCount = int(in.Int())
+		case "private":
+			out.Private = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -106,6 +108,11 @@ func easyjson9e1087fdEncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryH
 		out.RawString(prefix)
 		out.Int(int(in.SubsCount))
 	}
+	{
+		const prefix string = ",\"private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Private))
+	}
 	out.RawByte('}')
 }
 
@@ -159,6 +166,8 @@ func easyjson9e1087fdDecodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryH
 			out.Avatar = string(in.String())
 		case "subscribers":
 			out.SubsCount = int(in.Int())
+		case "private":
+			out.Private = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -193,6 +202,11 @@ func easyjson9e1087fdEncodeGithubComGoParkMailRu20232ONDTeamInternalPkgDeliveryH
 		out.RawString(prefix)
 		out.Int(int(in.SubsCount))
 	}
+	{
+		const prefix string = ",\"private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Private))
+	}
 	out.RawByte('}')
 }
 
@@ -18,6 +18,8 @@ var (
 	subscriptionsView = "subscriptions"
 	subscribersView   = "subscribers"
 	maxCount          = 50
+
+	knownFollowersPreview = 3
 )
 
 func (h *HandlerHTTP) Subscribe(w http.ResponseWriter, r *http.Request) {
@@ -39,12 +41,19 @@ func (h *HandlerHTTP) Subscribe(w http.ResponseWriter, r *http.Request) {
 	}
 
 	from := r.Context().Value(auth.KeyCurrentUserID).(int)
-	if err := h.subCase.SubscribeToUser(r.Context(), from, *sub.To); err != nil {
-		h.responseErr(w, r, err)
-	} else if err := responseOk(http.StatusOK, w, "subscribed successfully", nil); err != nil {
+	requested, err := h.subCase.SubscribeToUser(r.Context(), from, *sub.To)
+	if err != nil {
 		h.responseErr(w, r, err)
+		return
 	}
 
+	message := "subscribed successfully"
+	if requested {
+		message = "subscription request has been sent successfully"
+	}
+	if err := responseOk(http.StatusOK, w, message, structs.SubscriptionStatus{Requested: requested}); err != nil {
+		h.responseErr(w, r, err)
+	}
 }
 
 func (h *HandlerHTTP) Unsubscribe(w http.ResponseWriter, r *http.Request) {
@@ -86,6 +95,215 @@ func (h *HandlerHTTP) GetSubscriptionInfoForUser(w http.ResponseWriter, r *http.
 	}
 }
 
+// GetFollowers godoc
+//
+//	@Description	Get the followers of the user from the newest. Each entry tells whether the user follows it back
+//	@Description	and whether the current user is subscribed to it
+//	@Tags			Subscription
+//	@Produce		json
+//	@Param			userID		path		int		true	"Id of the user"
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			count		query		int		true	"Count of the followers"
+//	@Param			lastID		query		int		false	"Id of the last follower of the previous page"
+//	@Success		200			{object}	JsonResponse{body=structs.FollowList}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/subscription/user/{userID}/followers [get]
+func (h *HandlerHTTP) GetFollowers(w http.ResponseWriter, r *http.Request) {
+	h.getFollowList(w, r, subscribersView)
+}
+
+// GetFollowing godoc
+//
+//	@Description	Get the users the user is subscribed to from the newest. Each entry tells whether it follows the user back
+//	@Description	and whether the current user is subscribed to it
+//	@Tags			Subscription
+//	@Produce		json
+//	@Param			userID		path		int		true	"Id of the user"
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			count		query		int		true	"Count of the users"
+//	@Param			lastID		query		int		false	"Id of the last user of the previous page"
+//	@Success		200			{object}	JsonResponse{body=structs.FollowList}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		403			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/subscription/user/{userID}/following [get]
+func (h *HandlerHTTP) GetFollowing(w http.ResponseWriter, r *http.Request) {
+	h.getFollowList(w, r, subscriptionsView)
+}
+
+func (h *HandlerHTTP) getFollowList(w http.ResponseWriter, r *http.Request, view string) {
+	userID, err := h.urlParamID(r, "userID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	count, lastID, err := FetchValidParamForLoadFeed(r.URL)
+	if err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidQueryParam{Params: map[string]string{
+			"count": r.URL.Query().Get("count"), "lastID": r.URL.Query().Get("lastID"),
+		}})
+		return
+	}
+
+	users, err := h.subCase.GetSubscriptionInfoForUser(r.Context(), &userEntity.SubscriptionOpts{
+		UserID: userID,
+		Count:  count,
+		LastID: lastID,
+		Filter: view,
+	})
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	list := structs.FollowList{Users: users, LastID: lastID}
+	if len(users) != 0 {
+		list.LastID = users[len(users)-1].ID
+	}
+	if err := responseOk(http.StatusOK, w, "got "+view+" successfully", list); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// GetKnownFollowers godoc
+//
+//	@Description	Get the summary of the followers of the user that the current user is subscribed to:
+//	@Description	the first of them and their total count
+//	@Tags			Subscription
+//	@Produce		json
+//	@Param			userID		path		int		true	"Id of the user"
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse{body=structs.KnownFollowers}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/subscription/user/{userID}/followers/known [get]
+func (h *HandlerHTTP) GetKnownFollowers(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.urlParamID(r, "userID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	users, total, err := h.subCase.GetKnownFollowers(r.Context(), userID, knownFollowersPreview)
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "got known followers successfully",
+		structs.KnownFollowers{Users: users, Count: total}); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// GetFollowRequests godoc
+//
+//	@Description	Get the pending subscription requests to the private profile of the current user from the newest
+//	@Tags			Subscription
+//	@Produce		json
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			count		query		int		true	"Count of the requests"
+//	@Param			lastID		query		int		false	"Id of the last request of the previous page"
+//	@Success		200			{object}	JsonResponse{body=structs.FollowRequestFeed}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/subscription/user/requests [get]
+func (h *HandlerHTTP) GetFollowRequests(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	count, lastID, err := FetchValidParamForLoadFeed(r.URL)
+	if err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidQueryParam{Params: map[string]string{
+			"count": r.URL.Query().Get("count"), "lastID": r.URL.Query().Get("lastID"),
+		}})
+		return
+	}
+
+	requests, err := h.subCase.GetFollowRequests(r.Context(), userID, count, lastID)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	feed := structs.FollowRequestFeed{Requests: make([]structs.FollowRequest, 0, len(requests)), LastID: lastID}
+	for _, req := range requests {
+		feed.Requests = append(feed.Requests, structs.FollowRequest{
+			ID:        req.ID,
+			UserID:    req.From.ID,
+			Username:  req.From.Username,
+			Avatar:    req.From.Avatar,
+			CreatedAt: req.CreatedAt,
+		})
+	}
+	if len(requests) != 0 {
+		feed.LastID = requests[len(requests)-1].ID
+	}
+
+	if err := responseOk(http.StatusOK, w, "got subscription requests successfully", feed); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// ApproveFollowRequest godoc
+//
+//	@Description	Approve the subscription request, its sender becomes the follower of the current user
+//	@Tags			Subscription
+//	@Produce		json
+//	@Param			requestID	path		int		true	"Id of the subscription request"
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/subscription/user/requests/{requestID}/approve [put]
+func (h *HandlerHTTP) ApproveFollowRequest(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	requestID, err := h.urlParamID(r, "requestID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if err := h.subCase.ApproveFollowRequest(r.Context(), userID, requestID); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "subscription request has been approved", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// DenyFollowRequest godoc
+//
+//	@Description	Deny the subscription request
+//	@Tags			Subscription
+//	@Produce		json
+//	@Param			requestID	path		int		true	"Id of the subscription request"
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		404			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/subscription/user/requests/{requestID} [delete]
+func (h *HandlerHTTP) DenyFollowRequest(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(auth.KeyCurrentUserID).(int)
+	requestID, err := h.urlParamID(r, "requestID")
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if err := h.subCase.DenyFollowRequest(r.Context(), userID, requestID); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "subscription request has been denied", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
 func GetOpts(r *http.Request) (*userEntity.SubscriptionOpts, error) {
 	opts := &userEntity.SubscriptionOpts{}
 	invalidParams := map[string]string{}
@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"net/http"
+
+	errHTTP "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/delivery/http/v1/structs"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+	"github.com/mailru/easyjson"
+)
+
+// LoginSecondFactor godoc
+//
+//	@Description	Finish the login of the user with two-factor authentication, creating new session
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		structs.SecondFactorLogin	true	"Token from the login response and TOTP or recovery code"
+//	@Success		201		{object}	JsonResponse
+//	@Failure		400		{object}	JsonErrResponse
+//	@Failure		401		{object}	JsonErrResponse
+//	@Failure		500		{object}	JsonErrResponse
+//	@Header			201		{string}	session_key	"Auth cookie with new valid session id"
+//	@Router			/api/v1/auth/login/2fa [post]
+func (h *HandlerHTTP) LoginSecondFactor(w http.ResponseWriter, r *http.Request) {
+	if contentType := r.Header.Get("Content-Type"); contentType != ApplicationJson {
+		h.responseErr(w, r, &errHTTP.ErrInvalidContentType{PreferredType: ApplicationJson})
+		return
+	}
+
+	params := structs.SecondFactorLogin{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &params); err != nil {
+		h.responseErr(w, r, &errHTTP.ErrInvalidBody{})
+		return
+	}
+	defer r.Body.Close()
+	if err := params.Validate(); err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	sess, err := h.authCase.LoginSecondFactor(r.Context(), *params.Token, *params.Code, auth.RequestMetadata(r))
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	http.SetCookie(w, auth.NewSessionCookie(sess.Key, sess.Expire))
+	if err := responseOk(http.StatusCreated, w, "a new session has been created for the user", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// EnrollTOTP godoc
+//
+//	@Description	Generate a new TOTP secret for the user, it is required on login after activation
+//	@Tags			Auth
+//	@Produce		json
+//	@Param			session_key	header		string	false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Success		200			{object}	JsonResponse{body=structs.TOTPEnrollment}
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		409			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/auth/2fa/enroll [post]
+func (h *HandlerHTTP) EnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	sess, err := currentSession(r)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	secret, uri, err := h.authCase.EnrollTOTP(r.Context(), sess)
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "the totp secret has been generated",
+		structs.TOTPEnrollment{Secret: secret, URI: uri}); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// ActivateTOTP godoc
+//
+//	@Description	Enable two-factor authentication confirming the enrolled secret with a code, get recovery codes
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			session_key	header		string				false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			body		body		structs.TOTPCode	true	"TOTP code"
+//	@Success		200			{object}	JsonResponse{body=structs.RecoveryCodes}
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		409			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/auth/2fa/activate [post]
+func (h *HandlerHTTP) ActivateTOTP(w http.ResponseWriter, r *http.Request) {
+	sess, code, err := h.parseTOTPRequest(r)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	recoveryCodes, err := h.authCase.ActivateTOTP(r.Context(), sess, code)
+	if err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "two-factor authentication has been enabled",
+		structs.RecoveryCodes{Codes: recoveryCodes}); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+// DisableTOTP godoc
+//
+//	@Description	Disable two-factor authentication, a TOTP or recovery code is required
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			session_key	header		string				false	"Auth session id"	example(senjs7rvdnrgkjdr)
+//	@Param			body		body		structs.TOTPCode	true	"TOTP or recovery code"
+//	@Success		200			{object}	JsonResponse
+//	@Failure		400			{object}	JsonErrResponse
+//	@Failure		401			{object}	JsonErrResponse
+//	@Failure		409			{object}	JsonErrResponse
+//	@Failure		500			{object}	JsonErrResponse
+//	@Router			/api/v1/auth/2fa/disable [post]
+func (h *HandlerHTTP) DisableTOTP(w http.ResponseWriter, r *http.Request) {
+	sess, code, err := h.parseTOTPRequest(r)
+	if err != nil {
+		h.responseErr(w, r, err)
+		return
+	}
+
+	if err := h.authCase.DisableTOTP(r.Context(), sess, code); err != nil {
+		h.responseErr(w, r, err)
+	} else if err := responseOk(http.StatusOK, w, "two-factor authentication has been disabled", nil); err != nil {
+		h.responseErr(w, r, err)
+	}
+}
+
+func (h *HandlerHTTP) parseTOTPRequest(r *http.Request) (*session.Session, string, error) {
+	if contentType := r.Header.Get("Content-Type"); contentType != ApplicationJson {
+		return nil, "", &errHTTP.ErrInvalidContentType{PreferredType: ApplicationJson}
+	}
+
+	sess, err := currentSession(r)
+	if err != nil {
+		return nil, "", err
+	}
+
+	params := structs.TOTPCode{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &params); err != nil {
+		return nil, "", &errHTTP.ErrInvalidBody{}
+	}
+	defer r.Body.Close()
+	if err := params.Validate(); err != nil {
+		return nil, "", err
+	}
+	return sess, *params.Code, nil
+}
+
+func currentSession(r *http.Request) (*session.Session, error) {
+	cookie, err := r.Cookie("session_key")
+	if err != nil {
+		return nil, &errPkg.ErrNotAuthenticated{}
+	}
+	return &session.Session{
+		Key:    cookie.Value,
+		UserID: r.Context().Value(auth.KeyCurrentUserID).(int),
+	}, nil
+}
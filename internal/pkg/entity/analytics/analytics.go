@@ -0,0 +1,78 @@
+package analytics
+
+import "time"
+
+type EventType uint8
+
+const (
+	EventImpression EventType = iota
+	EventCloseup
+	EventLike
+	EventSave
+	EventClick
+)
+
+// Periods are the numbers of the last days the analytics is reported for.
+var Periods = []int{7, 30, 90}
+
+// Event is a single interaction of a user with the pin.
+type Event struct {
+	PinID int
+	Type  EventType
+	Time  time.Time
+}
+
+type Counters struct {
+	Impressions int `json:"impressions"`
+	Closeups    int `json:"closeups"`
+	Likes       int `json:"likes"`
+	Saves       int `json:"saves"`
+	Clicks      int `json:"clicks"`
+} //@name AnalyticsCounters
+
+func (c *Counters) Add(event EventType) {
+	switch event {
+	case EventImpression:
+		c.Impressions++
+	case EventCloseup:
+		c.Closeups++
+	case EventLike:
+		c.Likes++
+	case EventSave:
+		c.Saves++
+	case EventClick:
+		c.Clicks++
+	}
+}
+
+func (c *Counters) Sum(other Counters) {
+	c.Impressions += other.Impressions
+	c.Closeups += other.Closeups
+	c.Likes += other.Likes
+	c.Saves += other.Saves
+	c.Clicks += other.Clicks
+}
+
+// PinDailyStat are the counters of the pin for the day in UTC.
+type PinDailyStat struct {
+	PinID int
+	Day   time.Time
+	Counters
+}
+
+type DailyStat struct {
+	Day time.Time `json:"day" example:"2023-11-01T00:00:00Z"`
+	Counters
+} //@name AnalyticsDay
+
+// Report is the time series of the counters for each of the last days with their total.
+type Report struct {
+	Days  int         `json:"days" example:"7"`
+	Total Counters    `json:"total"`
+	Stats []DailyStat `json:"stats"`
+} //@name AnalyticsReport
+
+// Day returns the start of the day of t in UTC.
+func Day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,56 @@
+package apitoken
+
+import "time"
+
+// The scopes limit the routes which can be accessed with the token. The routes without
+// a scope, such as the management of sessions and tokens, need the session of the user.
+const (
+	ScopePinsRead           = "pins:read"
+	ScopePinsWrite          = "pins:write"
+	ScopeBoardsRead         = "boards:read"
+	ScopeBoardsWrite        = "boards:write"
+	ScopeCommentsWrite      = "comments:write"
+	ScopeProfileRead        = "profile:read"
+	ScopeProfileWrite       = "profile:write"
+	ScopeSubscriptionsWrite = "subscriptions:write"
+	ScopeMessagesRead       = "messages:read"
+	ScopeMessagesWrite      = "messages:write"
+)
+
+var Scopes = []string{
+	ScopePinsRead, ScopePinsWrite,
+	ScopeBoardsRead, ScopeBoardsWrite,
+	ScopeCommentsWrite,
+	ScopeProfileRead, ScopeProfileWrite,
+	ScopeSubscriptionsWrite,
+	ScopeMessagesRead, ScopeMessagesWrite,
+}
+
+// Token is the personal API token of the user, the secret itself is not stored.
+type Token struct {
+	ID         int
+	UserID     int
+	Name       string
+	Scopes     []string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	ExpiresAt  *time.Time
+}
+
+func IsValidScope(scope string) bool {
+	for _, s := range Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Token) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -22,10 +22,11 @@ type Board struct {
 
 //easyjson:json
 type BoardWithContent struct {
-	BoardInfo  Board
-	PinsNumber int
-	Pins       []string
-	TagTitles  []string
+	BoardInfo         Board
+	PinsNumber        int
+	Pins              []string
+	TagTitles         []string
+	SubscribersNumber int
 }
 
 func (b *Board) Sanitize(sanitizer *bluemonday.Policy) {
@@ -87,6 +87,8 @@ func easyjson202377feDecodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityBoa
 				}
 				in.Delim(']')
 			}
+		case "SubscribersNumber":
+			out.SubscribersNumber = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -143,6 +145,11 @@ func easyjson202377feEncodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityBoa
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"SubscribersNumber\":"
+		out.RawString(prefix)
+		out.Int(int(in.SubscribersNumber))
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,20 @@
+package export
+
+import "time"
+
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusReady   Status = "ready"
+	StatusFailed  Status = "failed"
+)
+
+// Export is a request of the user for the archive with all their data.
+type Export struct {
+	ID         string
+	UserID     int
+	Status     Status
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
@@ -0,0 +1,18 @@
+package identity
+
+// Identity links the account of the user at the external provider to the profile.
+type Identity struct {
+	Provider string
+	Subject  string
+	UserID   int
+	Email    string
+}
+
+// AuthRequest is kept between the redirect of the user to the provider and the callback.
+// LinkUserID is set when the logged in user links the identity to the profile.
+type AuthRequest struct {
+	Provider   string `json:"provider"`
+	Nonce      string `json:"nonce"`
+	Verifier   string `json:"verifier"`
+	LinkUserID int    `json:"link_user_id,omitempty"`
+}
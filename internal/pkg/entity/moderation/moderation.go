@@ -0,0 +1,56 @@
+package moderation
+
+import "time"
+
+// The types of the targets of the reports and the moderation actions.
+const (
+	TargetUser    = "user"
+	TargetPin     = "pin"
+	TargetBoard   = "board"
+	TargetComment = "comment"
+)
+
+const (
+	ActionSuspendUser   = "suspend_user"
+	ActionUnsuspendUser = "unsuspend_user"
+	ActionSetRole       = "set_role"
+	ActionHide          = "hide"
+	ActionUnhide        = "unhide"
+	ActionResolveReport = "resolve_report"
+)
+
+// Report is the complaint of the user about the content or another user.
+type Report struct {
+	ID         int
+	ReporterID int
+	TargetType string
+	TargetID   int
+	Reason     string
+	CreatedAt  time.Time
+	ResolvedAt *time.Time
+	ResolvedBy *int
+}
+
+// AuditEntry records the action of the staff member, the actor is nil after the account is purged.
+type AuditEntry struct {
+	ID         int
+	ActorID    *int
+	Action     string
+	TargetType string
+	TargetID   int
+	Details    string
+	CreatedAt  time.Time
+}
+
+func IsValidTarget(targetType string) bool {
+	switch targetType {
+	case TargetUser, TargetPin, TargetBoard, TargetComment:
+		return true
+	}
+	return false
+}
+
+// IsHideable reports whether the target is the content which can be hidden.
+func IsHideable(targetType string) bool {
+	return targetType == TargetPin || targetType == TargetBoard || targetType == TargetComment
+}
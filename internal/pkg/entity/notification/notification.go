@@ -14,6 +14,9 @@ const _defaultCapBuffer = 128
 const (
 	_ NotifyType = iota
 	NotifyComment
+	NotifyFollowRequest
+	NotifyFollowApproved
+	NotifyBoardPins
 
 	_notifyCustom
 )
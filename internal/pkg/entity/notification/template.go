@@ -1,5 +1,8 @@
 package notification
 
 var notifyTypeTemplate = map[NotifyType]string{
-	NotifyComment: `Пользователь {{.Username}} оставил комментарий под пином "{{.TitlePin}}".`,
+	NotifyComment:        `Пользователь {{.Username}} оставил комментарий под пином "{{.TitlePin}}".`,
+	NotifyFollowRequest:  `Пользователь {{.Username}} хочет подписаться на вас.`,
+	NotifyFollowApproved: `Пользователь {{.Username}} одобрил вашу заявку на подписку.`,
+	NotifyBoardPins:      `На доске "{{.TitleBoard}}" появились новые пины.`,
 }
@@ -8,6 +8,12 @@ func TypeString(t NotifyType) string {
 	switch t {
 	case NotifyComment:
 		return "comment"
+	case NotifyFollowRequest:
+		return "follow_request"
+	case NotifyFollowApproved:
+		return "follow_approved"
+	case NotifyBoardPins:
+		return "board_pins"
 	case _notifyCustom:
 		return "custom"
 	}
@@ -15,11 +15,20 @@ type FeedPinConfig struct {
 	Count      int
 	userID     int
 	boardID    int
+	viewerID   int
 	Protection protection
 	Liked      bool
 	Deleted    bool
 	hasUser    bool
 	hasBoard   bool
+	hasViewer  bool
+	pinIDs     []int
+	hasPins    bool
+	// FollowedBoards leaves only the pins of the boards the viewer is subscribed to
+	FollowedBoards bool
+	// Following is the home feed of the viewer built from the followed users, the followed boards
+	// and the tags of the liked pins
+	Following bool
 }
 
 func (cfg *FeedPinConfig) SetBoard(boardID int) {
@@ -32,6 +41,19 @@ func (cfg *FeedPinConfig) SetUser(userID int) {
 	cfg.hasUser = true
 }
 
+// SetViewer hides the pins of the users that have a block with the viewer
+// and shows the pins of the private profiles the viewer is subscribed to.
+func (cfg *FeedPinConfig) SetViewer(userID int) {
+	cfg.viewerID = userID
+	cfg.hasViewer = true
+}
+
+// SetPins leaves in the feed only the pins with the given ids.
+func (cfg *FeedPinConfig) SetPins(pinIDs []int) {
+	cfg.pinIDs = pinIDs
+	cfg.hasPins = true
+}
+
 func (cfg *FeedPinConfig) Board() (int, bool) {
 	return cfg.boardID, cfg.hasBoard
 }
@@ -40,6 +62,14 @@ func (cfg *FeedPinConfig) User() (int, bool) {
 	return cfg.userID, cfg.hasUser
 }
 
+func (cfg *FeedPinConfig) Viewer() (int, bool) {
+	return cfg.viewerID, cfg.hasViewer
+}
+
+func (cfg *FeedPinConfig) Pins() ([]int, bool) {
+	return cfg.pinIDs, cfg.hasPins
+}
+
 type protection int8
 
 const (
@@ -1,6 +1,8 @@
 package pin
 
 import (
+	"time"
+
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
@@ -14,12 +16,40 @@ type Pin struct {
 	Description pgtype.Text `json:"description" example:"about face"`
 	Public      bool        `json:"public"`
 
+	// SourceURL is the outbound link of the pin, its Preview is fetched after the pin is saved
+	SourceURL pgtype.Text  `json:"source_url" example:"https://example.com/recipes/pie"`
+	Preview   *LinkPreview `json:"preview,omitempty"`
+
+	// Images are all the images of the pin in their order, the first one is the Picture
+	Images []string `json:"images,omitempty" example:"['pinspire/imgs/image.png']"`
+	// Draft is seen only by its author until it is published
+	Draft bool `json:"draft"`
+	// PublishAt is the time the scheduled pin becomes published,
+	// in the feeds the pin is still ordered by the time it was created
+	PublishAt pgtype.Timestamptz `json:"publish_at"`
+
 	Tags      []Tag `json:"tags,omitempty"`
 	CountLike int   `json:"count_likes"`
+	CountSave int   `json:"count_saves"`
+	// Attribution is the chain of the pins this pin was saved from, the last one is the original pin
+	Attribution []Attribution `json:"attribution,omitempty"`
 
 	DeletedAt pgtype.Timestamptz `json:"-"`
 } //@name Pin
 
+// MaxImages is the maximum number of the images in the pin.
+const MaxImages = 10
+
+type Attribution struct {
+	PinID  int        `json:"pin_id" example:"12"`
+	Author *user.User `json:"author"`
+} //@name Attribution
+
+// Published reports whether the pin is visible to the users other than its author.
+func (p *Pin) Published() bool {
+	return !p.Draft && !p.PublishAt.Valid
+}
+
 func (p *Pin) SetTitle(title string) {
 	p.Title = pgtype.Text{
 		String: title,
@@ -27,6 +57,20 @@ func (p *Pin) SetTitle(title string) {
 	}
 }
 
+func (p *Pin) SetSourceURL(sourceURL string) {
+	p.SourceURL = pgtype.Text{
+		String: sourceURL,
+		Valid:  true,
+	}
+}
+
+func (p *Pin) SetPublishAt(publishAt time.Time) {
+	p.PublishAt = pgtype.Timestamptz{
+		Time:  publishAt,
+		Valid: true,
+	}
+}
+
 func (p *Pin) SetDescription(description string) {
 	p.Description = pgtype.Text{
 		String: description,
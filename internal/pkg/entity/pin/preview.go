@@ -0,0 +1,9 @@
+package pin
+
+// LinkPreview is the preview of the page by the source url of the pin.
+type LinkPreview struct {
+	URL         string `json:"url" example:"https://example.com/recipes/pie"`
+	Title       string `json:"title" example:"Apple pie"`
+	Description string `json:"description" example:"The classic recipe"`
+	Image       string `json:"image" example:"https://example.com/images/pie.jpg"`
+} //@name LinkPreview
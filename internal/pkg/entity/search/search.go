@@ -41,6 +41,7 @@ type PinForSearch struct {
 	Title   string `json:"title"`
 	Picture string `json:"picture"`
 	Likes   int    `json:"likes"`
+	Saves   int    `json:"saves"`
 }
 
 //easyjson:json
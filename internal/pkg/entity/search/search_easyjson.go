@@ -138,6 +138,8 @@ func easyjsonD4176298DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntitySea
 			out.Picture = string(in.String())
 		case "likes":
 			out.Likes = int(in.Int())
+		case "saves":
+			out.Saves = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -172,6 +174,11 @@ func easyjsonD4176298EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntitySea
 		out.RawString(prefix)
 		out.Int(int(in.Likes))
 	}
+	{
+		const prefix string = ",\"saves\":"
+		out.RawString(prefix)
+		out.Int(int(in.Saves))
+	}
 	out.RawByte('}')
 }
 
@@ -3,7 +3,16 @@ package session
 import "time"
 
 type Session struct {
+	ID     string
 	Key    string
 	UserID int
 	Expire time.Time
+	Metadata
+}
+
+type Metadata struct {
+	UserAgent string
+	IP        string
+	CreatedAt time.Time
+	LastSeen  time.Time
 }
@@ -0,0 +1,11 @@
+package user
+
+import "time"
+
+// FollowRequest is the request for the subscription to the private profile.
+type FollowRequest struct {
+	ID        int
+	From      User
+	To        User
+	CreatedAt time.Time
+}
@@ -19,6 +19,7 @@ type User struct {
 	Avatar   string      `json:"avatar" example:"pinspire.online/avatars/avatar.jpg"`
 	AboutMe  pgtype.Text `json:"about_me,omitempty"`
 	Password string      `json:"password,omitempty" example:"pass123"`
+	Private  bool        `json:"private,omitempty" example:"false"`
 } // @name User
 
 //easyjson:json
@@ -27,12 +28,27 @@ type SubscriptionUser struct {
 	Username                string `json:"username"`
 	Avatar                  string `json:"avatar"`
 	HasSubscribeFromCurUser bool   `json:"is_subscribed"`
+	IsMutual                bool   `json:"isMutual"`
 }
 
 func (u *SubscriptionUser) Sanitize(sanitizer *bluemonday.Policy) {
 	sanitizer.Sanitize(u.Username)
 }
 
+// Recommendation is the account suggested to follow, the higher the score the more relevant it is.
+//
+//easyjson:json
+type Recommendation struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Avatar   string `json:"avatar"`
+	Score    int    `json:"score"`
+}
+
+func (r *Recommendation) Sanitize(sanitizer *bluemonday.Policy) {
+	r.Username = sanitizer.Sanitize(r.Username)
+}
+
 type SubscriptionOpts struct {
 	UserID int
 	Count  int
@@ -58,6 +58,8 @@ func easyjson9e1087fdDecodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityUse
 			}
 		case "password":
 			out.Password = string(in.String())
+		case "private":
+			out.Private = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -118,6 +120,11 @@ func easyjson9e1087fdEncodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityUse
 		out.RawString(prefix)
 		out.String(string(in.Password))
 	}
+	if in.Private {
+		const prefix string = ",\"private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Private))
+	}
 	out.RawByte('}')
 }
 
@@ -171,6 +178,8 @@ func easyjson9e1087fdDecodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityUse
 			out.Avatar = string(in.String())
 		case "is_subscribed":
 			out.HasSubscribeFromCurUser = bool(in.Bool())
+		case "isMutual":
+			out.IsMutual = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -205,6 +214,11 @@ func easyjson9e1087fdEncodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityUse
 		out.RawString(prefix)
 		out.Bool(bool(in.HasSubscribeFromCurUser))
 	}
+	{
+		const prefix string = ",\"isMutual\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMutual))
+	}
 	out.RawByte('}')
 }
 
@@ -231,3 +245,90 @@ func (v *SubscriptionUser) UnmarshalJSON(data []byte) error {
 func (v *SubscriptionUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson9e1087fdDecodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityUser1(l, v)
 }
+func easyjson9e1087fdDecodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityUser2(in *jlexer.Lexer, out *Recommendation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "username":
+			out.Username = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "score":
+			out.Score = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9e1087fdEncodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityUser2(out *jwriter.Writer, in Recommendation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Recommendation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9e1087fdEncodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityUser2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Recommendation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9e1087fdEncodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityUser2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Recommendation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9e1087fdDecodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityUser2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Recommendation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9e1087fdDecodeGithubComGoParkMailRu20232ONDTeamInternalPkgEntityUser2(l, v)
+}
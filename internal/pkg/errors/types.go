@@ -21,6 +21,7 @@ const (
 	ErrNoAuth
 	ErrNotImplemented
 	ErrTimeout
+	ErrTooManyRequests
 )
 
 type DeclaredError interface {
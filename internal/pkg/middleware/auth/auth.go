@@ -2,18 +2,26 @@ package auth
 
 import (
 	"context"
+	"net"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	authCase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/auth"
 )
 
 type authContextValueKey string
 
 const (
-	KeyCurrentUserID authContextValueKey = "userID"
+	KeyCurrentUserID   authContextValueKey = "userID"
+	KeyCurrentUserRole authContextValueKey = "userRole"
+	keyAPIToken        authContextValueKey = "apiToken"
 
 	SessionCookieName string = "session_key"
+
+	bearerPrefix string = "Bearer "
 )
 
 type authMiddleware struct {
@@ -24,11 +32,30 @@ func NewAuthMiddleware(auth authCase.Usecase) authMiddleware {
 	return authMiddleware{auth}
 }
 
+// apiToken is the owner and the scopes of the token with which the request is authenticated.
+type apiToken struct {
+	userID int
+	scopes []string
+}
+
+// ContextWithUserID authenticates the request by the session cookie. The request with the API token
+// is authenticated only by the token, the user is set in the context by RequireScope.
 func (am authMiddleware) ContextWithUserID(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if cookie, err := r.Cookie(SessionCookieName); err == nil {
-			if userID, err := am.authCase.GetUserIDBySession(r.Context(), &session.Session{Key: cookie.Value, Expire: cookie.Expires}); err == nil {
-				r = r.WithContext(context.WithValue(r.Context(), KeyCurrentUserID, userID))
+		if secret, ok := BearerToken(r); ok {
+			userID, scopes, err := am.authCase.CheckAPIToken(r.Context(), secret)
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"status": "error", "code": "no_auth", "message": "invalid api token"}`))
+				return
+			}
+			r = r.WithContext(context.WithValue(r.Context(), keyAPIToken, apiToken{userID, scopes}))
+		} else if cookie, err := r.Cookie(SessionCookieName); err == nil {
+			sess := &session.Session{Key: cookie.Value, Expire: cookie.Expires, Metadata: RequestMetadata(r)}
+			if userID, role, expire, err := am.authCase.GetUserIDBySession(r.Context(), sess); err == nil {
+				http.SetCookie(w, NewSessionCookie(cookie.Value, expire))
+				ctx := context.WithValue(r.Context(), KeyCurrentUserID, userID)
+				r = r.WithContext(context.WithValue(ctx, KeyCurrentUserRole, role))
 			}
 		}
 		next.ServeHTTP(w, r)
@@ -46,3 +73,76 @@ func RequireAuth(next http.Handler) http.Handler {
 		}
 	})
 }
+
+// RequireScope allows the request with the API token to the route only if the token has the scope,
+// the routes without it are closed for the tokens. Other requests are passed unchanged.
+func RequireScope(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := r.Context().Value(keyAPIToken).(apiToken)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			for _, s := range token.scopes {
+				if s == scope {
+					next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), KeyCurrentUserID, token.userID)))
+					return
+				}
+			}
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"status": "error", "code": "no_access", "message": "the api token has no scope ` + scope + `"}`))
+		})
+	}
+}
+
+// RequireRole allows the request only to the user with the role or a higher one. The role is set
+// only for the session, so the requests with the API token are not allowed.
+func RequireRole(role string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current, _ := r.Context().Value(KeyCurrentUserRole).(string)
+			if user.HasRole(current, role) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"status": "error", "code": "no_access", "message": "the ` + role + ` role is required"}`))
+		})
+	}
+}
+
+// BearerToken returns the API token from the Authorization header.
+func BearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(header, bearerPrefix), true
+}
+
+func NewSessionCookie(key string, expire time.Time) *http.Cookie {
+	return &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    key,
+		HttpOnly: true,
+		Secure:   true,
+		Path:     "/",
+		Expires:  expire,
+		SameSite: http.SameSiteStrictMode,
+	}
+}
+
+// RequestMetadata collects the information about the client device which is stored with the session.
+// The address is taken from the connection, the one behind a trusted proxy is set by middleware.RealIP.
+func RequestMetadata(r *http.Request) session.Metadata {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	return session.Metadata{
+		UserAgent: r.UserAgent(),
+		IP:        ip,
+	}
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+const headerRealIP = "X-Real-IP"
+
+// RealIP sets the remote address of the request to the client address from the X-Real-IP header,
+// but only for the requests that came from one of the trusted proxies. For other requests the header
+// is controlled by the client and is ignored. The proxies are given as addresses or networks in CIDR notation.
+func RealIP(trustedProxies []string) Middleware {
+	trusted := parseNetworks(trustedProxies)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if realIP := net.ParseIP(strings.TrimSpace(r.Header.Get(headerRealIP))); realIP != nil && fromTrusted(r.RemoteAddr, trusted) {
+				r.RemoteAddr = net.JoinHostPort(realIP.String(), "0")
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func fromTrusted(remoteAddr string, trusted []*net.IPNet) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseNetworks(addrs []string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(addrs))
+	for _, addr := range addrs {
+		addr = strings.TrimSpace(addr)
+		if addr == "" {
+			continue
+		}
+		if !strings.Contains(addr, "/") {
+			if ip := net.ParseIP(addr); ip != nil && ip.To4() != nil {
+				addr += "/32"
+			} else {
+				addr += "/128"
+			}
+		}
+		if _, network, err := net.ParseCIDR(addr); err == nil {
+			networks = append(networks, network)
+		}
+	}
+	return networks
+}
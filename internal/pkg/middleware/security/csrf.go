@@ -3,6 +3,7 @@ package security
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	mw "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware"
@@ -16,6 +17,10 @@ func CSRF(cfg CSRFConfig) mw.Middleware {
 				setToken(w, &cfg)
 				return
 			}
+			if cfg.SkipBearerAuth && strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+				next.ServeHTTP(w, r)
+				return
+			}
 
 			skip := isSkipMethod(r.Method, &cfg)
 			tokenHeader := r.Header.Get(cfg.Header)
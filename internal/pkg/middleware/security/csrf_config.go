@@ -14,6 +14,9 @@ type CSRFConfig struct {
 	Lifetime              time.Duration
 	LenToken              int
 	UpdateWithEachRequest bool
+	// SkipBearerAuth passes the requests with the Authorization: Bearer header without the check,
+	// they are authenticated by the API token which the browser does not attach by itself.
+	SkipBearerAuth bool
 }
 
 type CookieConfig struct {
@@ -39,5 +42,7 @@ func DefaultCSRFConfig() CSRFConfig {
 		Header:      "X-CSRF-Token",
 		LenToken:    16,
 		Lifetime:    time.Hour,
+
+		SkipBearerAuth: true,
 	}
 }
@@ -0,0 +1,59 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/notification"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/notification"
+)
+
+const prefixChannelBoard = "board_"
+
+type boardGetter interface {
+	GetBoardTitle(ctx context.Context, boardID int) (string, error)
+	GetSubscribedBoardsIDs(ctx context.Context, userID int) ([]int, error)
+}
+
+// pinsNotify notifies the subscribers of the board about the new pins on it.
+// Every board has its own channel, so the notification is published once for all the subscribers.
+type pinsNotify struct {
+	notification.NotifyBuilder
+
+	board boardGetter
+}
+
+func NewPinsNotify(builder notification.NotifyBuilder, board boardGetter) pinsNotify {
+	return pinsNotify{builder, board}
+}
+
+func (p pinsNotify) Type() entity.NotifyType {
+	return p.NotifyBuilder.Type()
+}
+
+func (p pinsNotify) MessageNotify(data notification.M) (*entity.NotifyMessage, error) {
+	return p.NotifyBuilder.BuildNotifyMessage(data)
+}
+
+func (p pinsNotify) ChannelsNameForSubscribe(ctx context.Context, userID int) ([]string, error) {
+	boardsIDs, err := p.board.GetSubscribedBoardsIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get subscribed boards for receive channels name on subscribe: %w", err)
+	}
+
+	channels := make([]string, 0, len(boardsIDs))
+	for _, boardID := range boardsIDs {
+		channels = append(channels, prefixChannelBoard+strconv.Itoa(boardID))
+	}
+	return channels, nil
+}
+
+func (p pinsNotify) ChannelNameForPublishWithData(ctx context.Context, boardID int) (string, notification.M, error) {
+	title, err := p.board.GetBoardTitle(ctx, boardID)
+	if err != nil {
+		return "", nil, fmt.Errorf("get board title for receive channel name on publish: %w", err)
+	}
+
+	return prefixChannelBoard + strconv.Itoa(boardID), notification.M{"TitleBoard": title}, nil
+}
@@ -0,0 +1,69 @@
+package follow
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/notification"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/notification"
+)
+
+type requestGetter interface {
+	GetFollowRequest(ctx context.Context, requestID int) (*user.FollowRequest, error)
+}
+
+type followNotify struct {
+	notification.NotifyBuilder
+
+	req requestGetter
+}
+
+func (f followNotify) Type() entity.NotifyType {
+	return f.NotifyBuilder.Type()
+}
+
+func (f followNotify) MessageNotify(data notification.M) (*entity.NotifyMessage, error) {
+	return f.NotifyBuilder.BuildNotifyMessage(data)
+}
+
+func (f followNotify) ChannelsNameForSubscribe(_ context.Context, userID int) ([]string, error) {
+	return []string{strconv.Itoa(userID)}, nil
+}
+
+// requestNotify notifies the owner of the private profile about the new subscription request.
+type requestNotify struct {
+	followNotify
+}
+
+func NewRequestNotify(builder notification.NotifyBuilder, req requestGetter) requestNotify {
+	return requestNotify{followNotify{builder, req}}
+}
+
+func (r requestNotify) ChannelNameForPublishWithData(ctx context.Context, requestID int) (string, notification.M, error) {
+	req, err := r.req.GetFollowRequest(ctx, requestID)
+	if err != nil {
+		return "", nil, fmt.Errorf("get subscription request for receive channel name on publish: %w", err)
+	}
+
+	return strconv.Itoa(req.To.ID), notification.M{"Username": req.From.Username}, nil
+}
+
+// approvedNotify notifies the sender of the subscription request that it has been approved.
+type approvedNotify struct {
+	followNotify
+}
+
+func NewApprovedNotify(builder notification.NotifyBuilder, req requestGetter) approvedNotify {
+	return approvedNotify{followNotify{builder, req}}
+}
+
+func (a approvedNotify) ChannelNameForPublishWithData(ctx context.Context, requestID int) (string, notification.M, error) {
+	req, err := a.req.GetFollowRequest(ctx, requestID)
+	if err != nil {
+		return "", nil, fmt.Errorf("get subscription request for receive channel name on publish: %w", err)
+	}
+
+	return strconv.Itoa(req.From.ID), notification.M{"Username": req.To.Username}, nil
+}
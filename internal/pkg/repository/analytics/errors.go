@@ -0,0 +1,13 @@
+package analytics
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrPinNotFound struct{}
+
+func (e *ErrPinNotFound) Error() string {
+	return "pin not found"
+}
+
+func (e *ErrPinNotFound) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
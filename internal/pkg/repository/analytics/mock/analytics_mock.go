@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	analytics "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPinStats mocks base method.
+func (m *MockRepository) AddPinStats(ctx context.Context, stats []analytics.PinDailyStat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPinStats", ctx, stats)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPinStats indicates an expected call of AddPinStats.
+func (mr *MockRepositoryMockRecorder) AddPinStats(ctx, stats interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPinStats", reflect.TypeOf((*MockRepository)(nil).AddPinStats), ctx, stats)
+}
+
+// GetAccountStats mocks base method.
+func (m *MockRepository) GetAccountStats(ctx context.Context, authorID int, since time.Time) ([]analytics.DailyStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountStats", ctx, authorID, since)
+	ret0, _ := ret[0].([]analytics.DailyStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountStats indicates an expected call of GetAccountStats.
+func (mr *MockRepositoryMockRecorder) GetAccountStats(ctx, authorID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountStats", reflect.TypeOf((*MockRepository)(nil).GetAccountStats), ctx, authorID, since)
+}
+
+// GetPinAuthorID mocks base method.
+func (m *MockRepository) GetPinAuthorID(ctx context.Context, pinID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPinAuthorID", ctx, pinID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPinAuthorID indicates an expected call of GetPinAuthorID.
+func (mr *MockRepositoryMockRecorder) GetPinAuthorID(ctx, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinAuthorID", reflect.TypeOf((*MockRepository)(nil).GetPinAuthorID), ctx, pinID)
+}
+
+// GetPinStats mocks base method.
+func (m *MockRepository) GetPinStats(ctx context.Context, pinID int, since time.Time) ([]analytics.DailyStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPinStats", ctx, pinID, since)
+	ret0, _ := ret[0].([]analytics.DailyStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPinStats indicates an expected call of GetPinStats.
+func (mr *MockRepositoryMockRecorder) GetPinStats(ctx, pinID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinStats", reflect.TypeOf((*MockRepository)(nil).GetPinStats), ctx, pinID, since)
+}
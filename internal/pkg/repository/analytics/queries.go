@@ -0,0 +1,22 @@
+package analytics
+
+const (
+	// the stats of the pins removed from the storage in the meantime are skipped
+	UpsertPinStats = `INSERT INTO pin_stat_daily AS s (pin_id, day, impressions, closeups, likes, saves, clicks)
+					  SELECT stat.pin_id, stat.day, stat.impressions, stat.closeups, stat.likes, stat.saves, stat.clicks
+					  FROM unnest($1::int[], $2::date[], $3::int[], $4::int[], $5::int[], $6::int[], $7::int[])
+						  AS stat (pin_id, day, impressions, closeups, likes, saves, clicks)
+					  INNER JOIN pin ON pin.id = stat.pin_id
+					  ON CONFLICT (pin_id, day) DO UPDATE
+					  SET impressions = s.impressions + excluded.impressions, closeups = s.closeups + excluded.closeups,
+						  likes = s.likes + excluded.likes, saves = s.saves + excluded.saves, clicks = s.clicks + excluded.clicks;`
+	SelectPinAuthor = "SELECT author FROM pin WHERE id = $1 AND deleted_at IS NULL;"
+	SelectPinStats  = `SELECT day, impressions, closeups, likes, saves, clicks
+					   FROM pin_stat_daily WHERE pin_id = $1 AND day >= $2
+					   ORDER BY day;`
+	SelectAccountStats = `SELECT s.day, SUM(s.impressions), SUM(s.closeups), SUM(s.likes), SUM(s.saves), SUM(s.clicks)
+						  FROM pin_stat_daily AS s INNER JOIN pin ON s.pin_id = pin.id
+						  WHERE pin.author = $1 AND s.day >= $2
+						  GROUP BY s.day
+						  ORDER BY s.day;`
+)
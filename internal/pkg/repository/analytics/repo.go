@@ -0,0 +1,100 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
+)
+
+//go:generate mockgen -destination=./mock/analytics_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	AddPinStats(ctx context.Context, stats []entity.PinDailyStat) error
+	GetPinAuthorID(ctx context.Context, pinID int) (int, error)
+	GetPinStats(ctx context.Context, pinID int, since time.Time) ([]entity.DailyStat, error)
+	GetAccountStats(ctx context.Context, authorID int, since time.Time) ([]entity.DailyStat, error)
+}
+
+type analyticsRepoPG struct {
+	db pgtype.PgxPoolIface
+}
+
+func NewAnalyticsRepoPG(db pgtype.PgxPoolIface) *analyticsRepoPG {
+	return &analyticsRepoPG{db}
+}
+
+// AddPinStats adds the counters to the daily aggregates of the pins with the single query.
+func (a *analyticsRepoPG) AddPinStats(ctx context.Context, stats []entity.PinDailyStat) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	var (
+		pinIDs      = make([]int, 0, len(stats))
+		days        = make([]time.Time, 0, len(stats))
+		impressions = make([]int, 0, len(stats))
+		closeups    = make([]int, 0, len(stats))
+		likes       = make([]int, 0, len(stats))
+		saves       = make([]int, 0, len(stats))
+		clicks      = make([]int, 0, len(stats))
+	)
+	for _, stat := range stats {
+		pinIDs = append(pinIDs, stat.PinID)
+		days = append(days, stat.Day)
+		impressions = append(impressions, stat.Impressions)
+		closeups = append(closeups, stat.Closeups)
+		likes = append(likes, stat.Likes)
+		saves = append(saves, stat.Saves)
+		clicks = append(clicks, stat.Clicks)
+	}
+
+	_, err := a.db.Exec(ctx, UpsertPinStats, pinIDs, days, impressions, closeups, likes, saves, clicks)
+	if err != nil {
+		return fmt.Errorf("add pin stats in storage: %w", err)
+	}
+	return nil
+}
+
+func (a *analyticsRepoPG) GetPinAuthorID(ctx context.Context, pinID int) (int, error) {
+	var authorID int
+	if err := a.db.QueryRow(ctx, SelectPinAuthor, pinID).Scan(&authorID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, &ErrPinNotFound{}
+		}
+		return 0, fmt.Errorf("get pin author for analytics from storage: %w", err)
+	}
+	return authorID, nil
+}
+
+func (a *analyticsRepoPG) GetPinStats(ctx context.Context, pinID int, since time.Time) ([]entity.DailyStat, error) {
+	return a.selectStats(ctx, SelectPinStats, pinID, since)
+}
+
+// GetAccountStats returns the daily sums of the counters of all the pins of the author.
+func (a *analyticsRepoPG) GetAccountStats(ctx context.Context, authorID int, since time.Time) ([]entity.DailyStat, error) {
+	return a.selectStats(ctx, SelectAccountStats, authorID, since)
+}
+
+func (a *analyticsRepoPG) selectStats(ctx context.Context, query string, args ...any) ([]entity.DailyStat, error) {
+	rows, err := a.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select stats from storage: %w", err)
+	}
+	defer rows.Close()
+
+	stats := []entity.DailyStat{}
+	var stat entity.DailyStat
+	for rows.Next() {
+		err = rows.Scan(&stat.Day, &stat.Impressions, &stat.Closeups, &stat.Likes, &stat.Saves, &stat.Clicks)
+		if err != nil {
+			return nil, fmt.Errorf("scan daily stat: %w", err)
+		}
+		stats = append(stats, stat)
+	}
+	return stats, rows.Err()
+}
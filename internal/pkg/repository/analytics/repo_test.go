@@ -0,0 +1,63 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v2"
+	"github.com/stretchr/testify/require"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
+)
+
+func TestAddPinStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewAnalyticsRepoPG(pool)
+	day := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	pool.ExpectExec("INSERT INTO pin_stat_daily").
+		WithArgs([]int{4, 9}, []time.Time{day, day}, []int{10, 3}, []int{2, 0}, []int{1, 0}, []int{0, 1}, []int{0, 0}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+
+	err = repo.AddPinStats(ctx, []entity.PinDailyStat{
+		{PinID: 4, Day: day, Counters: entity.Counters{Impressions: 10, Closeups: 2, Likes: 1}},
+		{PinID: 9, Day: day, Counters: entity.Counters{Impressions: 3, Saves: 1}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, repo.AddPinStats(ctx, nil))
+	require.NoError(t, pool.ExpectationsWereMet())
+}
+
+func TestGetAccountStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewAnalyticsRepoPG(pool)
+	since := time.Date(2023, 10, 26, 0, 0, 0, 0, time.UTC)
+	day := time.Date(2023, 10, 30, 0, 0, 0, 0, time.UTC)
+
+	pool.ExpectQuery("SELECT s.day").
+		WithArgs(12, since).
+		WillReturnRows(pgxmock.NewRows([]string{"day", "impressions", "closeups", "likes", "saves", "clicks"}).
+			AddRow(day, 40, 7, 3, 2, 1))
+
+	stats, err := repo.GetAccountStats(ctx, 12, since)
+	require.NoError(t, err)
+	require.Equal(t, []entity.DailyStat{
+		{Day: day, Counters: entity.Counters{Impressions: 40, Closeups: 7, Likes: 3, Saves: 2, Clicks: 1}},
+	}, stats)
+	require.NoError(t, pool.ExpectationsWereMet())
+}
@@ -0,0 +1,13 @@
+package apitoken
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrTokenNotFound struct{}
+
+func (e *ErrTokenNotFound) Error() string {
+	return "api token not found or has expired"
+}
+
+func (e *ErrTokenNotFound) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	apitoken "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/apitoken"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddToken mocks base method.
+func (m *MockRepository) AddToken(ctx context.Context, token *apitoken.Token, hash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToken", ctx, token, hash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToken indicates an expected call of AddToken.
+func (mr *MockRepositoryMockRecorder) AddToken(ctx, token, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToken", reflect.TypeOf((*MockRepository)(nil).AddToken), ctx, token, hash)
+}
+
+// DeleteToken mocks base method.
+func (m *MockRepository) DeleteToken(ctx context.Context, userID, tokenID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteToken", ctx, userID, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteToken indicates an expected call of DeleteToken.
+func (mr *MockRepositoryMockRecorder) DeleteToken(ctx, userID, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteToken", reflect.TypeOf((*MockRepository)(nil).DeleteToken), ctx, userID, tokenID)
+}
+
+// GetUserTokens mocks base method.
+func (m *MockRepository) GetUserTokens(ctx context.Context, userID int) ([]apitoken.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTokens", ctx, userID)
+	ret0, _ := ret[0].([]apitoken.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTokens indicates an expected call of GetUserTokens.
+func (mr *MockRepositoryMockRecorder) GetUserTokens(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTokens", reflect.TypeOf((*MockRepository)(nil).GetUserTokens), ctx, userID)
+}
+
+// UseToken mocks base method.
+func (m *MockRepository) UseToken(ctx context.Context, hash string) (*apitoken.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseToken", ctx, hash)
+	ret0, _ := ret[0].(*apitoken.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseToken indicates an expected call of UseToken.
+func (mr *MockRepositoryMockRecorder) UseToken(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseToken", reflect.TypeOf((*MockRepository)(nil).UseToken), ctx, hash)
+}
@@ -0,0 +1,19 @@
+package apitoken
+
+var (
+	InsertToken = `INSERT INTO api_token (user_id, name, token_hash, scopes, expires_at) VALUES ($1, $2, $3, $4, $5)
+				   RETURNING id, created_at;`
+
+	SelectUserTokens = `SELECT id, name, scopes, created_at, last_used_at, expires_at
+						FROM api_token
+						WHERE user_id = $1 AND (expires_at IS NULL OR expires_at > now())
+						ORDER BY id;`
+
+	DeleteToken = "DELETE FROM api_token WHERE id = $1 AND user_id = $2;"
+
+	UpdateTokenUsed = `UPDATE api_token SET last_used_at = now()
+					   WHERE token_hash = $1 AND (expires_at IS NULL OR expires_at > now()) AND
+					   		user_id IN (SELECT id FROM profile WHERE deleted_at IS NULL AND
+								(suspended_at IS NULL OR suspended_until <= now()))
+					   RETURNING id, user_id, name, scopes, created_at, last_used_at, expires_at;`
+)
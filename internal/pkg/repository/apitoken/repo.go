@@ -0,0 +1,81 @@
+package apitoken
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/apitoken"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
+)
+
+//go:generate mockgen -destination=./mock/apitoken_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	AddToken(ctx context.Context, token *entity.Token, hash string) error
+	GetUserTokens(ctx context.Context, userID int) ([]entity.Token, error)
+	DeleteToken(ctx context.Context, userID, tokenID int) error
+	UseToken(ctx context.Context, hash string) (*entity.Token, error)
+}
+
+type apiTokenRepoPG struct {
+	db pgtype.PgxPoolIface
+}
+
+func NewAPITokenRepoPG(db pgtype.PgxPoolIface) *apiTokenRepoPG {
+	return &apiTokenRepoPG{db}
+}
+
+func (a *apiTokenRepoPG) AddToken(ctx context.Context, token *entity.Token, hash string) error {
+	err := a.db.QueryRow(ctx, InsertToken, token.UserID, token.Name, hash, token.Scopes, token.ExpiresAt).
+		Scan(&token.ID, &token.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("add api token in storage: %w", err)
+	}
+	return nil
+}
+
+// GetUserTokens returns the tokens of the user which have not expired.
+func (a *apiTokenRepoPG) GetUserTokens(ctx context.Context, userID int) ([]entity.Token, error) {
+	rows, err := a.db.Query(ctx, SelectUserTokens, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user api tokens from storage: %w", err)
+	}
+	defer rows.Close()
+
+	tokens := make([]entity.Token, 0)
+	for rows.Next() {
+		token := entity.Token{UserID: userID}
+		err = rows.Scan(&token.ID, &token.Name, &token.Scopes, &token.CreatedAt, &token.LastUsedAt, &token.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan api token: %w", err)
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, rows.Err()
+}
+
+func (a *apiTokenRepoPG) DeleteToken(ctx context.Context, userID, tokenID int) error {
+	status, err := a.db.Exec(ctx, DeleteToken, tokenID, userID)
+	if err != nil {
+		return fmt.Errorf("delete api token from storage: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return &ErrTokenNotFound{}
+	}
+	return nil
+}
+
+// UseToken returns the valid token with the hash and saves the time it was used.
+func (a *apiTokenRepoPG) UseToken(ctx context.Context, hash string) (*entity.Token, error) {
+	token := &entity.Token{}
+	err := a.db.QueryRow(ctx, UpdateTokenUsed, hash).Scan(&token.ID, &token.UserID, &token.Name, &token.Scopes,
+		&token.CreatedAt, &token.LastUsedAt, &token.ExpiresAt)
+	if err == pgx.ErrNoRows {
+		return nil, &ErrTokenNotFound{}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("use api token in storage: %w", err)
+	}
+	return token, nil
+}
@@ -0,0 +1,85 @@
+package attempt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	prefixFailures = "login_failures:"
+	prefixBlock    = "login_block:"
+)
+
+//go:generate mockgen -destination=./mock/attempt_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	AddFailure(ctx context.Context, key string, window time.Duration) (int, error)
+	Block(ctx context.Context, key string, duration time.Duration) error
+	BlockedFor(ctx context.Context, keys ...string) (time.Duration, error)
+	Reset(ctx context.Context, keys ...string) error
+}
+
+// attemptRepo counts failed login attempts, the key identifies the subject of the attempts,
+// for example a username or an ip address.
+type attemptRepo struct {
+	client *redis.Client
+}
+
+func NewAttemptRepo(client *redis.Client) *attemptRepo {
+	return &attemptRepo{client}
+}
+
+// AddFailure increments the failure counter for the key and returns its new value,
+// the counter is forgotten after the window has passed since the last failure.
+func (a *attemptRepo) AddFailure(ctx context.Context, key string, window time.Duration) (int, error) {
+	pipe := a.client.TxPipeline()
+	incr := pipe.Incr(ctx, prefixFailures+key)
+	pipe.PExpire(ctx, prefixFailures+key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("add login failure in storage: %w", err)
+	}
+	return int(incr.Val()), nil
+}
+
+func (a *attemptRepo) Block(ctx context.Context, key string, duration time.Duration) error {
+	err := a.client.Set(ctx, prefixBlock+key, 1, duration).Err()
+	if err != nil {
+		return fmt.Errorf("block login in storage: %w", err)
+	}
+	return nil
+}
+
+// BlockedFor returns the longest remaining block among the keys, zero if none of them is blocked.
+func (a *attemptRepo) BlockedFor(ctx context.Context, keys ...string) (time.Duration, error) {
+	pipe := a.client.Pipeline()
+	cmds := make([]*redis.DurationCmd, 0, len(keys))
+	for _, key := range keys {
+		cmds = append(cmds, pipe.PTTL(ctx, prefixBlock+key))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("get login block from storage: %w", err)
+	}
+
+	var blocked time.Duration
+	for _, cmd := range cmds {
+		// negative values mean that there is no block for the key
+		if ttl := cmd.Val(); ttl > blocked {
+			blocked = ttl
+		}
+	}
+	return blocked, nil
+}
+
+// Reset forgets the failures and the blocks of the keys.
+func (a *attemptRepo) Reset(ctx context.Context, keys ...string) error {
+	redisKeys := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		redisKeys = append(redisKeys, prefixFailures+key, prefixBlock+key)
+	}
+	if err := a.client.Del(ctx, redisKeys...).Err(); err != nil {
+		return fmt.Errorf("reset login failures in storage: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package block
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrAlreadyBlocked struct{}
+
+func (e *ErrAlreadyBlocked) Error() string {
+	return "the user is already blocked"
+}
+
+func (e *ErrAlreadyBlocked) Type() errPkg.Type {
+	return errPkg.ErrAlreadyExists
+}
+
+type ErrBlockNotFound struct{}
+
+func (e *ErrBlockNotFound) Error() string {
+	return "the user is not blocked"
+}
+
+func (e *ErrBlockNotFound) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
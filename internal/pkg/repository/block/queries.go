@@ -0,0 +1,17 @@
+package block
+
+const (
+	InsertBlock = `INSERT INTO user_block (blocker_id, blocked_id) VALUES ($1, $2)
+				   ON CONFLICT (blocker_id, blocked_id) DO NOTHING;`
+	DeleteBlock          = "DELETE FROM user_block WHERE blocker_id = $1 AND blocked_id = $2;"
+	DeleteSubscriptions  = "DELETE FROM subscription_user WHERE who = $1 AND whom = $2 OR who = $2 AND whom = $1;"
+	DeleteFollowRequests = `DELETE FROM subscription_request
+							WHERE status = 'pending' AND (who = $1 AND whom = $2 OR who = $2 AND whom = $1);`
+	SelectBlockedBetween = `SELECT EXISTS (SELECT 1 FROM user_block
+							WHERE blocker_id = $1 AND blocked_id = $2 OR blocker_id = $2 AND blocked_id = $1);`
+	SelectBlockedUsers = `SELECT profile.id, username, avatar
+						  FROM user_block INNER JOIN profile ON blocked_id = profile.id
+						  WHERE blocker_id = $1 AND (blocked_id < $2 OR $2 = 0) AND profile.deleted_at IS NULL
+						  ORDER BY blocked_id DESC
+						  LIMIT $3;`
+)
@@ -0,0 +1,92 @@
+package block
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
+)
+
+//go:generate mockgen -destination=./mock/block_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	BlockUser(ctx context.Context, blockerID, blockedID int) error
+	UnblockUser(ctx context.Context, blockerID, blockedID int) error
+	IsBlockedBetween(ctx context.Context, userID1, userID2 int) (bool, error)
+	GetBlockedUsers(ctx context.Context, blockerID, lastID, count int) ([]user.User, error)
+}
+
+type blockRepoPG struct {
+	db pgtype.PgxPoolIface
+}
+
+func NewBlockRepoPG(db pgtype.PgxPoolIface) *blockRepoPG {
+	return &blockRepoPG{db}
+}
+
+// BlockUser adds the block and drops the subscriptions and the pending subscription requests
+// of the users to each other.
+func (b *blockRepoPG) BlockUser(ctx context.Context, blockerID, blockedID int) error {
+	tx, err := b.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction for block user: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	status, err := tx.Exec(ctx, InsertBlock, blockerID, blockedID)
+	if err != nil {
+		return fmt.Errorf("add block user in storage: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return &ErrAlreadyBlocked{}
+	}
+
+	if _, err = tx.Exec(ctx, DeleteSubscriptions, blockerID, blockedID); err != nil {
+		return fmt.Errorf("delete subscriptions of the blocked user from storage: %w", err)
+	}
+	if _, err = tx.Exec(ctx, DeleteFollowRequests, blockerID, blockedID); err != nil {
+		return fmt.Errorf("delete subscription requests of the blocked user from storage: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction for block user: %w", err)
+	}
+	return nil
+}
+
+func (b *blockRepoPG) UnblockUser(ctx context.Context, blockerID, blockedID int) error {
+	status, err := b.db.Exec(ctx, DeleteBlock, blockerID, blockedID)
+	if err != nil {
+		return fmt.Errorf("delete block user from storage: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return &ErrBlockNotFound{}
+	}
+	return nil
+}
+
+// IsBlockedBetween reports whether any of the users has blocked the other one.
+func (b *blockRepoPG) IsBlockedBetween(ctx context.Context, userID1, userID2 int) (blocked bool, err error) {
+	if err = b.db.QueryRow(ctx, SelectBlockedBetween, userID1, userID2).Scan(&blocked); err != nil {
+		return false, fmt.Errorf("check block between users in storage: %w", err)
+	}
+	return blocked, nil
+}
+
+func (b *blockRepoPG) GetBlockedUsers(ctx context.Context, blockerID, lastID, count int) ([]user.User, error) {
+	rows, err := b.db.Query(ctx, SelectBlockedUsers, blockerID, lastID, count)
+	if err != nil {
+		return nil, fmt.Errorf("get blocked users from storage: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]user.User, 0, count)
+	u := user.User{}
+	for rows.Next() {
+		if err = rows.Scan(&u.ID, &u.Username, &u.Avatar); err != nil {
+			return users, fmt.Errorf("scan blocked user: %w", err)
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
@@ -0,0 +1,46 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockUser(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewBlockRepoPG(pool)
+
+	pool.ExpectBegin()
+	pool.ExpectExec("INSERT INTO user_block").
+		WithArgs(1, 2).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	pool.ExpectExec("DELETE FROM subscription_user").
+		WithArgs(1, 2).
+		WillReturnResult(pgxmock.NewResult("DELETE", 2))
+	pool.ExpectExec("DELETE FROM subscription_request").
+		WithArgs(1, 2).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	pool.ExpectCommit()
+
+	err = repo.BlockUser(ctx, 1, 2)
+	require.NoError(t, err)
+
+	pool.ExpectBegin()
+	pool.ExpectExec("INSERT INTO user_block").
+		WithArgs(1, 2).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+	pool.ExpectRollback()
+
+	err = repo.BlockUser(ctx, 1, 2)
+	require.ErrorAs(t, err, new(*ErrAlreadyBlocked))
+	require.NoError(t, pool.ExpectationsWereMet())
+}
@@ -141,6 +141,21 @@ func (mr *MockRepositoryMockRecorder) GetBoardInfoForUpdate(ctx, boardID, hasAcc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardInfoForUpdate", reflect.TypeOf((*MockRepository)(nil).GetBoardInfoForUpdate), ctx, boardID, hasAccess)
 }
 
+// GetBoardTitle mocks base method.
+func (m *MockRepository) GetBoardTitle(ctx context.Context, boardID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardTitle", ctx, boardID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardTitle indicates an expected call of GetBoardTitle.
+func (mr *MockRepositoryMockRecorder) GetBoardTitle(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardTitle", reflect.TypeOf((*MockRepository)(nil).GetBoardTitle), ctx, boardID)
+}
+
 // GetBoardsByUserID mocks base method.
 func (m *MockRepository) GetBoardsByUserID(ctx context.Context, userID int, isAuthor bool, accessableBoardsIDs []int) ([]board.BoardWithContent, error) {
 	m.ctrl.T.Helper()
@@ -186,6 +201,21 @@ func (mr *MockRepositoryMockRecorder) GetContributorsByBoardID(ctx, boardID inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContributorsByBoardID", reflect.TypeOf((*MockRepository)(nil).GetContributorsByBoardID), ctx, boardID)
 }
 
+// GetMembershipByAuthor mocks base method.
+func (m *MockRepository) GetMembershipByAuthor(ctx context.Context, authorID int) (map[int][]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembershipByAuthor", ctx, authorID)
+	ret0, _ := ret[0].(map[int][]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembershipByAuthor indicates an expected call of GetMembershipByAuthor.
+func (mr *MockRepositoryMockRecorder) GetMembershipByAuthor(ctx, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembershipByAuthor", reflect.TypeOf((*MockRepository)(nil).GetMembershipByAuthor), ctx, authorID)
+}
+
 // GetProtectionStatusBoard mocks base method.
 func (m *MockRepository) GetProtectionStatusBoard(ctx context.Context, boardID int) (board0.ProtectionBoard, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +231,37 @@ func (mr *MockRepositoryMockRecorder) GetProtectionStatusBoard(ctx, boardID inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProtectionStatusBoard", reflect.TypeOf((*MockRepository)(nil).GetProtectionStatusBoard), ctx, boardID)
 }
 
+// GetSubscribedBoardsIDs mocks base method.
+func (m *MockRepository) GetSubscribedBoardsIDs(ctx context.Context, userID int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscribedBoardsIDs", ctx, userID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscribedBoardsIDs indicates an expected call of GetSubscribedBoardsIDs.
+func (mr *MockRepositoryMockRecorder) GetSubscribedBoardsIDs(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribedBoardsIDs", reflect.TypeOf((*MockRepository)(nil).GetSubscribedBoardsIDs), ctx, userID)
+}
+
+// RepinOnBoard mocks base method.
+func (m *MockRepository) RepinOnBoard(ctx context.Context, boardID, pinID, userID int) (int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepinOnBoard", ctx, boardID, pinID, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RepinOnBoard indicates an expected call of RepinOnBoard.
+func (mr *MockRepositoryMockRecorder) RepinOnBoard(ctx, boardID, pinID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepinOnBoard", reflect.TypeOf((*MockRepository)(nil).RepinOnBoard), ctx, boardID, pinID, userID)
+}
+
 // RoleUserHaveOnThisBoard mocks base method.
 func (m *MockRepository) RoleUserHaveOnThisBoard(ctx context.Context, boardID, userID int) (board0.UserRole, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +277,34 @@ func (mr *MockRepositoryMockRecorder) RoleUserHaveOnThisBoard(ctx, boardID, user
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleUserHaveOnThisBoard", reflect.TypeOf((*MockRepository)(nil).RoleUserHaveOnThisBoard), ctx, boardID, userID)
 }
 
+// SubscribeToBoard mocks base method.
+func (m *MockRepository) SubscribeToBoard(ctx context.Context, userID, boardID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToBoard", ctx, userID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeToBoard indicates an expected call of SubscribeToBoard.
+func (mr *MockRepositoryMockRecorder) SubscribeToBoard(ctx, userID, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToBoard", reflect.TypeOf((*MockRepository)(nil).SubscribeToBoard), ctx, userID, boardID)
+}
+
+// UnsubscribeFromBoard mocks base method.
+func (m *MockRepository) UnsubscribeFromBoard(ctx context.Context, userID, boardID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeFromBoard", ctx, userID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsubscribeFromBoard indicates an expected call of UnsubscribeFromBoard.
+func (mr *MockRepositoryMockRecorder) UnsubscribeFromBoard(ctx, userID, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeFromBoard", reflect.TypeOf((*MockRepository)(nil).UnsubscribeFromBoard), ctx, userID, boardID)
+}
+
 // UpdateBoard mocks base method.
 func (m *MockRepository) UpdateBoard(ctx context.Context, newBoardData board.Board, tagTitles []string) error {
 	m.ctrl.T.Helper()
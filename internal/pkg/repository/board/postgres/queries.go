@@ -10,8 +10,40 @@ const (
 	DeleteBoardByIdQuery                  = "UPDATE board SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL;"
 	DeleteCurrentBoardTags                = "DELETE FROM board_tag WHERE board_id = $1;"
 	DeletePinFromBoard                    = "DELETE FROM membership m WHERE m.board_id = $1 AND m.pin_id = $2 AND (SELECT deleted_at IS NULL FROM pin p WHERE p.id = $2);"
+	InsertBoardSubscription               = "INSERT INTO subscription_board (user_id, board_id) VALUES ($1, $2) ON CONFLICT DO NOTHING;"
+	DeleteBoardSubscription               = "DELETE FROM subscription_board WHERE user_id = $1 AND board_id = $2;"
+	SelectSubscribedBoardsIDs             = "SELECT board_id FROM subscription_board WHERE user_id = $1;"
+	SelectBoardTitle                      = "SELECT title FROM board WHERE id = $1 AND deleted_at IS NULL;"
 	SelectAuthorOrContributorRole         = `SELECT board.author, role.name FROM board LEFT JOIN contributor
 											 ON contributor.board_id = board.id AND contributor.user_id = $1 LEFT JOIN role
 											 ON contributor.role_id = role.id
 											 WHERE board.id = $2;`
+	SelectMembershipByAuthor = `SELECT membership.board_id, membership.pin_id FROM membership INNER JOIN board
+											 ON membership.board_id = board.id INNER JOIN pin
+											 ON membership.pin_id = pin.id
+											 WHERE board.author = $1 AND board.deleted_at IS NULL AND pin.deleted_at IS NULL
+											 ORDER BY membership.board_id, membership.added_at;`
+)
+
+const (
+	// the public pin is copied for the user only while it is visible to the user
+	// and only once for the board, see pin_repin_uniq:
+	// it isn't the own pin of the user, the author hasn't a block with the user
+	// and the profile of the author is public or followed by the user
+	InsertRepin = `INSERT INTO pin (author, title, description, picture, public, source_url, source_pin_id, repin_board_id)
+				   SELECT $2, pin.title, pin.description, pin.picture, board.public, pin.source_url, pin.id, $3
+				   FROM pin INNER JOIN profile ON pin.author = profile.id INNER JOIN board ON board.id = $3
+				   WHERE pin.id = $1 AND pin.deleted_at IS NULL AND pin.public AND pin.author <> $2
+						AND NOT pin.draft AND pin.publish_at IS NULL
+						AND profile.deleted_at IS NULL
+						AND NOT EXISTS (SELECT 1 FROM user_block
+							WHERE blocker_id = pin.author AND blocked_id = $2 OR blocker_id = $2 AND blocked_id = pin.author)
+						AND (NOT profile.private OR EXISTS (SELECT 1 FROM subscription_user WHERE who = $2 AND whom = pin.author))
+				   RETURNING id, public;`
+	InsertRepinTags       = "INSERT INTO pin_tag (pin_id, tag_id) SELECT $1, tag_id FROM pin_tag WHERE pin_id = $2;"
+	InsertRepinImages     = "INSERT INTO pin_image (pin_id, position, picture) SELECT $1, position, picture FROM pin_image WHERE pin_id = $2;"
+	InsertRepinMembership = "INSERT INTO membership (pin_id, board_id) VALUES ($1, $2);"
+	UpdatePinSaveCount    = "UPDATE pin SET save_count = save_count + 1 WHERE id = $1;"
+	InsertRepinPreview    = `INSERT INTO link_preview (pin_id, url, title, description, image, fetched_at)
+						  SELECT $1, url, title, description, image, fetched_at FROM link_preview WHERE pin_id = $2;`
 )
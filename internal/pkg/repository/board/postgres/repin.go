@@ -0,0 +1,72 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
+)
+
+// uniqRepin is the index which lets the user save the pin on the board only once.
+const uniqRepin = "pin_repin_uniq"
+
+// RepinOnBoard saves the copy of someone else's pin with its tags, images, source link and its preview
+// on the board of the user, the copy refers to the saved pin and the save counter of the saved pin is increased.
+// The copy is public only on the public board, it is reported alongside the id of the copy.
+// The repeated save of the pin on the same board fails with ErrNoDataAffected.
+func (repo *boardRepoPG) RepinOnBoard(ctx context.Context, boardID, pinID, userID int) (int, bool, error) {
+	tx, err := repo.db.Begin(ctx)
+	if err != nil {
+		return 0, false, fmt.Errorf("starting transaction for repin: %w", err)
+	}
+
+	var (
+		newPinID int
+		public   bool
+	)
+	if err = tx.QueryRow(ctx, InsertRepin, pinID, userID, boardID).Scan(&newPinID, &public); err != nil {
+		tx.Rollback(ctx)
+		if err == pgx.ErrNoRows {
+			return 0, false, repository.ErrNoData
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == uniqRepin {
+			return 0, false, repository.ErrNoDataAffected
+		}
+		return 0, false, fmt.Errorf("inserting repin within transaction: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, InsertRepinTags, newPinID, pinID); err != nil {
+		tx.Rollback(ctx)
+		return 0, false, fmt.Errorf("copying tags of the repin within transaction: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, InsertRepinImages, newPinID, pinID); err != nil {
+		tx.Rollback(ctx)
+		return 0, false, fmt.Errorf("copying images of the repin within transaction: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, InsertRepinPreview, newPinID, pinID); err != nil {
+		tx.Rollback(ctx)
+		return 0, false, fmt.Errorf("copying link preview of the repin within transaction: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, InsertRepinMembership, newPinID, boardID); err != nil {
+		tx.Rollback(ctx)
+		return 0, false, fmt.Errorf("adding repin on board within transaction: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, UpdatePinSaveCount, pinID); err != nil {
+		tx.Rollback(ctx)
+		return 0, false, fmt.Errorf("increasing save count within transaction: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, false, fmt.Errorf("commit transaction for repin: %w", err)
+	}
+	return newPinID, public, nil
+}
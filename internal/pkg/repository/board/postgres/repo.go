@@ -62,8 +62,8 @@ func (boardRepo *boardRepoPG) GetBoardsByUserID(ctx context.Context, userID int,
 			"board.title",
 			"COALESCE(board.description, '')",
 			"board.created_at",
-			"COUNT(DISTINCT pin.id) FILTER (WHERE pin.deleted_at IS NULL) AS pins_number",
-			"COALESCE((ARRAY_AGG(DISTINCT pin.picture) FILTER (WHERE pin.deleted_at IS NULL AND pin.picture IS NOT NULL))[:3], ARRAY[]::TEXT[]) AS pins",
+			"COUNT(DISTINCT pin.id) FILTER (WHERE pin.deleted_at IS NULL AND NOT pin.draft AND pin.publish_at IS NULL) AS pins_number",
+			"COALESCE((ARRAY_AGG(DISTINCT pin.picture) FILTER (WHERE pin.deleted_at IS NULL AND NOT pin.draft AND pin.publish_at IS NULL AND pin.picture IS NOT NULL))[:3], ARRAY[]::TEXT[]) AS pins",
 			"COALESCE(ARRAY_AGG(DISTINCT tag.title) FILTER (WHERE tag.title IS NOT NULL), ARRAY[]::TEXT[]) AS tag_titles").
 		From("board").
 		LeftJoin("membership ON board.id = membership.board_id").
@@ -123,9 +123,10 @@ func (repo *boardRepoPG) GetBoardByID(ctx context.Context, boardID int, hasAcces
 			"board.title",
 			"COALESCE(board.description, '')",
 			"board.created_at",
-			"COUNT(DISTINCT pin.id) FILTER (WHERE pin.deleted_at IS NULL) AS pins_number",
-			"COALESCE((ARRAY_AGG(DISTINCT pin.picture) FILTER (WHERE pin.deleted_at IS NULL AND pin.picture IS NOT NULL)), ARRAY[]::TEXT[]) AS pins",
-			"COALESCE(ARRAY_AGG(DISTINCT tag.title) FILTER (WHERE tag.title IS NOT NULL), ARRAY[]::TEXT[]) AS tag_titles").
+			"COUNT(DISTINCT pin.id) FILTER (WHERE pin.deleted_at IS NULL AND NOT pin.draft AND pin.publish_at IS NULL) AS pins_number",
+			"COALESCE((ARRAY_AGG(DISTINCT pin.picture) FILTER (WHERE pin.deleted_at IS NULL AND NOT pin.draft AND pin.publish_at IS NULL AND pin.picture IS NOT NULL)), ARRAY[]::TEXT[]) AS pins",
+			"COALESCE(ARRAY_AGG(DISTINCT tag.title) FILTER (WHERE tag.title IS NOT NULL), ARRAY[]::TEXT[]) AS tag_titles",
+			"(SELECT COUNT(*) FROM subscription_board WHERE subscription_board.board_id = board.id) AS subscribers_number").
 		From("board").
 		LeftJoin("profile ON board.author = profile.id").
 		LeftJoin("board_tag ON board.id = board_tag.board_id").
@@ -154,7 +155,7 @@ func (repo *boardRepoPG) GetBoardByID(ctx context.Context, boardID int, hasAcces
 
 	row := repo.db.QueryRow(ctx, sqlRow, args...)
 	board = entity.BoardWithContent{}
-	err = row.Scan(&board.BoardInfo.ID, &board.BoardInfo.AuthorID, &username, &board.BoardInfo.Title, &board.BoardInfo.Description, &board.BoardInfo.CreatedAt, &board.PinsNumber, &board.Pins, &board.TagTitles)
+	err = row.Scan(&board.BoardInfo.ID, &board.BoardInfo.AuthorID, &username, &board.BoardInfo.Title, &board.BoardInfo.Description, &board.BoardInfo.CreatedAt, &board.PinsNumber, &board.Pins, &board.TagTitles, &board.SubscribersNumber)
 	if err != nil {
 		switch err {
 		case pgx.ErrNoRows:
@@ -377,3 +378,22 @@ func (b *boardRepoPG) GetProtectionStatusBoard(ctx context.Context, boardID int)
 	}
 	return repoBoard.ProtectionPrivate, nil
 }
+
+// GetMembershipByAuthor returns the ids of the pins on every board of the author.
+func (b *boardRepoPG) GetMembershipByAuthor(ctx context.Context, authorID int) (map[int][]int, error) {
+	rows, err := b.db.Query(ctx, SelectMembershipByAuthor, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("get membership by board author from storage: %w", err)
+	}
+	defer rows.Close()
+
+	var boardID, pinID int
+	membership := make(map[int][]int)
+	for rows.Next() {
+		if err = rows.Scan(&boardID, &pinID); err != nil {
+			return nil, fmt.Errorf("scan membership of the board: %w", err)
+		}
+		membership[boardID] = append(membership[boardID], pinID)
+	}
+	return membership, rows.Err()
+}
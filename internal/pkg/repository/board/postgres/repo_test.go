@@ -9,7 +9,9 @@ import (
 	"time"
 
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/board"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pashagolub/pgxmock/v2"
 	"github.com/stretchr/testify/require"
 )
@@ -195,8 +197,10 @@ func TestBoardRepo_GetBoardsByUserID(t *testing.T) {
 					AddRow(4, "title", "desc", &time.Time{}, 1, []string{"/pic1"}, []string{"blue"}).
 					AddRow(5, "title_", "desc", &time.Time{}, 0, []string{}, []string{})
 
+				// the drafts and the scheduled pins are neither counted nor used as the covers
 				mockDB.ExpectQuery(
-					`SELECT (.+) FROM board LEFT JOIN membership ON (.+) WHERE (.+) GROUP BY (.+) ORDER BY (.+)`,
+					`SELECT (.+) FILTER \(WHERE pin.deleted_at IS NULL AND NOT pin.draft AND pin.publish_at IS NULL\) AS pins_number, ` +
+						`(.+) FROM board LEFT JOIN membership ON (.+) WHERE (.+) GROUP BY (.+) ORDER BY (.+)`,
 				).WithArgs(2).WillReturnRows(rows)
 
 			},
@@ -271,3 +275,51 @@ func TestBoardRepo_GetBoardsByUserID(t *testing.T) {
 		})
 	}
 }
+
+func TestBoardRepo_RepinOnBoard(t *testing.T) {
+	mockDB, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("test: get new mock pool - %s", err.Error())
+	}
+	boardRepo := NewBoardRepoPG(mockDB)
+	boardID, pinID, userID, newPinID := 3, 14, 7, 25
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectQuery(`INSERT INTO pin \(.*source_url, source_pin_id, repin_board_id\)\s+SELECT .*board.public, pin.source_url, pin.id, \$3`).
+		WithArgs(pinID, userID, boardID).
+		WillReturnRows(mockDB.NewRows([]string{"id", "public"}).AddRow(newPinID, false))
+	mockDB.ExpectExec("INSERT INTO pin_tag").WithArgs(newPinID, pinID).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	mockDB.ExpectExec("INSERT INTO pin_image").WithArgs(newPinID, pinID).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mockDB.ExpectExec("INSERT INTO link_preview").WithArgs(newPinID, pinID).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mockDB.ExpectExec("INSERT INTO membership").WithArgs(newPinID, boardID).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mockDB.ExpectExec("UPDATE pin SET save_count").WithArgs(pinID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mockDB.ExpectCommit()
+
+	actualID, public, err := boardRepo.RepinOnBoard(context.Background(), boardID, pinID, userID)
+	require.NoError(t, err)
+	require.Equal(t, newPinID, actualID)
+	require.False(t, public)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestBoardRepo_RepinOnBoardTwice(t *testing.T) {
+	mockDB, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("test: get new mock pool - %s", err.Error())
+	}
+	boardRepo := NewBoardRepoPG(mockDB)
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectQuery("INSERT INTO pin").WithArgs(14, 7, 3).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: uniqRepin})
+	mockDB.ExpectRollback()
+
+	_, _, err = boardRepo.RepinOnBoard(context.Background(), 3, 14, 7)
+	require.ErrorIs(t, err, repository.ErrNoDataAffected)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
@@ -0,0 +1,64 @@
+package board
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
+)
+
+func (repo *boardRepoPG) SubscribeToBoard(ctx context.Context, userID, boardID int) error {
+	status, err := repo.db.Exec(ctx, InsertBoardSubscription, userID, boardID)
+	if err != nil {
+		return fmt.Errorf("insert board subscription: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return repository.ErrNoDataAffected
+	}
+	return nil
+}
+
+func (repo *boardRepoPG) UnsubscribeFromBoard(ctx context.Context, userID, boardID int) error {
+	status, err := repo.db.Exec(ctx, DeleteBoardSubscription, userID, boardID)
+	if err != nil {
+		return fmt.Errorf("delete board subscription: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return repository.ErrNoDataAffected
+	}
+	return nil
+}
+
+func (repo *boardRepoPG) GetSubscribedBoardsIDs(ctx context.Context, userID int) ([]int, error) {
+	rows, err := repo.db.Query(ctx, SelectSubscribedBoardsIDs, userID)
+	if err != nil {
+		return nil, fmt.Errorf("select subscribed boards ids: %w", err)
+	}
+	defer rows.Close()
+
+	boardsIDs := make([]int, 0)
+	var boardID int
+	for rows.Next() {
+		if err = rows.Scan(&boardID); err != nil {
+			return nil, fmt.Errorf("scan subscribed board id: %w", err)
+		}
+		boardsIDs = append(boardsIDs, boardID)
+	}
+	return boardsIDs, rows.Err()
+}
+
+func (repo *boardRepoPG) GetBoardTitle(ctx context.Context, boardID int) (string, error) {
+	var title string
+	err := repo.db.QueryRow(ctx, SelectBoardTitle, boardID).Scan(&title)
+	if err != nil {
+		switch err {
+		case pgx.ErrNoRows:
+			return "", repository.ErrNoData
+		default:
+			return "", fmt.Errorf("select board title: %w", err)
+		}
+	}
+	return title, nil
+}
@@ -20,8 +20,14 @@ type Repository interface {
 	DeleteBoardByID(ctx context.Context, boardID int) error
 	RoleUserHaveOnThisBoard(ctx context.Context, boardID int, userID int) (UserRole, error)
 	AddPinsOnBoard(ctx context.Context, boardID int, pinIds []int) error
+	RepinOnBoard(ctx context.Context, boardID, pinID, userID int) (int, bool, error)
 	DeletePinFromBoard(ctx context.Context, boardID, pinID int) error
 	GetProtectionStatusBoard(ctx context.Context, boardID int) (ProtectionBoard, error)
+	GetMembershipByAuthor(ctx context.Context, authorID int) (map[int][]int, error)
+	SubscribeToBoard(ctx context.Context, userID, boardID int) error
+	UnsubscribeFromBoard(ctx context.Context, userID, boardID int) error
+	GetSubscribedBoardsIDs(ctx context.Context, userID int) ([]int, error)
+	GetBoardTitle(ctx context.Context, boardID int) (string, error)
 }
 
 type UserRole uint8
@@ -65,18 +65,18 @@ func (mr *MockRepositoryMockRecorder) EditStatusCommentOnDeletedByID(ctx, id int
 }
 
 // GetCommensToPin mocks base method.
-func (m *MockRepository) GetCommensToPin(ctx context.Context, pinID, lastID, count int) ([]comment.Comment, error) {
+func (m *MockRepository) GetCommensToPin(ctx context.Context, viewerID, pinID, lastID, count int) ([]comment.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCommensToPin", ctx, pinID, lastID, count)
+	ret := m.ctrl.Call(m, "GetCommensToPin", ctx, viewerID, pinID, lastID, count)
 	ret0, _ := ret[0].([]comment.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCommensToPin indicates an expected call of GetCommensToPin.
-func (mr *MockRepositoryMockRecorder) GetCommensToPin(ctx, pinID, lastID, count interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetCommensToPin(ctx, viewerID, pinID, lastID, count interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommensToPin", reflect.TypeOf((*MockRepository)(nil).GetCommensToPin), ctx, pinID, lastID, count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommensToPin", reflect.TypeOf((*MockRepository)(nil).GetCommensToPin), ctx, viewerID, pinID, lastID, count)
 }
 
 // GetCommentByID mocks base method.
@@ -93,3 +93,18 @@ func (mr *MockRepositoryMockRecorder) GetCommentByID(ctx, id interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockRepository)(nil).GetCommentByID), ctx, id)
 }
+
+// GetCommentsByAuthor mocks base method.
+func (m *MockRepository) GetCommentsByAuthor(ctx context.Context, authorID int) ([]comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentsByAuthor", ctx, authorID)
+	ret0, _ := ret[0].([]comment.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentsByAuthor indicates an expected call of GetCommentsByAuthor.
+func (mr *MockRepositoryMockRecorder) GetCommentsByAuthor(ctx, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByAuthor", reflect.TypeOf((*MockRepository)(nil).GetCommentsByAuthor), ctx, authorID)
+}
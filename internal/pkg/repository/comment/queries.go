@@ -1,7 +1,13 @@
 package comment
 
 const (
-	InsertNewComment = "INSERT INTO comment (author, pin_id, content) VALUES ($1, $2, $3) RETURNING id;"
+	InsertNewComment = `INSERT INTO comment (author, pin_id, content)
+						SELECT $1, pin.id, $3 FROM pin INNER JOIN profile ON pin.author = profile.id
+						WHERE pin.id = $2 AND (pin.author = $1 OR
+							(NOT profile.private OR EXISTS (SELECT 1 FROM subscription_user WHERE who = $1 AND whom = pin.author)) AND
+							NOT EXISTS (SELECT 1 FROM user_block
+										WHERE blocker_id = pin.author AND blocked_id = $1 OR blocker_id = $1 AND blocked_id = pin.author))
+						RETURNING id;`
 
 	UpdateCommentOnDeleted = "UPDATE comment SET deleted_at = now() WHERE id = $1;"
 
@@ -13,7 +19,14 @@ const (
 	SelectCommentsByPinID = `SELECT c.id, p.id, p.username, p.avatar, c.content
 							 FROM comment AS c INNER JOIN profile AS p
 							 ON c.author = p.id
-							 WHERE c.pin_id = $1 AND (c.id < $2 OR $2 = 0) AND c.deleted_at IS NULL
+							 WHERE c.pin_id = $1 AND (c.id < $2 OR $2 = 0) AND c.deleted_at IS NULL AND
+								EXISTS (SELECT 1 FROM pin INNER JOIN profile AS a ON pin.author = a.id
+										WHERE pin.id = $1 AND (NOT a.private OR a.id = $4 OR
+											EXISTS (SELECT 1 FROM subscription_user WHERE who = $4 AND whom = a.id)))
 							 ORDER BY c.id DESC
 							 LIMIT $3;`
+
+	SelectCommentsByAuthor = `SELECT id, pin_id, content FROM comment
+							  WHERE author = $1 AND deleted_at IS NULL
+							  ORDER BY id;`
 )
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
+
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/comment"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
@@ -15,10 +17,14 @@ type Repository interface {
 	AddComment(ctx context.Context, comment *entity.Comment) (int, error)
 	GetCommentByID(ctx context.Context, id int) (*entity.Comment, error)
 	EditStatusCommentOnDeletedByID(ctx context.Context, id int) error
-	GetCommensToPin(ctx context.Context, pinID, lastID, count int) ([]entity.Comment, error)
+	GetCommensToPin(ctx context.Context, viewerID, pinID, lastID, count int) ([]entity.Comment, error)
+	GetCommentsByAuthor(ctx context.Context, authorID int) ([]entity.Comment, error)
 }
 
-var ErrUserRequired = errors.New("the comment does not have its author specified")
+var (
+	ErrUserRequired    = errors.New("the comment does not have its author specified")
+	ErrPinNotAvailable = errors.New("the pin is not available to the comment author")
+)
 
 type commentRepoPG struct {
 	db pgtype.PgxPoolIface
@@ -36,6 +42,9 @@ func (c *commentRepoPG) AddComment(ctx context.Context, comment *entity.Comment)
 	var idInsertedComment int
 	err := c.db.QueryRow(ctx, InsertNewComment, comment.Author.ID, comment.PinID, comment.Content).
 		Scan(&idInsertedComment)
+	if err == pgx.ErrNoRows {
+		return 0, ErrPinNotAvailable
+	}
 	if err != nil {
 		return 0, fmt.Errorf("add comment in storage: %w", err)
 	}
@@ -61,8 +70,8 @@ func (c *commentRepoPG) EditStatusCommentOnDeletedByID(ctx context.Context, id i
 	return nil
 }
 
-func (c *commentRepoPG) GetCommensToPin(ctx context.Context, pinID, lastID, count int) ([]entity.Comment, error) {
-	rows, err := c.db.Query(ctx, SelectCommentsByPinID, pinID, lastID, count)
+func (c *commentRepoPG) GetCommensToPin(ctx context.Context, viewerID, pinID, lastID, count int) ([]entity.Comment, error) {
+	rows, err := c.db.Query(ctx, SelectCommentsByPinID, pinID, lastID, count, viewerID)
 	if err != nil {
 		return nil, fmt.Errorf("get comments to pin from storage: %w", err)
 	}
@@ -84,3 +93,21 @@ func (c *commentRepoPG) GetCommensToPin(ctx context.Context, pinID, lastID, coun
 	}
 	return cmts, nil
 }
+
+func (c *commentRepoPG) GetCommentsByAuthor(ctx context.Context, authorID int) ([]entity.Comment, error) {
+	rows, err := c.db.Query(ctx, SelectCommentsByAuthor, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("get comments by author from storage: %w", err)
+	}
+	defer rows.Close()
+
+	comments := make([]entity.Comment, 0)
+	for rows.Next() {
+		comment := entity.Comment{Author: &user.User{ID: authorID}}
+		if err = rows.Scan(&comment.ID, &comment.PinID, &comment.Content); err != nil {
+			return nil, fmt.Errorf("scan comment of the author: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+	return comments, rows.Err()
+}
@@ -0,0 +1,58 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+//go:generate mockgen -destination=./mock/archive_mock.go -package=mock -source=archive.go ArchiveStorage
+type ArchiveStorage interface {
+	CreateArchive(exportID string) (io.WriteCloser, error)
+	OpenArchive(exportID string) (io.ReadCloser, error)
+	RemoveArchive(exportID string) error
+}
+
+type archiveStorageFS struct {
+	dir string
+}
+
+// NewArchiveStorageFS keeps the archives in the directory, it must not be served as static files.
+func NewArchiveStorageFS(dir string) *archiveStorageFS {
+	return &archiveStorageFS{dir}
+}
+
+func (a *archiveStorageFS) CreateArchive(exportID string) (io.WriteCloser, error) {
+	if err := os.MkdirAll(a.dir, 0700); err != nil {
+		return nil, fmt.Errorf("mkdir %s to save archive: %w", a.dir, err)
+	}
+
+	file, err := os.OpenFile(a.filename(exportID), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("create archive: %w", err)
+	}
+	return file, nil
+}
+
+func (a *archiveStorageFS) OpenArchive(exportID string) (io.ReadCloser, error) {
+	file, err := os.Open(a.filename(exportID))
+	if os.IsNotExist(err) {
+		return nil, &ErrExportNotFound{}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open archive: %w", err)
+	}
+	return file, nil
+}
+
+func (a *archiveStorageFS) RemoveArchive(exportID string) error {
+	if err := os.Remove(a.filename(exportID)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove archive: %w", err)
+	}
+	return nil
+}
+
+func (a *archiveStorageFS) filename(exportID string) string {
+	return filepath.Join(a.dir, filepath.Base(exportID)+".zip")
+}
@@ -0,0 +1,13 @@
+package export
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrExportNotFound struct{}
+
+func (e *ErrExportNotFound) Error() string {
+	return "data export not found"
+}
+
+func (e *ErrExportNotFound) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
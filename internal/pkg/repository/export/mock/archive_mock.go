@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: archive.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockArchiveStorage is a mock of ArchiveStorage interface.
+type MockArchiveStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveStorageMockRecorder
+}
+
+// MockArchiveStorageMockRecorder is the mock recorder for MockArchiveStorage.
+type MockArchiveStorageMockRecorder struct {
+	mock *MockArchiveStorage
+}
+
+// NewMockArchiveStorage creates a new mock instance.
+func NewMockArchiveStorage(ctrl *gomock.Controller) *MockArchiveStorage {
+	mock := &MockArchiveStorage{ctrl: ctrl}
+	mock.recorder = &MockArchiveStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchiveStorage) EXPECT() *MockArchiveStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateArchive mocks base method.
+func (m *MockArchiveStorage) CreateArchive(exportID string) (io.WriteCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArchive", exportID)
+	ret0, _ := ret[0].(io.WriteCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArchive indicates an expected call of CreateArchive.
+func (mr *MockArchiveStorageMockRecorder) CreateArchive(exportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArchive", reflect.TypeOf((*MockArchiveStorage)(nil).CreateArchive), exportID)
+}
+
+// OpenArchive mocks base method.
+func (m *MockArchiveStorage) OpenArchive(exportID string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenArchive", exportID)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenArchive indicates an expected call of OpenArchive.
+func (mr *MockArchiveStorageMockRecorder) OpenArchive(exportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenArchive", reflect.TypeOf((*MockArchiveStorage)(nil).OpenArchive), exportID)
+}
+
+// RemoveArchive mocks base method.
+func (m *MockArchiveStorage) RemoveArchive(exportID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveArchive", exportID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveArchive indicates an expected call of RemoveArchive.
+func (mr *MockArchiveStorageMockRecorder) RemoveArchive(exportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveArchive", reflect.TypeOf((*MockArchiveStorage)(nil).RemoveArchive), exportID)
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	export "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/export"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddExport mocks base method.
+func (m *MockRepository) AddExport(ctx context.Context, export *export.Export) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddExport", ctx, export)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddExport indicates an expected call of AddExport.
+func (mr *MockRepositoryMockRecorder) AddExport(ctx, export interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExport", reflect.TypeOf((*MockRepository)(nil).AddExport), ctx, export)
+}
+
+// DeleteExpiredExports mocks base method.
+func (m *MockRepository) DeleteExpiredExports(ctx context.Context, createdBefore time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredExports", ctx, createdBefore)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredExports indicates an expected call of DeleteExpiredExports.
+func (mr *MockRepositoryMockRecorder) DeleteExpiredExports(ctx, createdBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredExports", reflect.TypeOf((*MockRepository)(nil).DeleteExpiredExports), ctx, createdBefore)
+}
+
+// GetExport mocks base method.
+func (m *MockRepository) GetExport(ctx context.Context, exportID string) (*export.Export, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExport", ctx, exportID)
+	ret0, _ := ret[0].(*export.Export)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExport indicates an expected call of GetExport.
+func (mr *MockRepositoryMockRecorder) GetExport(ctx, exportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExport", reflect.TypeOf((*MockRepository)(nil).GetExport), ctx, exportID)
+}
+
+// GetLastExport mocks base method.
+func (m *MockRepository) GetLastExport(ctx context.Context, userID int) (*export.Export, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastExport", ctx, userID)
+	ret0, _ := ret[0].(*export.Export)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastExport indicates an expected call of GetLastExport.
+func (mr *MockRepositoryMockRecorder) GetLastExport(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastExport", reflect.TypeOf((*MockRepository)(nil).GetLastExport), ctx, userID)
+}
+
+// SetStatus mocks base method.
+func (m *MockRepository) SetStatus(ctx context.Context, exportID string, status export.Status) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatus", ctx, exportID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStatus indicates an expected call of SetStatus.
+func (mr *MockRepositoryMockRecorder) SetStatus(ctx, exportID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatus", reflect.TypeOf((*MockRepository)(nil).SetStatus), ctx, exportID, status)
+}
@@ -0,0 +1,10 @@
+package export
+
+var (
+	InsertExport = "INSERT INTO data_export (id, user_id) VALUES ($1, $2) RETURNING status, created_at;"
+
+	SelectExportByID   = "SELECT user_id, status, created_at, finished_at FROM data_export WHERE id = $1;"
+	SelectLastExport   = "SELECT id, status, created_at, finished_at FROM data_export WHERE user_id = $1 ORDER BY created_at DESC LIMIT 1;"
+	UpdateExportStatus = "UPDATE data_export SET status = $2, finished_at = now() WHERE id = $1;"
+	DeleteExpired      = "DELETE FROM data_export WHERE created_at < $1 RETURNING id;"
+)
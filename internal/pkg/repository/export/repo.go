@@ -0,0 +1,89 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/export"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
+)
+
+//go:generate mockgen -destination=./mock/export_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	AddExport(ctx context.Context, export *entity.Export) error
+	GetExport(ctx context.Context, exportID string) (*entity.Export, error)
+	GetLastExport(ctx context.Context, userID int) (*entity.Export, error)
+	SetStatus(ctx context.Context, exportID string, status entity.Status) error
+	DeleteExpiredExports(ctx context.Context, createdBefore time.Time) ([]string, error)
+}
+
+type exportRepoPG struct {
+	db pgtype.PgxPoolIface
+}
+
+func NewExportRepoPG(db pgtype.PgxPoolIface) *exportRepoPG {
+	return &exportRepoPG{db}
+}
+
+func (e *exportRepoPG) AddExport(ctx context.Context, export *entity.Export) error {
+	err := e.db.QueryRow(ctx, InsertExport, export.ID, export.UserID).Scan(&export.Status, &export.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("add data export in storage: %w", err)
+	}
+	return nil
+}
+
+func (e *exportRepoPG) GetExport(ctx context.Context, exportID string) (*entity.Export, error) {
+	export := &entity.Export{ID: exportID}
+	err := e.db.QueryRow(ctx, SelectExportByID, exportID).
+		Scan(&export.UserID, &export.Status, &export.CreatedAt, &export.FinishedAt)
+	if err == pgx.ErrNoRows {
+		return nil, &ErrExportNotFound{}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get data export from storage: %w", err)
+	}
+	return export, nil
+}
+
+func (e *exportRepoPG) GetLastExport(ctx context.Context, userID int) (*entity.Export, error) {
+	export := &entity.Export{UserID: userID}
+	err := e.db.QueryRow(ctx, SelectLastExport, userID).
+		Scan(&export.ID, &export.Status, &export.CreatedAt, &export.FinishedAt)
+	if err == pgx.ErrNoRows {
+		return nil, &ErrExportNotFound{}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get last data export from storage: %w", err)
+	}
+	return export, nil
+}
+
+func (e *exportRepoPG) SetStatus(ctx context.Context, exportID string, status entity.Status) error {
+	if _, err := e.db.Exec(ctx, UpdateExportStatus, exportID, status); err != nil {
+		return fmt.Errorf("set data export status in storage: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpiredExports deletes the exports created before the time and returns their ids.
+func (e *exportRepoPG) DeleteExpiredExports(ctx context.Context, createdBefore time.Time) ([]string, error) {
+	rows, err := e.db.Query(ctx, DeleteExpired, createdBefore)
+	if err != nil {
+		return nil, fmt.Errorf("delete expired data exports from storage: %w", err)
+	}
+	defer rows.Close()
+
+	exportIDs := []string{}
+	var exportID string
+	for rows.Next() {
+		if err = rows.Scan(&exportID); err != nil {
+			return nil, fmt.Errorf("scan expired data export: %w", err)
+		}
+		exportIDs = append(exportIDs, exportID)
+	}
+	return exportIDs, rows.Err()
+}
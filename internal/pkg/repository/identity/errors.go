@@ -0,0 +1,33 @@
+package identity
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrIdentityNotFound struct{}
+
+func (e *ErrIdentityNotFound) Error() string {
+	return "external identity not found"
+}
+
+func (e *ErrIdentityNotFound) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
+
+type ErrIdentityAlreadyLinked struct{}
+
+func (e *ErrIdentityAlreadyLinked) Error() string {
+	return "the external identity or another identity of the provider is already linked"
+}
+
+func (e *ErrIdentityAlreadyLinked) Type() errPkg.Type {
+	return errPkg.ErrAlreadyExists
+}
+
+type ErrAuthRequestNotFound struct{}
+
+func (e *ErrAuthRequestNotFound) Error() string {
+	return "the authorization request was not found or has expired"
+}
+
+func (e *ErrAuthRequestNotFound) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
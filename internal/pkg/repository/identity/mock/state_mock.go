@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: state.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	identity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/identity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStateRepository is a mock of StateRepository interface.
+type MockStateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateRepositoryMockRecorder
+}
+
+// MockStateRepositoryMockRecorder is the mock recorder for MockStateRepository.
+type MockStateRepositoryMockRecorder struct {
+	mock *MockStateRepository
+}
+
+// NewMockStateRepository creates a new mock instance.
+func NewMockStateRepository(ctrl *gomock.Controller) *MockStateRepository {
+	mock := &MockStateRepository{ctrl: ctrl}
+	mock.recorder = &MockStateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStateRepository) EXPECT() *MockStateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddAuthRequest mocks base method.
+func (m *MockStateRepository) AddAuthRequest(ctx context.Context, state string, req *identity.AuthRequest, lifetime time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAuthRequest", ctx, state, req, lifetime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAuthRequest indicates an expected call of AddAuthRequest.
+func (mr *MockStateRepositoryMockRecorder) AddAuthRequest(ctx, state, req, lifetime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAuthRequest", reflect.TypeOf((*MockStateRepository)(nil).AddAuthRequest), ctx, state, req, lifetime)
+}
+
+// TakeAuthRequest mocks base method.
+func (m *MockStateRepository) TakeAuthRequest(ctx context.Context, state string) (*identity.AuthRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeAuthRequest", ctx, state)
+	ret0, _ := ret[0].(*identity.AuthRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeAuthRequest indicates an expected call of TakeAuthRequest.
+func (mr *MockStateRepositoryMockRecorder) TakeAuthRequest(ctx, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeAuthRequest", reflect.TypeOf((*MockStateRepository)(nil).TakeAuthRequest), ctx, state)
+}
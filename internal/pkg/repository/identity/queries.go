@@ -0,0 +1,10 @@
+package identity
+
+var (
+	InsertIdentity = `INSERT INTO external_identity (provider, subject, user_id, email) VALUES ($1, $2, $3, $4)
+					  ON CONFLICT DO NOTHING;`
+
+	SelectUserIDByIdentity = `SELECT external_identity.user_id
+							  FROM external_identity INNER JOIN profile ON external_identity.user_id = profile.id
+							  WHERE provider = $1 AND subject = $2 AND profile.deleted_at IS NULL;`
+)
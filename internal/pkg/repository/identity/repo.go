@@ -0,0 +1,50 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/identity"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
+)
+
+//go:generate mockgen -destination=./mock/identity_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	GetUserIDByIdentity(ctx context.Context, provider, subject string) (int, error)
+	AddIdentity(ctx context.Context, identity *entity.Identity) error
+}
+
+type identityRepoPG struct {
+	db pgtype.PgxPoolIface
+}
+
+func NewIdentityRepoPG(db pgtype.PgxPoolIface) *identityRepoPG {
+	return &identityRepoPG{db}
+}
+
+// GetUserIDByIdentity returns the user to whom the identity is linked, the deleted profiles are ignored.
+func (i *identityRepoPG) GetUserIDByIdentity(ctx context.Context, provider, subject string) (int, error) {
+	var userID int
+	err := i.db.QueryRow(ctx, SelectUserIDByIdentity, provider, subject).Scan(&userID)
+	if err == pgx.ErrNoRows {
+		return 0, &ErrIdentityNotFound{}
+	}
+	if err != nil {
+		return 0, fmt.Errorf("get user id by external identity from storage: %w", err)
+	}
+	return userID, nil
+}
+
+// AddIdentity links the identity to the user, the user can have only one identity of every provider.
+func (i *identityRepoPG) AddIdentity(ctx context.Context, identity *entity.Identity) error {
+	status, err := i.db.Exec(ctx, InsertIdentity, identity.Provider, identity.Subject, identity.UserID, identity.Email)
+	if err != nil {
+		return fmt.Errorf("add external identity in storage: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return &ErrIdentityAlreadyLinked{}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/identity"
+)
+
+const prefixAuthRequest = "oidc_state:"
+
+//go:generate mockgen -destination=./mock/state_mock.go -package=mock -source=state.go StateRepository
+type StateRepository interface {
+	AddAuthRequest(ctx context.Context, state string, req *entity.AuthRequest, lifetime time.Duration) error
+	TakeAuthRequest(ctx context.Context, state string) (*entity.AuthRequest, error)
+}
+
+type stateRepo struct {
+	client *redis.Client
+}
+
+func NewStateRepo(client *redis.Client) *stateRepo {
+	return &stateRepo{client}
+}
+
+func (s *stateRepo) AddAuthRequest(ctx context.Context, state string, req *entity.AuthRequest, lifetime time.Duration) error {
+	value, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal authorization request: %w", err)
+	}
+
+	if err = s.client.Set(ctx, prefixAuthRequest+state, value, lifetime).Err(); err != nil {
+		return fmt.Errorf("add authorization request in storage: %w", err)
+	}
+	return nil
+}
+
+// TakeAuthRequest returns the request and deletes it, so the state can be used only once.
+func (s *stateRepo) TakeAuthRequest(ctx context.Context, state string) (*entity.AuthRequest, error) {
+	value, err := s.client.GetDel(ctx, prefixAuthRequest+state).Bytes()
+	if err == redis.Nil {
+		return nil, &ErrAuthRequestNotFound{}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("take authorization request from storage: %w", err)
+	}
+
+	req := &entity.AuthRequest{}
+	if err = json.Unmarshal(value, req); err != nil {
+		return nil, fmt.Errorf("unmarshal authorization request: %w", err)
+	}
+	return req, nil
+}
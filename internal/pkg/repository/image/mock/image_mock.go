@@ -34,6 +34,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// OpenImage mocks base method.
+func (m *MockRepository) OpenImage(filename string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenImage", filename)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenImage indicates an expected call of OpenImage.
+func (mr *MockRepositoryMockRecorder) OpenImage(filename interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenImage", reflect.TypeOf((*MockRepository)(nil).OpenImage), filename)
+}
+
 // SaveImage mocks base method.
 func (m *MockRepository) SaveImage(prefixPath, extension string, image io.Reader) (string, int64, error) {
 	m.ctrl.T.Helper()
@@ -1,20 +1,26 @@
 package image
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+var ErrOutsideBasePath = errors.New("the file is outside the base path of the images")
+
 //go:generate mockgen -destination=./mock/image_mock.go -package=mock -source=repo.go Repository
 type Repository interface {
 	SaveImage(prefixPath, extension string, image io.Reader) (filename string, written int64, err error)
 	SetBasePath(path string)
 	SetDirToSave(fn func() string)
+	OpenImage(filename string) (io.ReadCloser, error)
 }
 
 type imageRepoFS struct {
@@ -55,6 +61,24 @@ func (img *imageRepoFS) SaveImage(prefixPath, extension string, image io.Reader)
 	return
 }
 
+// OpenImage opens the file returned by SaveImage, only the files under the base path can be opened.
+func (img *imageRepoFS) OpenImage(filename string) (io.ReadCloser, error) {
+	img.m.Lock()
+	basePath := filepath.Clean(img.basePath)
+	img.m.Unlock()
+
+	filename = filepath.Clean(filename)
+	if !strings.HasPrefix(filename, basePath+string(filepath.Separator)) {
+		return nil, ErrOutsideBasePath
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("open image %s: %w", filename, err)
+	}
+	return file, nil
+}
+
 func (img *imageRepoFS) SetBasePath(path string) {
 	img.m.Lock()
 	img.basePath = path
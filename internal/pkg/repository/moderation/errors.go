@@ -0,0 +1,35 @@
+package moderation
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrTargetNotFound struct {
+	TargetType string
+}
+
+func (e *ErrTargetNotFound) Error() string {
+	return e.TargetType + " not found or is already in the requested state"
+}
+
+func (e *ErrTargetNotFound) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
+
+type ErrAlreadyReported struct{}
+
+func (e *ErrAlreadyReported) Error() string {
+	return "the report about it has already been sent and is not resolved yet"
+}
+
+func (e *ErrAlreadyReported) Type() errPkg.Type {
+	return errPkg.ErrAlreadyExists
+}
+
+type ErrReportNotFound struct{}
+
+func (e *ErrReportNotFound) Error() string {
+	return "report not found or has already been resolved"
+}
+
+func (e *ErrReportNotFound) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
@@ -0,0 +1,181 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	moderation "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/moderation"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddReport mocks base method.
+func (m *MockRepository) AddReport(ctx context.Context, report *moderation.Report) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReport", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReport indicates an expected call of AddReport.
+func (mr *MockRepositoryMockRecorder) AddReport(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReport", reflect.TypeOf((*MockRepository)(nil).AddReport), ctx, report)
+}
+
+// GetAuditLog mocks base method.
+func (m *MockRepository) GetAuditLog(ctx context.Context, lastID, count int) ([]moderation.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, lastID, count)
+	ret0, _ := ret[0].([]moderation.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockRepositoryMockRecorder) GetAuditLog(ctx, lastID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockRepository)(nil).GetAuditLog), ctx, lastID, count)
+}
+
+// GetReports mocks base method.
+func (m *MockRepository) GetReports(ctx context.Context, resolved bool, lastID, count int) ([]moderation.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReports", ctx, resolved, lastID, count)
+	ret0, _ := ret[0].([]moderation.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockRepositoryMockRecorder) GetReports(ctx, resolved, lastID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockRepository)(nil).GetReports), ctx, resolved, lastID, count)
+}
+
+// GetRole mocks base method.
+func (m *MockRepository) GetRole(ctx context.Context, userID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockRepositoryMockRecorder) GetRole(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockRepository)(nil).GetRole), ctx, userID)
+}
+
+// ResolveReport mocks base method.
+func (m *MockRepository) ResolveReport(ctx context.Context, reportID int, entry *moderation.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReport", ctx, reportID, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveReport indicates an expected call of ResolveReport.
+func (mr *MockRepositoryMockRecorder) ResolveReport(ctx, reportID, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReport", reflect.TypeOf((*MockRepository)(nil).ResolveReport), ctx, reportID, entry)
+}
+
+// SetHidden mocks base method.
+func (m *MockRepository) SetHidden(ctx context.Context, targetType string, targetID int, hidden bool, entry *moderation.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHidden", ctx, targetType, targetID, hidden, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHidden indicates an expected call of SetHidden.
+func (mr *MockRepositoryMockRecorder) SetHidden(ctx, targetType, targetID, hidden, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHidden", reflect.TypeOf((*MockRepository)(nil).SetHidden), ctx, targetType, targetID, hidden, entry)
+}
+
+// SetRole mocks base method.
+func (m *MockRepository) SetRole(ctx context.Context, userID int, role string, entry *moderation.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", ctx, userID, role, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRole indicates an expected call of SetRole.
+func (mr *MockRepositoryMockRecorder) SetRole(ctx, userID, role, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockRepository)(nil).SetRole), ctx, userID, role, entry)
+}
+
+// SuspendUser mocks base method.
+func (m *MockRepository) SuspendUser(ctx context.Context, userID int, until *time.Time, reason string, entry *moderation.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", ctx, userID, until, reason, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockRepositoryMockRecorder) SuspendUser(ctx, userID, until, reason, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*MockRepository)(nil).SuspendUser), ctx, userID, until, reason, entry)
+}
+
+// TargetExists mocks base method.
+func (m *MockRepository) TargetExists(ctx context.Context, targetType string, targetID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetExists", ctx, targetType, targetID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetExists indicates an expected call of TargetExists.
+func (mr *MockRepositoryMockRecorder) TargetExists(ctx, targetType, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetExists", reflect.TypeOf((*MockRepository)(nil).TargetExists), ctx, targetType, targetID)
+}
+
+// UnsuspendUser mocks base method.
+func (m *MockRepository) UnsuspendUser(ctx context.Context, userID int, entry *moderation.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsuspendUser", ctx, userID, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsuspendUser indicates an expected call of UnsuspendUser.
+func (mr *MockRepositoryMockRecorder) UnsuspendUser(ctx, userID, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsuspendUser", reflect.TypeOf((*MockRepository)(nil).UnsuspendUser), ctx, userID, entry)
+}
@@ -0,0 +1,41 @@
+package moderation
+
+var (
+	SelectRole = "SELECT role FROM profile WHERE id = $1 AND deleted_at IS NULL;"
+	UpdateRole = "UPDATE profile SET role = $2 WHERE id = $1 AND deleted_at IS NULL;"
+
+	UpdateSuspended = `UPDATE profile SET suspended_at = now(), suspended_until = $2, suspension_reason = $3
+					   WHERE id = $1 AND deleted_at IS NULL;`
+	UpdateUnsuspended = `UPDATE profile SET suspended_at = NULL, suspended_until = NULL, suspension_reason = NULL
+						 WHERE id = $1 AND suspended_at IS NOT NULL;`
+
+	// the content of the deleted account is not shown again
+	UpdatePinHidden       = "UPDATE pin SET hidden_at = now(), deleted_at = now() WHERE id = $1 AND deleted_at IS NULL;"
+	UpdatePinUnhidden     = "UPDATE pin SET hidden_at = NULL, deleted_at = NULL WHERE id = $1 AND hidden_at IS NOT NULL AND author IN (SELECT id FROM profile WHERE deleted_at IS NULL);"
+	UpdateBoardHidden     = "UPDATE board SET hidden_at = now(), deleted_at = now() WHERE id = $1 AND deleted_at IS NULL;"
+	UpdateBoardUnhidden   = "UPDATE board SET hidden_at = NULL, deleted_at = NULL WHERE id = $1 AND hidden_at IS NOT NULL AND author IN (SELECT id FROM profile WHERE deleted_at IS NULL);"
+	UpdateCommentHidden   = "UPDATE comment SET hidden_at = now(), deleted_at = now() WHERE id = $1 AND deleted_at IS NULL;"
+	UpdateCommentUnhidden = "UPDATE comment SET hidden_at = NULL, deleted_at = NULL WHERE id = $1 AND hidden_at IS NOT NULL AND author IN (SELECT id FROM profile WHERE deleted_at IS NULL);"
+
+	SelectUserExists    = "SELECT EXISTS (SELECT 1 FROM profile WHERE id = $1 AND deleted_at IS NULL);"
+	SelectPinExists     = "SELECT EXISTS (SELECT 1 FROM pin WHERE id = $1 AND deleted_at IS NULL);"
+	SelectBoardExists   = "SELECT EXISTS (SELECT 1 FROM board WHERE id = $1 AND deleted_at IS NULL);"
+	SelectCommentExists = "SELECT EXISTS (SELECT 1 FROM comment WHERE id = $1 AND deleted_at IS NULL);"
+
+	InsertReport = `INSERT INTO report (reporter_id, target_type, target_id, reason) VALUES ($1, $2, $3, $4)
+					ON CONFLICT DO NOTHING
+					RETURNING id, created_at;`
+	SelectReports = `SELECT id, reporter_id, target_type, target_id, reason, created_at, resolved_at, resolved_by
+					 FROM report
+					 WHERE (resolved_at IS NOT NULL) = $1 AND (id < $2 OR $2 = 0)
+					 ORDER BY id DESC
+					 LIMIT $3;`
+	UpdateReportResolved = "UPDATE report SET resolved_at = now(), resolved_by = $2 WHERE id = $1 AND resolved_at IS NULL;"
+
+	InsertAuditEntry = "INSERT INTO audit_log (actor_id, action, target_type, target_id, details) VALUES ($1, $2, $3, $4, $5);"
+	SelectAuditLog   = `SELECT id, actor_id, action, target_type, target_id, details, created_at
+						FROM audit_log
+						WHERE id < $1 OR $1 = 0
+						ORDER BY id DESC
+						LIMIT $2;`
+)
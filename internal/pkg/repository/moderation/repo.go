@@ -0,0 +1,231 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/moderation"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
+)
+
+// Repository writes every action of the staff to the audit log in the same transaction.
+//
+//go:generate mockgen -destination=./mock/moderation_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	GetRole(ctx context.Context, userID int) (string, error)
+	SetRole(ctx context.Context, userID int, role string, entry *entity.AuditEntry) error
+	SuspendUser(ctx context.Context, userID int, until *time.Time, reason string, entry *entity.AuditEntry) error
+	UnsuspendUser(ctx context.Context, userID int, entry *entity.AuditEntry) error
+	SetHidden(ctx context.Context, targetType string, targetID int, hidden bool, entry *entity.AuditEntry) error
+	TargetExists(ctx context.Context, targetType string, targetID int) (bool, error)
+	AddReport(ctx context.Context, report *entity.Report) error
+	GetReports(ctx context.Context, resolved bool, lastID, count int) ([]entity.Report, error)
+	ResolveReport(ctx context.Context, reportID int, entry *entity.AuditEntry) error
+	GetAuditLog(ctx context.Context, lastID, count int) ([]entity.AuditEntry, error)
+}
+
+var (
+	hideQueries = map[string]string{
+		entity.TargetPin:     UpdatePinHidden,
+		entity.TargetBoard:   UpdateBoardHidden,
+		entity.TargetComment: UpdateCommentHidden,
+	}
+	unhideQueries = map[string]string{
+		entity.TargetPin:     UpdatePinUnhidden,
+		entity.TargetBoard:   UpdateBoardUnhidden,
+		entity.TargetComment: UpdateCommentUnhidden,
+	}
+	existsQueries = map[string]string{
+		entity.TargetUser:    SelectUserExists,
+		entity.TargetPin:     SelectPinExists,
+		entity.TargetBoard:   SelectBoardExists,
+		entity.TargetComment: SelectCommentExists,
+	}
+)
+
+type moderationRepoPG struct {
+	db pgtype.PgxPoolIface
+}
+
+func NewModerationRepoPG(db pgtype.PgxPoolIface) *moderationRepoPG {
+	return &moderationRepoPG{db}
+}
+
+func (m *moderationRepoPG) GetRole(ctx context.Context, userID int) (role string, err error) {
+	err = m.db.QueryRow(ctx, SelectRole, userID).Scan(&role)
+	if err == pgx.ErrNoRows {
+		return "", &ErrTargetNotFound{TargetType: entity.TargetUser}
+	}
+	if err != nil {
+		return "", fmt.Errorf("get role of the user from storage: %w", err)
+	}
+	return role, nil
+}
+
+func (m *moderationRepoPG) SetRole(ctx context.Context, userID int, role string, entry *entity.AuditEntry) error {
+	err := m.withAudit(ctx, entry, func(tx pgx.Tx) (pgconn.CommandTag, error) {
+		return tx.Exec(ctx, UpdateRole, userID, role)
+	})
+	if err != nil {
+		return fmt.Errorf("set role of the user in storage: %w", err)
+	}
+	return nil
+}
+
+// SuspendUser suspends the user until the time or, if it is nil, until the suspension is lifted.
+func (m *moderationRepoPG) SuspendUser(ctx context.Context, userID int, until *time.Time, reason string, entry *entity.AuditEntry) error {
+	err := m.withAudit(ctx, entry, func(tx pgx.Tx) (pgconn.CommandTag, error) {
+		return tx.Exec(ctx, UpdateSuspended, userID, until, reason)
+	})
+	if err != nil {
+		return fmt.Errorf("suspend user in storage: %w", err)
+	}
+	return nil
+}
+
+func (m *moderationRepoPG) UnsuspendUser(ctx context.Context, userID int, entry *entity.AuditEntry) error {
+	err := m.withAudit(ctx, entry, func(tx pgx.Tx) (pgconn.CommandTag, error) {
+		return tx.Exec(ctx, UpdateUnsuspended, userID)
+	})
+	if err != nil {
+		return fmt.Errorf("unsuspend user in storage: %w", err)
+	}
+	return nil
+}
+
+func (m *moderationRepoPG) SetHidden(ctx context.Context, targetType string, targetID int, hidden bool, entry *entity.AuditEntry) error {
+	queries := unhideQueries
+	if hidden {
+		queries = hideQueries
+	}
+	query, ok := queries[targetType]
+	if !ok {
+		return fmt.Errorf("set hidden: unknown target type %q", targetType)
+	}
+
+	err := m.withAudit(ctx, entry, func(tx pgx.Tx) (pgconn.CommandTag, error) {
+		return tx.Exec(ctx, query, targetID)
+	})
+	if err != nil {
+		return fmt.Errorf("set hidden %s in storage: %w", targetType, err)
+	}
+	return nil
+}
+
+func (m *moderationRepoPG) TargetExists(ctx context.Context, targetType string, targetID int) (exists bool, err error) {
+	query, ok := existsQueries[targetType]
+	if !ok {
+		return false, fmt.Errorf("check target existence: unknown target type %q", targetType)
+	}
+
+	if err = m.db.QueryRow(ctx, query, targetID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("check %s existence in storage: %w", targetType, err)
+	}
+	return exists, nil
+}
+
+// AddReport saves the report if the reporter has no unresolved report about the same target.
+func (m *moderationRepoPG) AddReport(ctx context.Context, report *entity.Report) error {
+	err := m.db.QueryRow(ctx, InsertReport, report.ReporterID, report.TargetType, report.TargetID, report.Reason).
+		Scan(&report.ID, &report.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return &ErrAlreadyReported{}
+	}
+	if err != nil {
+		return fmt.Errorf("add report in storage: %w", err)
+	}
+	return nil
+}
+
+func (m *moderationRepoPG) GetReports(ctx context.Context, resolved bool, lastID, count int) ([]entity.Report, error) {
+	rows, err := m.db.Query(ctx, SelectReports, resolved, lastID, count)
+	if err != nil {
+		return nil, fmt.Errorf("get reports from storage: %w", err)
+	}
+	defer rows.Close()
+
+	reports := make([]entity.Report, 0, count)
+	for rows.Next() {
+		r := entity.Report{}
+		err = rows.Scan(&r.ID, &r.ReporterID, &r.TargetType, &r.TargetID, &r.Reason,
+			&r.CreatedAt, &r.ResolvedAt, &r.ResolvedBy)
+		if err != nil {
+			return nil, fmt.Errorf("scan report: %w", err)
+		}
+		reports = append(reports, r)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("get reports from storage: %w", err)
+	}
+	return reports, nil
+}
+
+func (m *moderationRepoPG) ResolveReport(ctx context.Context, reportID int, entry *entity.AuditEntry) error {
+	err := m.withAudit(ctx, entry, func(tx pgx.Tx) (pgconn.CommandTag, error) {
+		return tx.Exec(ctx, UpdateReportResolved, reportID, entry.ActorID)
+	})
+	var notFound *ErrTargetNotFound
+	if errors.As(err, &notFound) {
+		return &ErrReportNotFound{}
+	}
+	if err != nil {
+		return fmt.Errorf("resolve report in storage: %w", err)
+	}
+	return nil
+}
+
+func (m *moderationRepoPG) GetAuditLog(ctx context.Context, lastID, count int) ([]entity.AuditEntry, error) {
+	rows, err := m.db.Query(ctx, SelectAuditLog, lastID, count)
+	if err != nil {
+		return nil, fmt.Errorf("get audit log from storage: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]entity.AuditEntry, 0, count)
+	for rows.Next() {
+		e := entity.AuditEntry{}
+		err = rows.Scan(&e.ID, &e.ActorID, &e.Action, &e.TargetType, &e.TargetID, &e.Details, &e.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan audit entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("get audit log from storage: %w", err)
+	}
+	return entries, nil
+}
+
+// withAudit runs the action and records the entry only if the action has changed the target,
+// otherwise ErrTargetNotFound is returned.
+func (m *moderationRepoPG) withAudit(ctx context.Context, entry *entity.AuditEntry,
+	action func(tx pgx.Tx) (pgconn.CommandTag, error)) error {
+	tx, err := m.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	status, err := action(tx)
+	if err != nil {
+		return err
+	}
+	if status.RowsAffected() == 0 {
+		return &ErrTargetNotFound{TargetType: entry.TargetType}
+	}
+
+	_, err = tx.Exec(ctx, InsertAuditEntry, entry.ActorID, entry.Action, entry.TargetType, entry.TargetID, entry.Details)
+	if err != nil {
+		return fmt.Errorf("insert audit entry: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
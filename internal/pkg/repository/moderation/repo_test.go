@@ -0,0 +1,79 @@
+package moderation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v2"
+	"github.com/stretchr/testify/require"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/moderation"
+)
+
+func TestSetHidden(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewModerationRepoPG(pool)
+	actorID := 1
+	entry := &entity.AuditEntry{
+		ActorID:    &actorID,
+		Action:     entity.ActionHide,
+		TargetType: entity.TargetPin,
+		TargetID:   12,
+		Details:    "spam",
+	}
+
+	pool.ExpectBegin()
+	pool.ExpectExec("UPDATE pin SET hidden_at = now()").
+		WithArgs(12).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	pool.ExpectExec("INSERT INTO audit_log").
+		WithArgs(&actorID, entity.ActionHide, entity.TargetPin, 12, "spam").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	pool.ExpectCommit()
+
+	err = repo.SetHidden(ctx, entity.TargetPin, 12, true, entry)
+	require.NoError(t, err)
+
+	pool.ExpectBegin()
+	pool.ExpectExec("UPDATE pin SET hidden_at = NULL").
+		WithArgs(12).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+	pool.ExpectRollback()
+
+	err = repo.SetHidden(ctx, entity.TargetPin, 12, false, entry)
+	require.ErrorAs(t, err, new(*ErrTargetNotFound))
+	require.NoError(t, pool.ExpectationsWereMet())
+}
+
+func TestAddReport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewModerationRepoPG(pool)
+	report := &entity.Report{
+		ReporterID: 3,
+		TargetType: entity.TargetComment,
+		TargetID:   40,
+		Reason:     "insult",
+	}
+
+	pool.ExpectQuery("INSERT INTO report").
+		WithArgs(3, entity.TargetComment, 40, "insult").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}))
+
+	err = repo.AddReport(ctx, report)
+	require.ErrorAs(t, err, new(*ErrAlreadyReported))
+	require.NoError(t, pool.ExpectationsWereMet())
+}
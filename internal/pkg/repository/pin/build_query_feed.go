@@ -11,11 +11,17 @@ func addFilters(queryBuild sq.SelectBuilder, cfg entity.FeedPinConfig, pin *enti
 
 	queryBuild, fields = protection(queryBuild, cfg, pin, fields)
 	queryBuild = addFilterDeleted(queryBuild, cfg.Deleted)
+	queryBuild = addFilterPublished(queryBuild, cfg)
 	queryBuild, ok := addFilterLiked(queryBuild, cfg)
 	if !ok {
 		queryBuild = addFilterUser(queryBuild, cfg)
 	}
 	queryBuild = addFilterBoard(queryBuild, cfg)
+	queryBuild = addFilterFollowedBoards(queryBuild, cfg)
+	queryBuild = addFilterFollowing(queryBuild, cfg)
+	queryBuild = addFilterPins(queryBuild, cfg)
+	queryBuild = addFilterBlocked(queryBuild, cfg)
+	queryBuild = addFilterPrivate(queryBuild, cfg)
 	return queryBuild, fields
 }
 
@@ -44,6 +50,15 @@ func addFilterDeleted(queryBuild sq.SelectBuilder, deleted bool) sq.SelectBuilde
 	return queryBuild
 }
 
+// addFilterPublished leaves the drafts and the scheduled pins only for their authors.
+func addFilterPublished(queryBuild sq.SelectBuilder, cfg entity.FeedPinConfig) sq.SelectBuilder {
+	published := sq.And{sq.Eq{"pin.draft": false}, sq.Eq{"pin.publish_at": nil}}
+	if viewerID, ok := cfg.Viewer(); ok {
+		return queryBuild.Where(sq.Or{published, sq.Eq{"pin.author": viewerID}})
+	}
+	return queryBuild.Where(published)
+}
+
 func addFilterBoard(queryBuild sq.SelectBuilder, cfg entity.FeedPinConfig) sq.SelectBuilder {
 	if boardID, ok := cfg.Board(); ok {
 		queryBuild = queryBuild.InnerJoin("membership ON membership.pin_id = pin.id").
@@ -53,6 +68,41 @@ func addFilterBoard(queryBuild sq.SelectBuilder, cfg entity.FeedPinConfig) sq.Se
 	return queryBuild
 }
 
+// the pin is on the board the viewer is subscribed to, a private board counts
+// only while the viewer is its author or contributor
+const followedBoardsCondition = `EXISTS (SELECT 1 FROM membership m
+		INNER JOIN subscription_board sb ON m.board_id = sb.board_id
+		INNER JOIN board b ON m.board_id = b.id
+		WHERE m.pin_id = pin.id AND sb.user_id = ? AND b.deleted_at IS NULL
+			AND (b.public OR b.author = ? OR EXISTS (SELECT 1 FROM contributor c WHERE c.board_id = b.id AND c.user_id = ?)))`
+
+// the pin has one of the tags the viewer likes the most
+const likedTagsCondition = `EXISTS (SELECT 1 FROM pin_tag pt WHERE pt.pin_id = pin.id AND pt.tag_id IN (
+		SELECT lt.tag_id FROM like_pin l INNER JOIN pin_tag lt ON l.pin_id = lt.pin_id
+		WHERE l.user_id = ? GROUP BY lt.tag_id ORDER BY COUNT(*) DESC LIMIT 20))`
+
+func addFilterFollowedBoards(queryBuild sq.SelectBuilder, cfg entity.FeedPinConfig) sq.SelectBuilder {
+	viewerID, ok := cfg.Viewer()
+	if !cfg.FollowedBoards || !ok {
+		return queryBuild
+	}
+	return queryBuild.Where(followedBoardsCondition, viewerID, viewerID, viewerID)
+}
+
+// addFilterFollowing leaves the pins of the other users that the viewer follows, the pins of the followed boards
+// and the pins with the tags of the liked pins.
+func addFilterFollowing(queryBuild sq.SelectBuilder, cfg entity.FeedPinConfig) sq.SelectBuilder {
+	viewerID, ok := cfg.Viewer()
+	if !cfg.Following || !ok {
+		return queryBuild
+	}
+	return queryBuild.Where(sq.NotEq{"pin.author": viewerID}).Where(sq.Or{
+		sq.Expr("EXISTS (SELECT 1 FROM subscription_user WHERE who = ? AND whom = pin.author)", viewerID),
+		sq.Expr(followedBoardsCondition, viewerID, viewerID, viewerID),
+		sq.Expr(likedTagsCondition, viewerID),
+	})
+}
+
 func addFilterLiked(queryBuild sq.SelectBuilder, cfg entity.FeedPinConfig) (sq.SelectBuilder, bool) {
 	if userID, ok := cfg.User(); ok && cfg.Liked {
 		queryBuild = queryBuild.InnerJoin("like_pin ON like_pin.pin_id = pin.id").
@@ -69,3 +119,29 @@ func addFilterUser(queryBuild sq.SelectBuilder, cfg entity.FeedPinConfig) sq.Sel
 	}
 	return queryBuild
 }
+
+func addFilterPins(queryBuild sq.SelectBuilder, cfg entity.FeedPinConfig) sq.SelectBuilder {
+	if pinIDs, ok := cfg.Pins(); ok {
+		queryBuild = queryBuild.Where(sq.Eq{"pin.id": pinIDs})
+	}
+	return queryBuild
+}
+
+func addFilterBlocked(queryBuild sq.SelectBuilder, cfg entity.FeedPinConfig) sq.SelectBuilder {
+	if viewerID, ok := cfg.Viewer(); ok {
+		queryBuild = queryBuild.Where(`NOT EXISTS (SELECT 1 FROM user_block
+			WHERE blocker_id = pin.author AND blocked_id = ? OR blocker_id = ? AND blocked_id = pin.author)`,
+			viewerID, viewerID)
+	}
+	return queryBuild
+}
+
+// addFilterPrivate leaves the pins of the private profiles only for their authors and approved followers.
+func addFilterPrivate(queryBuild sq.SelectBuilder, cfg entity.FeedPinConfig) sq.SelectBuilder {
+	notPrivate := "NOT EXISTS (SELECT 1 FROM profile WHERE profile.id = pin.author AND profile.private)"
+	if viewerID, ok := cfg.Viewer(); ok {
+		return queryBuild.Where("("+notPrivate+` OR pin.author = ?
+			OR EXISTS (SELECT 1 FROM subscription_user WHERE who = ? AND whom = pin.author))`, viewerID, viewerID)
+	}
+	return queryBuild.Where(notPrivate)
+}
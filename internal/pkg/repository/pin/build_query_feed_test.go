@@ -0,0 +1,60 @@
+package pin
+
+import (
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/require"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+)
+
+func TestAddFilterFollowing(t *testing.T) {
+	viewerID := 7
+
+	testCases := []struct {
+		name      string
+		following bool
+		hasViewer bool
+		wantWhere bool
+	}{
+		{
+			name:      "following feed of the viewer",
+			following: true,
+			hasViewer: true,
+			wantWhere: true,
+		},
+		{
+			name:      "not following feed",
+			hasViewer: true,
+		},
+		{
+			name:      "following feed without viewer",
+			following: true,
+		},
+	}
+
+	for _, tCase := range testCases {
+		t.Run(tCase.name, func(t *testing.T) {
+			cfg := entity.FeedPinConfig{Following: tCase.following}
+			if tCase.hasViewer {
+				cfg.SetViewer(viewerID)
+			}
+
+			sqlRow, args, err := addFilterFollowing(sq.Select("pin.id").From("pin"), cfg).ToSql()
+			require.NoError(t, err)
+			if !tCase.wantWhere {
+				require.Equal(t, "SELECT pin.id FROM pin", sqlRow)
+				require.Empty(t, args)
+				return
+			}
+
+			require.Contains(t, sqlRow, "pin.author <> ?")
+			require.Contains(t, sqlRow, "EXISTS (SELECT 1 FROM subscription_user WHERE who = ? AND whom = pin.author)")
+			require.Contains(t, sqlRow, "INNER JOIN subscription_board sb ON m.board_id = sb.board_id")
+			require.Contains(t, sqlRow, "FROM like_pin l INNER JOIN pin_tag lt ON l.pin_id = lt.pin_id")
+			// the own pins, the followed users, three for the followed boards and the liked tags
+			require.Equal(t, []any{viewerID, viewerID, viewerID, viewerID, viewerID, viewerID}, args)
+		})
+	}
+}
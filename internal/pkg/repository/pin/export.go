@@ -0,0 +1,51 @@
+package pin
+
+import (
+	"context"
+	"fmt"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+)
+
+// GetPinsByAuthor returns all not deleted pins of the user with their tags and images, both public and private.
+func (p *pinRepoPG) GetPinsByAuthor(ctx context.Context, userID int) ([]entity.Pin, error) {
+	rows, err := p.db.Query(ctx, SelectPinsByAuthor, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get pins by author from storage: %w", err)
+	}
+	defer rows.Close()
+
+	pins := make([]entity.Pin, 0)
+	for rows.Next() {
+		pin := entity.Pin{Author: &user.User{ID: userID}}
+		titles := []string{}
+		err = rows.Scan(&pin.ID, &pin.Title, &pin.Description, &pin.Picture, &pin.Public, &titles, &pin.Images)
+		if err != nil {
+			return nil, fmt.Errorf("scan pin of the author: %w", err)
+		}
+		for _, title := range titles {
+			pin.Tags = append(pin.Tags, entity.Tag{Title: title})
+		}
+		pins = append(pins, pin)
+	}
+	return pins, rows.Err()
+}
+
+func (p *pinRepoPG) GetLikedPinIDs(ctx context.Context, userID int) ([]int, error) {
+	rows, err := p.db.Query(ctx, SelectLikedPinIDs, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get liked pins from storage: %w", err)
+	}
+	defer rows.Close()
+
+	var pinID int
+	pinIDs := make([]int, 0)
+	for rows.Next() {
+		if err = rows.Scan(&pinID); err != nil {
+			return nil, fmt.Errorf("scan liked pin: %w", err)
+		}
+		pinIDs = append(pinIDs, pinID)
+	}
+	return pinIDs, rows.Err()
+}
@@ -0,0 +1,68 @@
+package pin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
+)
+
+func (p *pinRepoPG) GetImagesByPinID(ctx context.Context, pinID int) ([]string, error) {
+	rows, err := p.db.Query(ctx, SelectImagesByPinID, pinID)
+	if err != nil {
+		return nil, fmt.Errorf("get pin images by its id in storage: %w", err)
+	}
+	defer rows.Close()
+
+	images := []string{}
+	var image string
+	for rows.Next() {
+		if err = rows.Scan(&image); err != nil {
+			return nil, fmt.Errorf("scan pin image in storage: %w", err)
+		}
+		images = append(images, image)
+	}
+	return images, rows.Err()
+}
+
+// replacePinImages sets the new list of the images of the pin, the first of them becomes the cover.
+func (p *pinRepoPG) replacePinImages(ctx context.Context, tx pgx.Tx, pinID, userID int, images []string) error {
+	status, err := tx.Exec(ctx, UpdatePinCover, pinID, userID, images[0])
+	if err != nil {
+		return fmt.Errorf("update pin cover: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return repository.ErrNoDataAffected
+	}
+
+	if _, err = tx.Exec(ctx, DeleteAllPinImages, pinID); err != nil {
+		return fmt.Errorf("delete pin images: %w", err)
+	}
+
+	if err = p.addImagesOnPin(ctx, tx, pinID, images); err != nil {
+		return fmt.Errorf("add pin images: %w", err)
+	}
+	return nil
+}
+
+func (p *pinRepoPG) addImagesOnPin(ctx context.Context, tx pgx.Tx, pinID int, images []string) error {
+	if len(images) == 0 {
+		return nil
+	}
+
+	insertBuilder := p.sqlBuilder.Insert("pin_image").Columns("pin_id", "position", "picture")
+	for position, image := range images {
+		insertBuilder = insertBuilder.Values(pinID, position, image)
+	}
+	sqlRow, args, err := insertBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build sql query row for insert pin images: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlRow, args...); err != nil {
+		return fmt.Errorf("executing a query to insert pin images: %w", err)
+	}
+	return nil
+}
@@ -5,12 +5,17 @@ import (
 	"fmt"
 
 	"github.com/jackc/pgx/v5"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
 )
 
 func (p *pinRepoPG) SetLike(ctx context.Context, pinID, userID int) (int, error) {
-	row := p.db.QueryRow(ctx, InsertLikePinFromUser, pinID, userID)
+	row := p.db.QueryRow(ctx, InsertLikePinFromUserAtomic, pinID, userID)
 	var currCountLike int
 	err := row.Scan(&currCountLike)
+	if err == pgx.ErrNoRows {
+		return 0, repository.ErrNoDataAffected
+	}
 	if err != nil {
 		return 0, fmt.Errorf("insert like to pin from user in storage: %w", err)
 	}
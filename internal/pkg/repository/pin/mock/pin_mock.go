@@ -81,17 +81,32 @@ func (mr *MockRepositoryMockRecorder) DeletePin(ctx, pinID, userID interface{})
 }
 
 // EditPin mocks base method.
-func (m *MockRepository) EditPin(ctx context.Context, pinID, userID int, updateData pin0.S, titleTags []string) error {
+func (m *MockRepository) EditPin(ctx context.Context, pinID, userID int, updateData pin0.S, titleTags, images []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EditPin", ctx, pinID, userID, updateData, titleTags)
+	ret := m.ctrl.Call(m, "EditPin", ctx, pinID, userID, updateData, titleTags, images)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // EditPin indicates an expected call of EditPin.
-func (mr *MockRepositoryMockRecorder) EditPin(ctx, pinID, userID, updateData, titleTags interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) EditPin(ctx, pinID, userID, updateData, titleTags, images interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditPin", reflect.TypeOf((*MockRepository)(nil).EditPin), ctx, pinID, userID, updateData, titleTags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditPin", reflect.TypeOf((*MockRepository)(nil).EditPin), ctx, pinID, userID, updateData, titleTags, images)
+}
+
+// GetAttributionByPinID mocks base method.
+func (m *MockRepository) GetAttributionByPinID(ctx context.Context, pinID int) ([]pin.Attribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributionByPinID", ctx, pinID)
+	ret0, _ := ret[0].([]pin.Attribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributionByPinID indicates an expected call of GetAttributionByPinID.
+func (mr *MockRepositoryMockRecorder) GetAttributionByPinID(ctx, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributionByPinID", reflect.TypeOf((*MockRepository)(nil).GetAttributionByPinID), ctx, pinID)
 }
 
 // GetAuthorPin mocks base method.
@@ -139,6 +154,21 @@ func (mr *MockRepositoryMockRecorder) GetCountLikeByPinID(ctx, pinID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountLikeByPinID", reflect.TypeOf((*MockRepository)(nil).GetCountLikeByPinID), ctx, pinID)
 }
 
+// GetCountSaveByPinID mocks base method.
+func (m *MockRepository) GetCountSaveByPinID(ctx context.Context, pinID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCountSaveByPinID", ctx, pinID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountSaveByPinID indicates an expected call of GetCountSaveByPinID.
+func (mr *MockRepositoryMockRecorder) GetCountSaveByPinID(ctx, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountSaveByPinID", reflect.TypeOf((*MockRepository)(nil).GetCountSaveByPinID), ctx, pinID)
+}
+
 // GetFeedPins mocks base method.
 func (m *MockRepository) GetFeedPins(ctx context.Context, cfg pin.FeedPinConfig) (pin.FeedPin, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +184,36 @@ func (mr *MockRepositoryMockRecorder) GetFeedPins(ctx, cfg interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedPins", reflect.TypeOf((*MockRepository)(nil).GetFeedPins), ctx, cfg)
 }
 
+// GetImagesByPinID mocks base method.
+func (m *MockRepository) GetImagesByPinID(ctx context.Context, pinID int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImagesByPinID", ctx, pinID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImagesByPinID indicates an expected call of GetImagesByPinID.
+func (mr *MockRepositoryMockRecorder) GetImagesByPinID(ctx, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImagesByPinID", reflect.TypeOf((*MockRepository)(nil).GetImagesByPinID), ctx, pinID)
+}
+
+// GetLikedPinIDs mocks base method.
+func (m *MockRepository) GetLikedPinIDs(ctx context.Context, userID int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLikedPinIDs", ctx, userID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLikedPinIDs indicates an expected call of GetLikedPinIDs.
+func (mr *MockRepositoryMockRecorder) GetLikedPinIDs(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedPinIDs", reflect.TypeOf((*MockRepository)(nil).GetLikedPinIDs), ctx, userID)
+}
+
 // GetPinByID mocks base method.
 func (m *MockRepository) GetPinByID(ctx context.Context, pinID int, revealAuthor bool) (*pin.Pin, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +229,21 @@ func (mr *MockRepositoryMockRecorder) GetPinByID(ctx, pinID, revealAuthor interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinByID", reflect.TypeOf((*MockRepository)(nil).GetPinByID), ctx, pinID, revealAuthor)
 }
 
+// GetPinsByAuthor mocks base method.
+func (m *MockRepository) GetPinsByAuthor(ctx context.Context, userID int) ([]pin.Pin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPinsByAuthor", ctx, userID)
+	ret0, _ := ret[0].([]pin.Pin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPinsByAuthor indicates an expected call of GetPinsByAuthor.
+func (mr *MockRepositoryMockRecorder) GetPinsByAuthor(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinsByAuthor", reflect.TypeOf((*MockRepository)(nil).GetPinsByAuthor), ctx, userID)
+}
+
 // GetTagsByPinID mocks base method.
 func (m *MockRepository) GetTagsByPinID(ctx context.Context, pinID int) ([]pin.Tag, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +259,21 @@ func (mr *MockRepositoryMockRecorder) GetTagsByPinID(ctx, pinID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByPinID", reflect.TypeOf((*MockRepository)(nil).GetTagsByPinID), ctx, pinID)
 }
 
+// HasFollowingSources mocks base method.
+func (m *MockRepository) HasFollowingSources(ctx context.Context, userID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasFollowingSources", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasFollowingSources indicates an expected call of HasFollowingSources.
+func (mr *MockRepositoryMockRecorder) HasFollowingSources(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFollowingSources", reflect.TypeOf((*MockRepository)(nil).HasFollowingSources), ctx, userID)
+}
+
 // IsAvailableToUserAsContributorBoard mocks base method.
 func (m *MockRepository) IsAvailableToUserAsContributorBoard(ctx context.Context, pinID, userID int) (bool, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +304,21 @@ func (mr *MockRepositoryMockRecorder) IsSetLike(ctx, pinID, userID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSetLike", reflect.TypeOf((*MockRepository)(nil).IsSetLike), ctx, pinID, userID)
 }
 
+// PublishScheduledPins mocks base method.
+func (m *MockRepository) PublishScheduledPins(ctx context.Context) ([]pin.Pin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduledPins", ctx)
+	ret0, _ := ret[0].([]pin.Pin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduledPins indicates an expected call of PublishScheduledPins.
+func (mr *MockRepositoryMockRecorder) PublishScheduledPins(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduledPins", reflect.TypeOf((*MockRepository)(nil).PublishScheduledPins), ctx)
+}
+
 // SetLike mocks base method.
 func (m *MockRepository) SetLike(ctx context.Context, pinID, userID int) (int, error) {
 	m.ctrl.T.Helper()
@@ -1,9 +1,9 @@
 package pin
 
 var (
-	SelectPinByID           = "SELECT author, title, description, picture, public, deleted_at FROM pin WHERE id = $1;"
+	SelectPinByID           = "SELECT author, title, description, picture, public, deleted_at, draft, publish_at, source_url FROM pin WHERE id = $1;"
 	SelectCountLikePin      = "SELECT COUNT(*) FROM like_pin WHERE pin_id = $1;"
-	SelectPinByIDWithAuthor = `SELECT author, title, description, picture, public, pin.deleted_at, username, avatar 
+	SelectPinByIDWithAuthor = `SELECT author, title, description, picture, public, pin.deleted_at, draft, publish_at, source_url, username, avatar 
 	                           FROM pin INNER JOIN profile ON author = profile.id WHERE pin.id = $1;`
 	SelectTagsByPinID = `SELECT tag.title FROM pin INNER JOIN pin_tag ON pin.id = pin_tag.pin_id
 							   INNER JOIN tag ON pin_tag.tag_id = tag.id WHERE pin.id = $1;`
@@ -13,11 +13,36 @@ var (
 			                                  ON board.id = contributor.board_id 
 			                                  WHERE pin.id = $1 AND (board.author = $2 OR contributor.user_id = $2));`
 	SelectCheckSetLike = "SELECT pin_id FROM like_pin WHERE pin_id = $1 AND user_id = $2;"
+	SelectPinsByAuthor = `SELECT pin.id, pin.title, pin.description, pin.picture, pin.public,
+							  COALESCE(ARRAY_AGG(tag.title) FILTER (WHERE tag.title IS NOT NULL), ARRAY[]::TEXT[]),
+							  ARRAY(SELECT pin_image.picture FROM pin_image WHERE pin_image.pin_id = pin.id ORDER BY pin_image.position)
+						  FROM pin LEFT JOIN pin_tag ON pin.id = pin_tag.pin_id LEFT JOIN tag ON pin_tag.tag_id = tag.id
+						  WHERE pin.author = $1 AND pin.deleted_at IS NULL
+						  GROUP BY pin.id
+						  ORDER BY pin.id;`
+	SelectLikedPinIDs         = "SELECT pin_id FROM like_pin WHERE user_id = $1 ORDER BY created_at;"
+	SelectHasFollowingSources = `SELECT EXISTS (SELECT 1 FROM subscription_user WHERE who = $1)
+								 OR EXISTS (SELECT 1 FROM subscription_board WHERE user_id = $1)
+								 OR EXISTS (SELECT 1 FROM like_pin WHERE user_id = $1);`
+
+	SelectCountSavePin = "SELECT save_count FROM pin WHERE id = $1;"
+	// the pins the pin was saved from, from the nearest to the original one
+	SelectAttributionByPinID = `WITH RECURSIVE chain AS (
+									SELECT source_pin_id AS id, 1 AS depth FROM pin WHERE id = $1 AND source_pin_id IS NOT NULL
+									UNION ALL
+									SELECT pin.source_pin_id, chain.depth + 1 FROM pin INNER JOIN chain ON pin.id = chain.id
+									WHERE pin.source_pin_id IS NOT NULL
+								)
+								SELECT pin.id, profile.id, profile.username, profile.avatar
+								FROM chain INNER JOIN pin ON chain.id = pin.id INNER JOIN profile ON pin.author = profile.id
+								ORDER BY chain.depth;`
 
 	InsertLikePinFromUser       = "INSERT INTO like_pin (pin_id, user_id) VALUES ($1, $2) RETURNING (SELECT COUNT(*) FROM like_pin WHERE pin_id = $1);"
 	InsertLikePinFromUserAtomic = `INSERT INTO like_pin (pin_id, user_id)
 							 	   SELECT $1, $2 WHERE (
-							 			SELECT public OR author = $2 OR
+							 			SELECT (author = $2 OR
+											(public AND (NOT profile.private OR
+												EXISTS (SELECT 1 FROM subscription_user WHERE who = $2 AND whom = author)) OR
 											EXISTS (SELECT FROM pin 
 													INNER JOIN membership 
 													ON pin.id = membership.pin_id 
@@ -25,12 +50,22 @@ var (
 													ON membership.board_id = board.id 
 											        INNER JOIN contributor 
 													ON board.id = contributor.board_id 
-													WHERE contributor.user_id = $2 AND pin.id = $1)
-								   		FROM pin WHERE id = $1
-							       );`
+													WHERE contributor.user_id = $2 AND pin.id = $1)) AND
+											NOT EXISTS (SELECT 1 FROM user_block
+														WHERE blocker_id = author AND blocked_id = $2 OR blocker_id = $2 AND blocked_id = author)
+								   		FROM pin INNER JOIN profile ON pin.author = profile.id WHERE pin.id = $1
+							       )
+								   RETURNING (SELECT COUNT(*) FROM like_pin WHERE pin_id = $1);`
 
 	UpdatePinSetStatusDelete = "UPDATE pin SET deleted_at = now() WHERE id = $1 AND author = $2 AND deleted_at IS NULL;"
+	UpdatePublishScheduled   = `UPDATE pin SET publish_at = NULL
+								WHERE publish_at <= now() AND NOT draft AND deleted_at IS NULL
+								RETURNING id, author, public;`
 
 	DeleteLikePinFromUser = "DELETE FROM like_pin WHERE pin_id = $1 AND user_id = $2  RETURNING (SELECT COUNT(*) FROM like_pin WHERE pin_id = $1);"
 	DeleteAllTagsFromPin  = "DELETE FROM pin_tag WHERE pin_id = $1;"
+
+	SelectImagesByPinID = "SELECT picture FROM pin_image WHERE pin_id = $1 ORDER BY position;"
+	UpdatePinCover      = "UPDATE pin SET picture = $3 WHERE id = $1 AND author = $2 AND deleted_at IS NULL;"
+	DeleteAllPinImages  = "DELETE FROM pin_image WHERE pin_id = $1;"
 )
@@ -0,0 +1,35 @@
+package pin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+)
+
+func (p *pinRepoPG) GetCountSaveByPinID(ctx context.Context, pinID int) (int, error) {
+	var count int
+	if err := p.db.QueryRow(ctx, SelectCountSavePin, pinID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("get count save by pin id: %w", err)
+	}
+	return count, nil
+}
+
+func (p *pinRepoPG) GetAttributionByPinID(ctx context.Context, pinID int) ([]pin.Attribution, error) {
+	rows, err := p.db.Query(ctx, SelectAttributionByPinID, pinID)
+	if err != nil {
+		return nil, fmt.Errorf("get pin attribution by its id in storage: %w", err)
+	}
+	defer rows.Close()
+
+	attribution := []pin.Attribution{}
+	for rows.Next() {
+		source := pin.Attribution{Author: &user.User{}}
+		if err = rows.Scan(&source.PinID, &source.Author.ID, &source.Author.Username, &source.Author.Avatar); err != nil {
+			return nil, fmt.Errorf("scan pin attribution in storage: %w", err)
+		}
+		attribution = append(attribution, source)
+	}
+	return attribution, rows.Err()
+}
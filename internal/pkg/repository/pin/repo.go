@@ -26,12 +26,23 @@ type Repository interface {
 	SetLike(ctx context.Context, pinID, userID int) (int, error)
 	IsSetLike(ctx context.Context, pinID, userID int) (bool, error)
 	DelLike(ctx context.Context, pinID, userID int) (int, error)
-	EditPin(ctx context.Context, pinID, userID int, updateData S, titleTags []string) error
+	EditPin(ctx context.Context, pinID, userID int, updateData S, titleTags, images []string) error
 	GetCountLikeByPinID(ctx context.Context, pinID int) (int, error)
 	GetTagsByPinID(ctx context.Context, pinID int) ([]entity.Tag, error)
+	GetCountSaveByPinID(ctx context.Context, pinID int) (int, error)
+	GetAttributionByPinID(ctx context.Context, pinID int) ([]entity.Attribution, error)
+	GetImagesByPinID(ctx context.Context, pinID int) ([]string, error)
 	IsAvailableToUserAsContributorBoard(ctx context.Context, pinID, userID int) (bool, error)
+	GetPinsByAuthor(ctx context.Context, userID int) ([]entity.Pin, error)
+	GetLikedPinIDs(ctx context.Context, userID int) ([]int, error)
+	HasFollowingSources(ctx context.Context, userID int) (bool, error)
+	PublishScheduledPins(ctx context.Context) ([]entity.Pin, error)
 }
 
+// PublishNow as the new value of publish_at schedules the draft pin for the publication right away,
+// the published pin stays as it is.
+var PublishNow = sq.Expr("CASE WHEN draft THEN now() ELSE publish_at END")
+
 type pinRepoPG struct {
 	db         pgtype.PgxPoolIface
 	sqlBuilder sq.StatementBuilderType
@@ -45,11 +56,12 @@ func NewPinRepoPG(db pgtype.PgxPoolIface) *pinRepoPG {
 }
 
 func (p *pinRepoPG) GetFeedPins(ctx context.Context, cfg entity.FeedPinConfig) (entity.FeedPin, error) {
-	queryBuild := p.sqlBuilder.Select("pin.id", "pin.picture").
+	queryBuild := p.sqlBuilder.Select("pin.id", "pin.picture", "pin.author").
 		From("pin")
 
 	pin := entity.Pin{}
-	scanFields := []any{&pin.ID, &pin.Picture}
+	var authorID int
+	scanFields := []any{&pin.ID, &pin.Picture, &authorID}
 
 	queryBuild, scanFields = addFilters(queryBuild, cfg, &pin, scanFields)
 
@@ -73,6 +85,7 @@ func (p *pinRepoPG) GetFeedPins(ctx context.Context, cfg entity.FeedPinConfig) (
 		if err != nil {
 			return feed, fmt.Errorf("scan feed pins: %w", err)
 		}
+		pin.Author = &user.User{ID: authorID}
 		feed.Pins = append(feed.Pins, pin)
 	}
 
@@ -85,6 +98,15 @@ func (p *pinRepoPG) GetFeedPins(ctx context.Context, cfg entity.FeedPinConfig) (
 	return feed, nil
 }
 
+// HasFollowingSources reports whether the user follows anyone or any board or has liked any pin,
+// otherwise the following feed of the user is empty.
+func (p *pinRepoPG) HasFollowingSources(ctx context.Context, userID int) (has bool, err error) {
+	if err = p.db.QueryRow(ctx, SelectHasFollowingSources, userID).Scan(&has); err != nil {
+		return false, fmt.Errorf("check sources of the following feed in storage: %w", err)
+	}
+	return has, nil
+}
+
 func (p *pinRepoPG) GetPinByID(ctx context.Context, pinID int, revealAuthor bool) (*entity.Pin, error) {
 	pin := &entity.Pin{Author: &user.User{}}
 	var err error
@@ -92,11 +114,13 @@ func (p *pinRepoPG) GetPinByID(ctx context.Context, pinID int, revealAuthor bool
 		err = p.getPinByID(ctx, pinID, SelectPinByIDWithAuthor,
 			&pin.Author.ID, &pin.Title, &pin.Description,
 			&pin.Picture, &pin.Public, &pin.DeletedAt,
+			&pin.Draft, &pin.PublishAt, &pin.SourceURL,
 			&pin.Author.Username, &pin.Author.Avatar)
 	} else {
 		err = p.getPinByID(ctx, pinID, SelectPinByID,
 			&pin.Author.ID, &pin.Title, &pin.Description,
-			&pin.Picture, &pin.Public, &pin.DeletedAt)
+			&pin.Picture, &pin.Public, &pin.DeletedAt,
+			&pin.Draft, &pin.PublishAt, &pin.SourceURL)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("get pin by id from storage: %w", err)
@@ -156,6 +180,12 @@ func (p *pinRepoPG) AddNewPin(ctx context.Context, pin *entity.Pin) error {
 		return fmt.Errorf("add pin: %w", err)
 	}
 
+	err = p.addImagesOnPin(ctx, tx, pinID, pin.Images)
+	if err != nil {
+		tx.Rollback(ctx)
+		return fmt.Errorf("add images: %w", err)
+	}
+
 	err = p.addTagsByTitleOnPin(ctx, tx, titles, pinID, true)
 	if err != nil {
 		tx.Rollback(ctx)
@@ -166,6 +196,7 @@ func (p *pinRepoPG) AddNewPin(ctx context.Context, pin *entity.Pin) error {
 	if err != nil {
 		return fmt.Errorf("commit transaction for add new pin: %w", err)
 	}
+	pin.ID = pinID
 	return nil
 }
 
@@ -181,8 +212,10 @@ func (p *pinRepoPG) DeletePin(ctx context.Context, pinID, userID int) error {
 	return nil
 }
 
-func (p *pinRepoPG) EditPin(ctx context.Context, pinID, userID int, updateData S, titleTags []string) error {
-	if len(updateData) == 0 && titleTags == nil {
+// EditPin changes the header, the tags and the images of the pin in one transaction,
+// the images are left as they are when they are nil.
+func (p *pinRepoPG) EditPin(ctx context.Context, pinID, userID int, updateData S, titleTags, images []string) error {
+	if len(updateData) == 0 && titleTags == nil && images == nil {
 		return nil
 	}
 
@@ -207,6 +240,13 @@ func (p *pinRepoPG) EditPin(ctx context.Context, pinID, userID int, updateData S
 		return fmt.Errorf("edit tags on pin: %w", err)
 	}
 
+	if images != nil {
+		if err = p.replacePinImages(ctx, tx, pinID, userID, images); err != nil {
+			tx.Rollback(ctx)
+			return err
+		}
+	}
+
 	err = tx.Commit(ctx)
 	if err != nil {
 		return fmt.Errorf("commit transaction for edit pin: %w", err)
@@ -233,8 +273,8 @@ func (p *pinRepoPG) updateHeaderPin(ctx context.Context, tx pgx.Tx, pinID, userI
 
 func (p *pinRepoPG) addPin(ctx context.Context, tx pgx.Tx, pin *entity.Pin) (int, error) {
 	sqlRow, args, err := p.sqlBuilder.Insert("pin").
-		Columns("author", "title", "description", "picture", "public").
-		Values(pin.Author.ID, pin.Title, pin.Description, pin.Picture, pin.Public).
+		Columns("author", "title", "description", "picture", "public", "draft", "publish_at", "source_url").
+		Values(pin.Author.ID, pin.Title, pin.Description, pin.Picture, pin.Public, pin.Draft, pin.PublishAt, pin.SourceURL).
 		Suffix("RETURNING id").
 		ToSql()
 	if err != nil {
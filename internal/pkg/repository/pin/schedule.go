@@ -0,0 +1,29 @@
+package pin
+
+import (
+	"context"
+	"fmt"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+)
+
+// PublishScheduledPins publishes the pins whose publication time has come and returns them.
+// The pins keep their ids, so the feeds order them by the creation time.
+func (p *pinRepoPG) PublishScheduledPins(ctx context.Context) ([]entity.Pin, error) {
+	rows, err := p.db.Query(ctx, UpdatePublishScheduled)
+	if err != nil {
+		return nil, fmt.Errorf("publish scheduled pins in storage: %w", err)
+	}
+	defer rows.Close()
+
+	pins := []entity.Pin{}
+	for rows.Next() {
+		pin := entity.Pin{Author: &user.User{}}
+		if err = rows.Scan(&pin.ID, &pin.Author.ID, &pin.Public); err != nil {
+			return nil, fmt.Errorf("scan published pin: %w", err)
+		}
+		pins = append(pins, pin)
+	}
+	return pins, rows.Err()
+}
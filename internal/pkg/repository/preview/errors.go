@@ -0,0 +1,13 @@
+package preview
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrNoPreview struct{}
+
+func (e *ErrNoPreview) Error() string {
+	return "pin has no link preview"
+}
+
+func (e *ErrNoPreview) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pin "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetPreview mocks base method.
+func (m *MockRepository) GetPreview(ctx context.Context, pinID int) (*pin.LinkPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreview", ctx, pinID)
+	ret0, _ := ret[0].(*pin.LinkPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreview indicates an expected call of GetPreview.
+func (mr *MockRepositoryMockRecorder) GetPreview(ctx, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreview", reflect.TypeOf((*MockRepository)(nil).GetPreview), ctx, pinID)
+}
+
+// SetPreview mocks base method.
+func (m *MockRepository) SetPreview(ctx context.Context, pinID int, preview pin.LinkPreview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPreview", ctx, pinID, preview)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPreview indicates an expected call of SetPreview.
+func (mr *MockRepositoryMockRecorder) SetPreview(ctx, pinID, preview interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreview", reflect.TypeOf((*MockRepository)(nil).SetPreview), ctx, pinID, preview)
+}
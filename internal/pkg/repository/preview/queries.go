@@ -0,0 +1,13 @@
+package preview
+
+const (
+	SelectPreviewByPinID = `SELECT lp.url, lp.title, lp.description, lp.image
+							FROM link_preview AS lp INNER JOIN pin ON lp.pin_id = pin.id AND lp.url = pin.source_url
+							WHERE lp.pin_id = $1;`
+	// the preview is saved only if the source url of the pin has not been changed during the fetch
+	UpsertPreview = `INSERT INTO link_preview (pin_id, url, title, description, image)
+					 SELECT id, source_url, $3, $4, $5 FROM pin WHERE id = $1 AND source_url = $2
+					 ON CONFLICT (pin_id) DO UPDATE
+					 SET url = excluded.url, title = excluded.title, description = excluded.description,
+						 image = excluded.image, fetched_at = now();`
+)
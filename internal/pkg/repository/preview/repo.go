@@ -0,0 +1,48 @@
+package preview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
+)
+
+//go:generate mockgen -destination=./mock/preview_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	GetPreview(ctx context.Context, pinID int) (*pin.LinkPreview, error)
+	SetPreview(ctx context.Context, pinID int, preview pin.LinkPreview) error
+}
+
+type previewRepoPG struct {
+	db pgtype.PgxPoolIface
+}
+
+func NewPreviewRepoPG(db pgtype.PgxPoolIface) *previewRepoPG {
+	return &previewRepoPG{db}
+}
+
+func (p *previewRepoPG) GetPreview(ctx context.Context, pinID int) (*pin.LinkPreview, error) {
+	preview := &pin.LinkPreview{}
+	err := p.db.QueryRow(ctx, SelectPreviewByPinID, pinID).
+		Scan(&preview.URL, &preview.Title, &preview.Description, &preview.Image)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &ErrNoPreview{}
+		}
+		return nil, fmt.Errorf("get link preview from storage: %w", err)
+	}
+	return preview, nil
+}
+
+// SetPreview saves the preview of the pin unless the source url of the pin differs from the url of the preview.
+func (p *previewRepoPG) SetPreview(ctx context.Context, pinID int, preview pin.LinkPreview) error {
+	_, err := p.db.Exec(ctx, UpsertPreview, pinID, preview.URL, preview.Title, preview.Description, preview.Image)
+	if err != nil {
+		return fmt.Errorf("set link preview in storage: %w", err)
+	}
+	return nil
+}
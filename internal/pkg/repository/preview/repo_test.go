@@ -0,0 +1,45 @@
+package preview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+)
+
+func TestGetPreview(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewPreviewRepoPG(pool)
+
+	pool.ExpectQuery("SELECT lp.url").
+		WithArgs(7).
+		WillReturnRows(pgxmock.NewRows([]string{"url", "title", "description", "image"}).
+			AddRow("https://example.com/pie", "Apple pie", "The classic recipe", "https://example.com/pie.jpg"))
+	pool.ExpectQuery("SELECT lp.url").
+		WithArgs(8).
+		WillReturnError(pgx.ErrNoRows)
+
+	preview, err := repo.GetPreview(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, &pin.LinkPreview{
+		URL:         "https://example.com/pie",
+		Title:       "Apple pie",
+		Description: "The classic recipe",
+		Image:       "https://example.com/pie.jpg",
+	}, preview)
+
+	_, err = repo.GetPreview(ctx, 8)
+	require.ErrorAs(t, err, new(*ErrNoPreview))
+	require.NoError(t, pool.ExpectationsWereMet())
+}
@@ -35,6 +35,33 @@ func (r *ramSessionRepo) GetSessionByKey(ctx context.Context, key string) (*enti
 	return session, nil
 }
 
+func (r *ramSessionRepo) GetSessionsForUser(ctx context.Context, userID int) ([]*entity.Session, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT session_key, expire FROM session WHERE user_id = $1;", userID)
+	if err != nil {
+		return nil, fmt.Errorf("get sessions for user from ram repository: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := []*entity.Session{}
+	for rows.Next() {
+		session := &entity.Session{UserID: userID}
+		if err = rows.Scan(&session.Key, &session.Expire); err != nil {
+			return nil, fmt.Errorf("scan session from ram repository: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+func (r *ramSessionRepo) TouchSession(ctx context.Context, session *entity.Session) error {
+	// session metadata is not stored in the ram repository
+	_, err := r.db.ExecContext(ctx, "UPDATE session SET expire = $1 WHERE session_key = $2;", session.Expire, session.Key)
+	if err != nil {
+		return fmt.Errorf("touch session in ram repository: %w", err)
+	}
+	return nil
+}
+
 func (r *ramSessionRepo) DeleteSessionByKey(ctx context.Context, key string) error {
 	_, err := r.db.ExecContext(ctx, "DELETE FROM session WHERE session_key = $1;", key)
 	if err != nil {
@@ -0,0 +1,65 @@
+package recommendation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+)
+
+const prefixRecommendations = "recommendations:"
+
+//go:generate mockgen -destination=./mock/cache_mock.go -package=mock -source=cache.go CacheRepository
+type CacheRepository interface {
+	GetRecommendations(ctx context.Context, userID int) ([]user.Recommendation, error)
+	SetRecommendations(ctx context.Context, userID int, recs []user.Recommendation, lifetime time.Duration) error
+	DeleteRecommendations(ctx context.Context, userID int) error
+}
+
+type cacheRepo struct {
+	client *redis.Client
+}
+
+func NewCacheRepo(client *redis.Client) *cacheRepo {
+	return &cacheRepo{client}
+}
+
+func (c *cacheRepo) GetRecommendations(ctx context.Context, userID int) ([]user.Recommendation, error) {
+	value, err := c.client.Get(ctx, prefixRecommendations+strconv.Itoa(userID)).Bytes()
+	if err == redis.Nil {
+		return nil, &ErrNotCached{}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get recommendations from cache: %w", err)
+	}
+
+	recs := []user.Recommendation{}
+	if err = json.Unmarshal(value, &recs); err != nil {
+		return nil, fmt.Errorf("unmarshal recommendations: %w", err)
+	}
+	return recs, nil
+}
+
+func (c *cacheRepo) SetRecommendations(ctx context.Context, userID int, recs []user.Recommendation, lifetime time.Duration) error {
+	value, err := json.Marshal(recs)
+	if err != nil {
+		return fmt.Errorf("marshal recommendations: %w", err)
+	}
+
+	if err = c.client.Set(ctx, prefixRecommendations+strconv.Itoa(userID), value, lifetime).Err(); err != nil {
+		return fmt.Errorf("set recommendations in cache: %w", err)
+	}
+	return nil
+}
+
+func (c *cacheRepo) DeleteRecommendations(ctx context.Context, userID int) error {
+	if err := c.client.Del(ctx, prefixRecommendations+strconv.Itoa(userID)).Err(); err != nil {
+		return fmt.Errorf("delete recommendations from cache: %w", err)
+	}
+	return nil
+}
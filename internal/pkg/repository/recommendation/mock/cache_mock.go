@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cache.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	user "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCacheRepository is a mock of CacheRepository interface.
+type MockCacheRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheRepositoryMockRecorder
+}
+
+// MockCacheRepositoryMockRecorder is the mock recorder for MockCacheRepository.
+type MockCacheRepositoryMockRecorder struct {
+	mock *MockCacheRepository
+}
+
+// NewMockCacheRepository creates a new mock instance.
+func NewMockCacheRepository(ctrl *gomock.Controller) *MockCacheRepository {
+	mock := &MockCacheRepository{ctrl: ctrl}
+	mock.recorder = &MockCacheRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCacheRepository) EXPECT() *MockCacheRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteRecommendations mocks base method.
+func (m *MockCacheRepository) DeleteRecommendations(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecommendations", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecommendations indicates an expected call of DeleteRecommendations.
+func (mr *MockCacheRepositoryMockRecorder) DeleteRecommendations(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecommendations", reflect.TypeOf((*MockCacheRepository)(nil).DeleteRecommendations), ctx, userID)
+}
+
+// GetRecommendations mocks base method.
+func (m *MockCacheRepository) GetRecommendations(ctx context.Context, userID int) ([]user.Recommendation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecommendations", ctx, userID)
+	ret0, _ := ret[0].([]user.Recommendation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecommendations indicates an expected call of GetRecommendations.
+func (mr *MockCacheRepositoryMockRecorder) GetRecommendations(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommendations", reflect.TypeOf((*MockCacheRepository)(nil).GetRecommendations), ctx, userID)
+}
+
+// SetRecommendations mocks base method.
+func (m *MockCacheRepository) SetRecommendations(ctx context.Context, userID int, recs []user.Recommendation, lifetime time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRecommendations", ctx, userID, recs, lifetime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRecommendations indicates an expected call of SetRecommendations.
+func (mr *MockCacheRepositoryMockRecorder) SetRecommendations(ctx, userID, recs, lifetime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecommendations", reflect.TypeOf((*MockCacheRepository)(nil).SetRecommendations), ctx, userID, recs, lifetime)
+}
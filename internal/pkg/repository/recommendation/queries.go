@@ -0,0 +1,50 @@
+package recommendation
+
+// the weights of the candidate sources: a co-author of a board is the strongest signal,
+// then the account followed by the followings of the user, then the author of the liked pin
+var SelectCandidates = `
+	WITH candidate AS (
+		SELECT f2.whom AS user_id, 3 AS weight
+		FROM subscription_user f1
+		INNER JOIN subscription_user f2 ON f1.whom = f2.who
+		WHERE f1.who = $1
+	UNION ALL
+		SELECT p.author, 2
+		FROM like_pin l
+		INNER JOIN pin p ON l.pin_id = p.id
+		WHERE l.user_id = $1 AND p.deleted_at IS NULL
+	UNION ALL
+		SELECT c2.user_id, 4
+		FROM contributor c1
+		INNER JOIN contributor c2 ON c1.board_id = c2.board_id
+		INNER JOIN board b ON c1.board_id = b.id
+		WHERE c1.user_id = $1 AND b.deleted_at IS NULL
+	UNION ALL
+		SELECT b.author, 4
+		FROM contributor c
+		INNER JOIN board b ON c.board_id = b.id
+		WHERE c.user_id = $1 AND b.deleted_at IS NULL
+	UNION ALL
+		SELECT c.user_id, 4
+		FROM board b
+		INNER JOIN contributor c ON b.id = c.board_id
+		WHERE b.author = $1 AND b.deleted_at IS NULL
+	)
+	SELECT
+		p.id, p.username, p.avatar, SUM(c.weight) AS score
+	FROM
+		candidate c
+	INNER JOIN
+		profile p ON c.user_id = p.id
+	WHERE
+		p.id <> $1 AND p.deleted_at IS NULL AND (p.suspended_at IS NULL OR p.suspended_until <= now())
+		AND NOT EXISTS (SELECT 1 FROM subscription_user s WHERE s.who = $1 AND s.whom = p.id)
+		AND NOT EXISTS (SELECT 1 FROM subscription_request r WHERE r.who = $1 AND r.whom = p.id AND r.status = 'pending')
+		AND NOT EXISTS (SELECT 1 FROM user_block ub WHERE (ub.blocker_id = $1 AND ub.blocked_id = p.id)
+															OR (ub.blocker_id = p.id AND ub.blocked_id = $1))
+	GROUP BY
+		p.id
+	ORDER BY
+		score DESC, p.id DESC
+	LIMIT
+		$2;`
@@ -0,0 +1,43 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
+)
+
+//go:generate mockgen -destination=./mock/recommendation_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	GetCandidates(ctx context.Context, userID, count int) ([]user.Recommendation, error)
+}
+
+type recommendationRepoPG struct {
+	db pgtype.PgxPoolIface
+}
+
+func NewRecommendationRepoPG(db pgtype.PgxPoolIface) *recommendationRepoPG {
+	return &recommendationRepoPG{db}
+}
+
+// GetCandidates returns the accounts the user doesn't follow yet, collected from the followings
+// of the followings, the authors of the liked pins and the co-authors of the boards,
+// they are ordered by the total score of all the sources they come from.
+func (r *recommendationRepoPG) GetCandidates(ctx context.Context, userID, count int) ([]user.Recommendation, error) {
+	rows, err := r.db.Query(ctx, SelectCandidates, userID, count)
+	if err != nil {
+		return nil, fmt.Errorf("get recommendation candidates from storage: %w", err)
+	}
+	defer rows.Close()
+
+	candidates := make([]user.Recommendation, 0, count)
+	for rows.Next() {
+		var candidate user.Recommendation
+		if err = rows.Scan(&candidate.ID, &candidate.Username, &candidate.Avatar, &candidate.Score); err != nil {
+			return nil, fmt.Errorf("scan recommendation candidate: %w", err)
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
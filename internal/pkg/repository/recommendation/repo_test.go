@@ -0,0 +1,37 @@
+package recommendation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+)
+
+func TestGetCandidates(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewRecommendationRepoPG(pool)
+
+	pool.ExpectQuery("WITH candidate AS").
+		WithArgs(1, 10).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "username", "avatar", "score"}).
+			AddRow(7, "baobab", "/pic1", 9).
+			AddRow(4, "green", "/pic2", 3))
+
+	candidates, err := repo.GetCandidates(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, []user.Recommendation{
+		{ID: 7, Username: "baobab", Avatar: "/pic1", Score: 9},
+		{ID: 4, Username: "green", Avatar: "/pic2", Score: 3},
+	}, candidates)
+	require.NoError(t, pool.ExpectationsWereMet())
+}
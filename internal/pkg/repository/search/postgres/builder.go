@@ -7,6 +7,20 @@ import (
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/search"
 )
 
+// notBlocked excludes the rows of the users that have a block with the current user in any direction.
+func notBlocked(userColumn string, currUserID int) squirrel.Sqlizer {
+	return squirrel.Expr(fmt.Sprintf(
+		"NOT EXISTS (SELECT 1 FROM user_block WHERE blocker_id = %[1]s AND blocked_id = ? OR blocker_id = ? AND blocked_id = %[1]s)",
+		userColumn), currUserID, currUserID)
+}
+
+// notPrivate excludes the rows of the private profiles unless the current user is the owner or its follower.
+func notPrivate(userColumn string, currUserID int) squirrel.Sqlizer {
+	return squirrel.Expr(fmt.Sprintf(
+		"(NOT EXISTS (SELECT 1 FROM profile WHERE profile.id = %[1]s AND profile.private) OR %[1]s = ? OR EXISTS (SELECT 1 FROM subscription_user WHERE who = ? AND whom = %[1]s))",
+		userColumn), currUserID, currUserID)
+}
+
 func SetUserSortType(sq squirrel.SelectBuilder, opts *search.SearchOpts) squirrel.SelectBuilder {
 	switch opts.SortBy {
 	case "subscribers":
@@ -29,6 +43,8 @@ func SetPinSortType(sq squirrel.SelectBuilder, opts *search.SearchOpts) squirrel
 	switch opts.SortBy {
 	case "likes":
 		return sq.OrderBy(fmt.Sprintf("likes %s", opts.General.SortOrder))
+	case "saves":
+		return sq.OrderBy(fmt.Sprintf("saves %s", opts.General.SortOrder))
 	default:
 		return sq.OrderBy(fmt.Sprintf("p.id %s", opts.General.SortOrder))
 	}
@@ -39,8 +55,8 @@ func (r *searchRepoPG) SelectBoardsForSearch(opts *search.SearchOpts) (string, [
 		"board.id",
 		"board.title",
 		"board.created_at",
-		"COUNT(DISTINCT pin.id) FILTER (WHERE pin.deleted_at IS NULL) AS pins_number",
-		"COALESCE((ARRAY_AGG(DISTINCT pin.picture) FILTER (WHERE pin.deleted_at IS NULL AND pin.picture IS NOT NULL))[:3], ARRAY[]::TEXT[]) AS pins",
+		"COUNT(DISTINCT pin.id) FILTER (WHERE pin.deleted_at IS NULL AND NOT pin.draft AND pin.publish_at IS NULL) AS pins_number",
+		"COALESCE((ARRAY_AGG(DISTINCT pin.picture) FILTER (WHERE pin.deleted_at IS NULL AND NOT pin.draft AND pin.publish_at IS NULL AND pin.picture IS NOT NULL))[:3], ARRAY[]::TEXT[]) AS pins",
 	).From(
 		"board",
 	).LeftJoin(
@@ -53,6 +69,10 @@ func (r *searchRepoPG) SelectBoardsForSearch(opts *search.SearchOpts) (string, [
 		squirrel.ILike{"board.title": defaultSearchTemplate(opts.General.Template).GetTempl()},
 	).Where(
 		fmt.Sprintf("(board.public OR board.author = %d OR %d IN (SELECT user_id FROM contributor WHERE board_id = board.id))", opts.General.CurrUserID, opts.General.CurrUserID),
+	).Where(
+		notBlocked("board.author", opts.General.CurrUserID),
+	).Where(
+		notPrivate("board.author", opts.General.CurrUserID),
 	).GroupBy(
 		"board.id",
 		"board.title",
@@ -85,6 +105,7 @@ func (r *searchRepoPG) SelectUsersForSearch(opts *search.SearchOpts) (string, []
 			squirrel.Eq{"p1.deleted_at": nil},
 			squirrel.Eq{"p2.deleted_at": nil},
 			squirrel.ILike{"p1.username": defaultSearchTemplate(opts.General.Template).GetTempl()},
+			notBlocked("p1.id", opts.General.CurrUserID),
 		},
 	).GroupBy(
 		"p1.id",
@@ -105,6 +126,7 @@ func (r *searchRepoPG) SelectPinsForSearch(opts *search.SearchOpts) (string, []i
 		"p.title",
 		"p.picture",
 		"COUNT(pin_id) AS likes",
+		"p.save_count AS saves",
 	).From(
 		"pin p",
 	).LeftJoin(
@@ -116,12 +138,19 @@ func (r *searchRepoPG) SelectPinsForSearch(opts *search.SearchOpts) (string, []i
 				squirrel.Eq{"p.public": true},
 				squirrel.Eq{"p.author": opts.General.CurrUserID},
 			},
+			squirrel.Or{
+				squirrel.And{squirrel.Eq{"p.draft": false}, squirrel.Eq{"p.publish_at": nil}},
+				squirrel.Eq{"p.author": opts.General.CurrUserID},
+			},
 			squirrel.ILike{"p.title": defaultSearchTemplate(opts.General.Template).GetTempl()},
+			notBlocked("p.author", opts.General.CurrUserID),
+			notPrivate("p.author", opts.General.CurrUserID),
 		},
 	).GroupBy(
 		"p.id",
 		"p.title",
 		"p.picture",
+		"p.save_count",
 	)
 
 	SelectPinsForSearch = SetPinSortType(SelectPinsForSearch, opts)
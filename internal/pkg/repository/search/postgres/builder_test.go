@@ -0,0 +1,60 @@
+package search
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/search"
+	"github.com/stretchr/testify/require"
+)
+
+const privateProfileFilter = "NOT EXISTS (SELECT 1 FROM profile WHERE profile.id = %s AND profile.private)"
+
+func TestSelectForSearchHidesPrivateProfiles(t *testing.T) {
+	repo := NewSearchRepoPG(nil)
+	opts := &search.SearchOpts{
+		General: search.GeneralOpts{
+			Template:   "cat",
+			SortOrder:  "DESC",
+			CurrUserID: 7,
+			Count:      20,
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		userColumn string
+		build      func(*search.SearchOpts) (string, []interface{}, error)
+	}{
+		{
+			name:       "pins",
+			userColumn: "p.author",
+			build:      repo.SelectPinsForSearch,
+		},
+		{
+			name:       "boards",
+			userColumn: "board.author",
+			build:      repo.SelectBoardsForSearch,
+		},
+	}
+
+	for _, tCase := range testCases {
+		t.Run(tCase.name, func(t *testing.T) {
+			sqlRow, args, err := tCase.build(opts)
+			require.NoError(t, err)
+
+			require.Contains(t, sqlRow, fmt.Sprintf(privateProfileFilter, tCase.userColumn))
+			require.Contains(t, sqlRow, tCase.userColumn+" = $")
+			require.Contains(t, sqlRow, "EXISTS (SELECT 1 FROM subscription_user WHERE who = $")
+
+			viewerArgs := 0
+			for _, arg := range args {
+				if arg == opts.General.CurrUserID {
+					viewerArgs++
+				}
+			}
+			// two args for the block filter and two for the private profile filter
+			require.GreaterOrEqual(t, viewerArgs, 4)
+		})
+	}
+}
@@ -114,7 +114,7 @@ func (r *searchRepoPG) GetFilteredPins(ctx context.Context, opts *search.SearchO
 	pins := make([]search.PinForSearch, 0)
 	for rows.Next() {
 		pin := search.PinForSearch{}
-		if err := rows.Scan(&pin.ID, &pin.Title, &pin.Picture, &pin.Likes); err != nil {
+		if err := rows.Scan(&pin.ID, &pin.Title, &pin.Picture, &pin.Likes, &pin.Saves); err != nil {
 			return nil, convertErrorPostgres(err)
 		}
 		pins = append(pins, pin)
@@ -91,3 +91,32 @@ func (mr *MockRepositoryMockRecorder) GetSessionByKey(ctx, key interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByKey", reflect.TypeOf((*MockRepository)(nil).GetSessionByKey), ctx, key)
 }
+
+// GetSessionsForUser mocks base method.
+func (m *MockRepository) GetSessionsForUser(ctx context.Context, userID int) ([]*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionsForUser", ctx, userID)
+	ret0, _ := ret[0].([]*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionsForUser indicates an expected call of GetSessionsForUser.
+func (mr *MockRepositoryMockRecorder) GetSessionsForUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsForUser", reflect.TypeOf((*MockRepository)(nil).GetSessionsForUser), ctx, userID)
+}
+
+// TouchSession mocks base method.
+func (m *MockRepository) TouchSession(ctx context.Context, session *session.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockRepositoryMockRecorder) TouchSession(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockRepository)(nil).TouchSession), ctx, session)
+}
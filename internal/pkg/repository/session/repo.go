@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
@@ -12,11 +13,38 @@ import (
 
 var ErrMethodUnimplemented = errors.New("unimplemented")
 var ErrExistsSession = errors.New("the session already exists")
+var ErrNotFoundSession = errors.New("the session was not found")
+
+const (
+	prefixUserSessions = "user_sessions:"
+	prefixSessionMeta  = "session_meta:"
+	fieldMetaUserAgent = "user_agent"
+	fieldMetaIP        = "ip"
+	fieldMetaCreatedAt = "created_at"
+	fieldMetaLastSeen  = "last_seen"
+)
+
+// touchScript updates the metadata and the lifetime of the session only while the session itself exists,
+// so that neither the metadata nor the user session index outlive the session.
+var touchScript = redis.NewScript(`
+if redis.call('PTTL', KEYS[1]) < 0 then
+	return 0
+end
+redis.call('HSET', KEYS[2], 'user_agent', ARGV[1], 'ip', ARGV[2], 'last_seen', ARGV[3])
+redis.call('PEXPIREAT', KEYS[1], ARGV[4])
+redis.call('PEXPIREAT', KEYS[2], ARGV[4])
+if redis.call('PEXPIRETIME', KEYS[3]) < tonumber(ARGV[4]) then
+	redis.call('PEXPIREAT', KEYS[3], ARGV[4])
+end
+return 1
+`)
 
 //go:generate mockgen -destination=./mock/session_mock.go -package=mock -source=repo.go Repository
 type Repository interface {
 	AddSession(ctx context.Context, session *session.Session) error
 	GetSessionByKey(ctx context.Context, key string) (*session.Session, error)
+	GetSessionsForUser(ctx context.Context, userID int) ([]*session.Session, error)
+	TouchSession(ctx context.Context, session *session.Session) error
 	DeleteSessionByKey(ctx context.Context, key string) error
 	DeleteAllSessionForUser(ctx context.Context, userID int) error
 }
@@ -29,25 +57,64 @@ func NewSessionRepo(client *redis.Client) *sessionRepo {
 	return &sessionRepo{client}
 }
 
+func userSessionsKey(userID int) string {
+	return prefixUserSessions + strconv.Itoa(userID)
+}
+
+func sessionMetaKey(key string) string {
+	return prefixSessionMeta + key
+}
+
 func (s *sessionRepo) AddSession(ctx context.Context, session *session.Session) error {
-	res := s.client.SetNX(ctx, session.Key, session.UserID, time.Duration(session.Expire.Sub(time.Now().UTC())))
+	lifetime := time.Duration(session.Expire.Sub(time.Now().UTC()))
+	res := s.client.SetNX(ctx, session.Key, session.UserID, lifetime)
 	if res.Err() != nil {
 		return fmt.Errorf("add session in storage: %w", res.Err())
 	}
 	if !res.Val() {
 		return ErrExistsSession
 	}
+
+	indexKey, metaKey := userSessionsKey(session.UserID), sessionMetaKey(session.Key)
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, indexKey, session.Key)
+		pipe.Expire(ctx, indexKey, lifetime)
+		pipe.HSet(ctx, metaKey,
+			fieldMetaUserAgent, session.UserAgent,
+			fieldMetaIP, session.IP,
+			fieldMetaCreatedAt, session.CreatedAt.Unix(),
+			fieldMetaLastSeen, session.LastSeen.Unix(),
+		)
+		pipe.Expire(ctx, metaKey, lifetime)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("add session in user session index: %w", err)
+	}
 	return nil
 }
 
 func (s *sessionRepo) GetSessionByKey(ctx context.Context, key string) (*session.Session, error) {
-	res := s.client.Get(ctx, key)
-	if res.Err() != nil {
-		return nil, fmt.Errorf("get session by key from storage: %w", res.Err())
+	var (
+		res  *redis.StringCmd
+		ttl  *redis.DurationCmd
+		meta *redis.MapStringStringCmd
+	)
+	_, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		res = pipe.Get(ctx, key)
+		ttl = pipe.PTTL(ctx, key)
+		meta = pipe.HGetAll(ctx, sessionMetaKey(key))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get session by key from storage: %w", err)
 	}
 
-	var err error
-	sess := &session.Session{Key: key}
+	sess := &session.Session{
+		Key:      key,
+		Expire:   time.Now().UTC().Add(ttl.Val()),
+		Metadata: parseMetadata(meta.Val()),
+	}
 	sess.UserID, err = res.Int()
 	if err != nil {
 		return nil, fmt.Errorf("bad value for session in storage: %w", err)
@@ -55,14 +122,117 @@ func (s *sessionRepo) GetSessionByKey(ctx context.Context, key string) (*session
 	return sess, nil
 }
 
+func (s *sessionRepo) GetSessionsForUser(ctx context.Context, userID int) ([]*session.Session, error) {
+	indexKey := userSessionsKey(userID)
+	keys, err := s.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("get user session index from storage: %w", err)
+	}
+	if len(keys) == 0 {
+		return []*session.Session{}, nil
+	}
+
+	ttls := make([]*redis.DurationCmd, len(keys))
+	metas := make([]*redis.MapStringStringCmd, len(keys))
+	_, err = s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for ind, key := range keys {
+			ttls[ind] = pipe.PTTL(ctx, key)
+			metas[ind] = pipe.HGetAll(ctx, sessionMetaKey(key))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get user sessions from storage: %w", err)
+	}
+
+	now := time.Now().UTC()
+	sessions := make([]*session.Session, 0, len(keys))
+	stale := make([]any, 0)
+	for ind, key := range keys {
+		// PTTL returns a negative duration for keys which have already expired
+		if ttls[ind].Val() < 0 {
+			stale = append(stale, key)
+			continue
+		}
+		sessions = append(sessions, &session.Session{
+			Key:      key,
+			UserID:   userID,
+			Expire:   now.Add(ttls[ind].Val()),
+			Metadata: parseMetadata(metas[ind].Val()),
+		})
+	}
+
+	if len(stale) > 0 {
+		if err = s.client.SRem(ctx, indexKey, stale...).Err(); err != nil {
+			return nil, fmt.Errorf("remove expired sessions from user session index: %w", err)
+		}
+	}
+	return sessions, nil
+}
+
+func (s *sessionRepo) TouchSession(ctx context.Context, session *session.Session) error {
+	keys := []string{session.Key, sessionMetaKey(session.Key), userSessionsKey(session.UserID)}
+	updated, err := touchScript.Run(ctx, s.client, keys,
+		session.UserAgent, session.IP, session.LastSeen.Unix(), session.Expire.UnixMilli()).Int()
+	if err != nil {
+		return fmt.Errorf("touch session in storage: %w", err)
+	}
+	if updated == 0 {
+		return ErrNotFoundSession
+	}
+	return nil
+}
+
 func (s *sessionRepo) DeleteSessionByKey(ctx context.Context, key string) error {
-	res := s.client.Del(ctx, key)
-	if res.Err() != nil {
-		return fmt.Errorf("delete session by key from storage: %w", res.Err())
+	userID, err := s.client.GetDel(ctx, key).Int()
+	if err == redis.Nil {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("delete session by key from storage: %w", err)
+	}
+
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SRem(ctx, userSessionsKey(userID), key)
+		pipe.Del(ctx, sessionMetaKey(key))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("delete session from user session index: %w", err)
 	}
 	return nil
 }
 
 func (s *sessionRepo) DeleteAllSessionForUser(ctx context.Context, userID int) error {
-	return ErrMethodUnimplemented
+	indexKey := userSessionsKey(userID)
+	keys, err := s.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return fmt.Errorf("get user session index from storage: %w", err)
+	}
+
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, key := range keys {
+			pipe.Del(ctx, key, sessionMetaKey(key))
+		}
+		pipe.Del(ctx, indexKey)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("delete all sessions for user from storage: %w", err)
+	}
+	return nil
+}
+
+func parseMetadata(fields map[string]string) session.Metadata {
+	meta := session.Metadata{
+		UserAgent: fields[fieldMetaUserAgent],
+		IP:        fields[fieldMetaIP],
+	}
+	if createdAt, err := strconv.ParseInt(fields[fieldMetaCreatedAt], 10, 64); err == nil {
+		meta.CreatedAt = time.Unix(createdAt, 0).UTC()
+	}
+	if lastSeen, err := strconv.ParseInt(fields[fieldMetaLastSeen], 10, 64); err == nil {
+		meta.LastSeen = time.Unix(lastSeen, 0).UTC()
+	}
+	return meta
 }
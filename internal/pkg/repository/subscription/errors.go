@@ -21,3 +21,23 @@ func (e *ErrNonExistingSubscription) Error() string {
 func (e *ErrNonExistingSubscription) Type() errPkg.Type {
 	return errPkg.ErrNotFound
 }
+
+type ErrFollowRequestAlreadyExist struct{}
+
+func (e *ErrFollowRequestAlreadyExist) Error() string {
+	return "subscription request to that user has already been sent"
+}
+
+func (e *ErrFollowRequestAlreadyExist) Type() errPkg.Type {
+	return errPkg.ErrAlreadyExists
+}
+
+type ErrNonExistingFollowRequest struct{}
+
+func (e *ErrNonExistingFollowRequest) Error() string {
+	return "such subscription request doesn't exist or has already been answered"
+}
+
+func (e *ErrNonExistingFollowRequest) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
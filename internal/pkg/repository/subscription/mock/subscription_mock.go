@@ -35,6 +35,50 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ApproveFollowRequest mocks base method.
+func (m *MockRepository) ApproveFollowRequest(ctx context.Context, requestID, userID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveFollowRequest", ctx, requestID, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveFollowRequest indicates an expected call of ApproveFollowRequest.
+func (mr *MockRepositoryMockRecorder) ApproveFollowRequest(ctx, requestID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFollowRequest", reflect.TypeOf((*MockRepository)(nil).ApproveFollowRequest), ctx, requestID, userID)
+}
+
+// CancelFollowRequest mocks base method.
+func (m *MockRepository) CancelFollowRequest(ctx context.Context, from, to int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelFollowRequest", ctx, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelFollowRequest indicates an expected call of CancelFollowRequest.
+func (mr *MockRepositoryMockRecorder) CancelFollowRequest(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelFollowRequest", reflect.TypeOf((*MockRepository)(nil).CancelFollowRequest), ctx, from, to)
+}
+
+// CreateFollowRequest mocks base method.
+func (m *MockRepository) CreateFollowRequest(ctx context.Context, from, to int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFollowRequest", ctx, from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFollowRequest indicates an expected call of CreateFollowRequest.
+func (mr *MockRepositoryMockRecorder) CreateFollowRequest(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFollowRequest", reflect.TypeOf((*MockRepository)(nil).CreateFollowRequest), ctx, from, to)
+}
+
 // CreateSubscriptionUser mocks base method.
 func (m *MockRepository) CreateSubscriptionUser(ctx context.Context, from, to int) error {
 	m.ctrl.T.Helper()
@@ -63,6 +107,66 @@ func (mr *MockRepositoryMockRecorder) DeleteSubscriptionUser(ctx, from, to inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionUser", reflect.TypeOf((*MockRepository)(nil).DeleteSubscriptionUser), ctx, from, to)
 }
 
+// DenyFollowRequest mocks base method.
+func (m *MockRepository) DenyFollowRequest(ctx context.Context, requestID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyFollowRequest", ctx, requestID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DenyFollowRequest indicates an expected call of DenyFollowRequest.
+func (mr *MockRepositoryMockRecorder) DenyFollowRequest(ctx, requestID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyFollowRequest", reflect.TypeOf((*MockRepository)(nil).DenyFollowRequest), ctx, requestID, userID)
+}
+
+// GetFollowRequest mocks base method.
+func (m *MockRepository) GetFollowRequest(ctx context.Context, requestID int) (*user.FollowRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowRequest", ctx, requestID)
+	ret0, _ := ret[0].(*user.FollowRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowRequest indicates an expected call of GetFollowRequest.
+func (mr *MockRepositoryMockRecorder) GetFollowRequest(ctx, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowRequest", reflect.TypeOf((*MockRepository)(nil).GetFollowRequest), ctx, requestID)
+}
+
+// GetFollowRequests mocks base method.
+func (m *MockRepository) GetFollowRequests(ctx context.Context, userID, count, lastID int) ([]user.FollowRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowRequests", ctx, userID, count, lastID)
+	ret0, _ := ret[0].([]user.FollowRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowRequests indicates an expected call of GetFollowRequests.
+func (mr *MockRepositoryMockRecorder) GetFollowRequests(ctx, userID, count, lastID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowRequests", reflect.TypeOf((*MockRepository)(nil).GetFollowRequests), ctx, userID, count, lastID)
+}
+
+// GetKnownFollowers mocks base method.
+func (m *MockRepository) GetKnownFollowers(ctx context.Context, userID, currUserID, count int) ([]user.SubscriptionUser, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKnownFollowers", ctx, userID, currUserID, count)
+	ret0, _ := ret[0].([]user.SubscriptionUser)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKnownFollowers indicates an expected call of GetKnownFollowers.
+func (mr *MockRepositoryMockRecorder) GetKnownFollowers(ctx, userID, currUserID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKnownFollowers", reflect.TypeOf((*MockRepository)(nil).GetKnownFollowers), ctx, userID, currUserID, count)
+}
+
 // GetUserSubscribers mocks base method.
 func (m *MockRepository) GetUserSubscribers(ctx context.Context, userID, count, lastID, currUserID int) ([]user.SubscriptionUser, error) {
 	m.ctrl.T.Helper()
@@ -5,7 +5,8 @@ var (
 	DeleteSubscriptionUser = "DELETE FROM subscription_user WHERE who = $1 AND whom = $2;"
 	GetUserSubscriptions   = `	
 		SELECT 
-			p.id, p.username, p.avatar, s.who IS NOT NULL AS is_subscribed
+			p.id, p.username, p.avatar, s.who IS NOT NULL AS is_subscribed,
+			EXISTS (SELECT 1 FROM subscription_user m WHERE m.who = f.whom AND m.whom = f.who) AS is_mutual
 		FROM
 			subscription_user f
 		LEFT JOIN
@@ -13,14 +14,15 @@ var (
 		LEFT JOIN
 			subscription_user s ON f.whom = s.whom AND s.who = $1
 		WHERE
-			f.who = $2 AND p.deleted_at IS NULL AND f.whom < $3
+			f.who = $2 AND p.deleted_at IS NULL AND (f.whom < $3 OR $3 = 0)
 		ORDER BY
 			f.whom DESC
 		LIMIT
 			$4;`
 	GetUserSubscribers = `	
 		SELECT
-			p.id, p.username, p.avatar, s.who IS NOT NULL AS is_subscribed
+			p.id, p.username, p.avatar, s.who IS NOT NULL AS is_subscribed,
+			EXISTS (SELECT 1 FROM subscription_user m WHERE m.who = f.whom AND m.whom = f.who) AS is_mutual
 		FROM
 			subscription_user f
 		LEFT JOIN
@@ -28,9 +30,60 @@ var (
 		LEFT JOIN
 			subscription_user s ON f.who = s.whom AND s.who = $1
 		WHERE
-			f.whom = $2 AND p.deleted_at IS NULL AND f.who < $3
+			f.whom = $2 AND p.deleted_at IS NULL AND (f.who < $3 OR $3 = 0)
 		ORDER BY
 			f.who DESC
 		LIMIT
 			$4;`
+
+	GetKnownFollowers = `
+		SELECT
+			p.id, p.username, p.avatar, COUNT(*) OVER () AS total
+		FROM
+			subscription_user f
+		INNER JOIN
+			subscription_user k ON f.who = k.whom AND k.who = $2
+		INNER JOIN
+			profile p ON f.who = p.id
+		WHERE
+			f.whom = $1 AND p.deleted_at IS NULL
+		ORDER BY
+			k.created_at DESC
+		LIMIT
+			$3;`
+	CreateFollowRequest = `
+		INSERT INTO subscription_request (who, whom)
+		SELECT $1, $2
+		WHERE NOT EXISTS (SELECT 1 FROM subscription_user WHERE who = $1 AND whom = $2)
+		RETURNING id;`
+	CancelFollowRequest = "DELETE FROM subscription_request WHERE who = $1 AND whom = $2 AND status = 'pending';"
+	GetFollowRequest    = `
+		SELECT
+			r.id, r.created_at, f.id, f.username, f.avatar, t.id, t.username, t.avatar
+		FROM
+			subscription_request r
+		INNER JOIN
+			profile f ON r.who = f.id
+		INNER JOIN
+			profile t ON r.whom = t.id
+		WHERE
+			r.id = $1;`
+	GetFollowRequests = `
+		SELECT
+			r.id, r.created_at, p.id, p.username, p.avatar
+		FROM
+			subscription_request r
+		INNER JOIN
+			profile p ON r.who = p.id
+		WHERE
+			r.whom = $1 AND r.status = 'pending' AND p.deleted_at IS NULL AND (r.id < $2 OR $2 = 0)
+		ORDER BY
+			r.id DESC
+		LIMIT
+			$3;`
+	UpdateFollowRequestStatus = `
+		UPDATE subscription_request SET status = $3, responded_at = now()
+		WHERE id = $1 AND whom = $2 AND status = 'pending'
+		RETURNING who;`
+	CreateSubscriptionUserIfNotExists = "INSERT INTO subscription_user (who, whom) VALUES ($1, $2) ON CONFLICT DO NOTHING;"
 )
@@ -10,9 +10,15 @@ import (
 	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
 	subRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/subscription"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
+const (
+	followRequestApproved = "approved"
+	followRequestDenied   = "denied"
+)
+
 type subscriptionRepoPG struct {
 	db         pgtype.PgxPoolIface
 	sqlBuilder squirrel.StatementBuilderType
@@ -33,6 +39,9 @@ func convertErrorPostgres(err error) error {
 	if errors.As(err, &pgErr) {
 		switch pgErr.SQLState() {
 		case strconv.Itoa(23505):
+			if pgErr.ConstraintName == "subscription_request_pending_uniq" {
+				return &subRepo.ErrFollowRequestAlreadyExist{}
+			}
 			return &subRepo.ErrSubscriptionAlreadyExist{}
 		}
 	}
@@ -92,7 +101,7 @@ func (r *subscriptionRepoPG) GetUserSubscriptions(ctx context.Context, userID, c
 	subscriptions := make([]userEntity.SubscriptionUser, 0)
 	for rows.Next() {
 		var subscription userEntity.SubscriptionUser
-		if err = rows.Scan(&subscription.ID, &subscription.Username, &subscription.Avatar, &subscription.HasSubscribeFromCurUser); err != nil {
+		if err = rows.Scan(&subscription.ID, &subscription.Username, &subscription.Avatar, &subscription.HasSubscribeFromCurUser, &subscription.IsMutual); err != nil {
 			return nil, convertErrorPostgres(err)
 		}
 		subscriptions = append(subscriptions, subscription)
@@ -111,10 +120,123 @@ func (r *subscriptionRepoPG) GetUserSubscribers(ctx context.Context, userID, cou
 	subscribers := make([]userEntity.SubscriptionUser, 0)
 	for rows.Next() {
 		var subscriber userEntity.SubscriptionUser
-		if err = rows.Scan(&subscriber.ID, &subscriber.Username, &subscriber.Avatar, &subscriber.HasSubscribeFromCurUser); err != nil {
+		if err = rows.Scan(&subscriber.ID, &subscriber.Username, &subscriber.Avatar, &subscriber.HasSubscribeFromCurUser, &subscriber.IsMutual); err != nil {
 			return nil, convertErrorPostgres(err)
 		}
 		subscribers = append(subscribers, subscriber)
 	}
 	return subscribers, nil
 }
+
+func (r *subscriptionRepoPG) GetKnownFollowers(ctx context.Context, userID, currUserID, count int) ([]userEntity.SubscriptionUser, int, error) {
+	rows, err := r.db.Query(ctx, GetKnownFollowers, userID, currUserID, count)
+	if err != nil {
+		return nil, 0, convertErrorPostgres(err)
+	}
+	defer rows.Close()
+
+	total := 0
+	known := make([]userEntity.SubscriptionUser, 0, count)
+	for rows.Next() {
+		follower := userEntity.SubscriptionUser{HasSubscribeFromCurUser: true}
+		if err = rows.Scan(&follower.ID, &follower.Username, &follower.Avatar, &total); err != nil {
+			return nil, 0, convertErrorPostgres(err)
+		}
+		known = append(known, follower)
+	}
+	return known, total, nil
+}
+
+func (r *subscriptionRepoPG) CreateFollowRequest(ctx context.Context, from, to int) (int, error) {
+	var requestID int
+	err := r.db.QueryRow(ctx, CreateFollowRequest, from, to).Scan(&requestID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, &subRepo.ErrSubscriptionAlreadyExist{}
+	}
+	if err != nil {
+		return 0, convertErrorPostgres(err)
+	}
+	return requestID, nil
+}
+
+func (r *subscriptionRepoPG) CancelFollowRequest(ctx context.Context, from, to int) error {
+	status, err := r.db.Exec(ctx, CancelFollowRequest, from, to)
+	if err != nil {
+		return convertErrorPostgres(err)
+	}
+	if status.RowsAffected() == 0 {
+		return &subRepo.ErrNonExistingFollowRequest{}
+	}
+	return nil
+}
+
+func (r *subscriptionRepoPG) GetFollowRequest(ctx context.Context, requestID int) (*userEntity.FollowRequest, error) {
+	req := &userEntity.FollowRequest{}
+	err := r.db.QueryRow(ctx, GetFollowRequest, requestID).Scan(&req.ID, &req.CreatedAt,
+		&req.From.ID, &req.From.Username, &req.From.Avatar, &req.To.ID, &req.To.Username, &req.To.Avatar)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &subRepo.ErrNonExistingFollowRequest{}
+	}
+	if err != nil {
+		return nil, convertErrorPostgres(err)
+	}
+	return req, nil
+}
+
+func (r *subscriptionRepoPG) GetFollowRequests(ctx context.Context, userID, count, lastID int) ([]userEntity.FollowRequest, error) {
+	rows, err := r.db.Query(ctx, GetFollowRequests, userID, lastID, count)
+	if err != nil {
+		return nil, convertErrorPostgres(err)
+	}
+	defer rows.Close()
+
+	requests := make([]userEntity.FollowRequest, 0)
+	for rows.Next() {
+		req := userEntity.FollowRequest{To: userEntity.User{ID: userID}}
+		if err = rows.Scan(&req.ID, &req.CreatedAt, &req.From.ID, &req.From.Username, &req.From.Avatar); err != nil {
+			return nil, convertErrorPostgres(err)
+		}
+		requests = append(requests, req)
+	}
+	return requests, nil
+}
+
+// ApproveFollowRequest marks the pending request to the user as approved, subscribes its sender
+// and returns the id of the sender.
+func (r *subscriptionRepoPG) ApproveFollowRequest(ctx context.Context, requestID, userID int) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, convertErrorPostgres(err)
+	}
+	defer tx.Rollback(ctx)
+
+	var from int
+	err = tx.QueryRow(ctx, UpdateFollowRequestStatus, requestID, userID, followRequestApproved).Scan(&from)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, &subRepo.ErrNonExistingFollowRequest{}
+	}
+	if err != nil {
+		return 0, convertErrorPostgres(err)
+	}
+
+	if _, err = tx.Exec(ctx, CreateSubscriptionUserIfNotExists, from, userID); err != nil {
+		return 0, convertErrorPostgres(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, convertErrorPostgres(err)
+	}
+	return from, nil
+}
+
+func (r *subscriptionRepoPG) DenyFollowRequest(ctx context.Context, requestID, userID int) error {
+	var from int
+	err := r.db.QueryRow(ctx, UpdateFollowRequestStatus, requestID, userID, followRequestDenied).Scan(&from)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &subRepo.ErrNonExistingFollowRequest{}
+	}
+	if err != nil {
+		return convertErrorPostgres(err)
+	}
+	return nil
+}
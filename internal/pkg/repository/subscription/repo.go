@@ -12,4 +12,11 @@ type Repository interface {
 	DeleteSubscriptionUser(ctx context.Context, from, to int) error
 	GetUserSubscriptions(ctx context.Context, userID, count, lastID int, currUserID int) ([]userEntity.SubscriptionUser, error)
 	GetUserSubscribers(ctx context.Context, userID, count, lastID int, currUserID int) ([]userEntity.SubscriptionUser, error)
+	GetKnownFollowers(ctx context.Context, userID, currUserID, count int) ([]userEntity.SubscriptionUser, int, error)
+	CreateFollowRequest(ctx context.Context, from, to int) (int, error)
+	CancelFollowRequest(ctx context.Context, from, to int) error
+	GetFollowRequest(ctx context.Context, requestID int) (*userEntity.FollowRequest, error)
+	GetFollowRequests(ctx context.Context, userID, count, lastID int) ([]userEntity.FollowRequest, error)
+	ApproveFollowRequest(ctx context.Context, requestID, userID int) (int, error)
+	DenyFollowRequest(ctx context.Context, requestID, userID int) error
 }
@@ -0,0 +1,159 @@
+package timeline
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+)
+
+const (
+	prefixTimeline = "timeline:"
+	// the member with zero score is kept in every built timeline,
+	// so a timeline without pins still differs from a missing one
+	timelineMarker = "0"
+	pushBatchSize  = 500
+)
+
+// MaxLength is the number of the newest pins kept in the timeline of the user.
+const MaxLength = 800
+
+// pushPin adds the pin only to the timelines that have already been built,
+// the missing ones are built from the storage on the first read.
+var pushPin = redis.NewScript(`
+for _, key in ipairs(KEYS) do
+	if redis.call('EXISTS', key) == 1 then
+		redis.call('ZADD', key, ARGV[1], ARGV[1])
+		redis.call('ZREMRANGEBYRANK', key, 0, -tonumber(ARGV[2]) - 1)
+	end
+end
+return 0
+`)
+
+//go:generate mockgen -destination=./mock/cache_mock.go -package=mock -source=cache.go CacheRepository
+type CacheRepository interface {
+	GetTimeline(ctx context.Context, userID int, cond pin.Condition, count int) (pinIDs []int, truncated bool, err error)
+	SetTimeline(ctx context.Context, userID int, pinIDs []int, lifetime time.Duration) error
+	PushPin(ctx context.Context, followersIDs []int, pinID int) error
+	RemovePin(ctx context.Context, followersIDs []int, pinID int) error
+	DeleteTimeline(ctx context.Context, userID int) error
+}
+
+type cacheRepo struct {
+	client *redis.Client
+}
+
+func NewCacheRepo(client *redis.Client) *cacheRepo {
+	return &cacheRepo{client}
+}
+
+// GetTimeline returns the ids of the pins from the timeline of the user with the same conditions as the feed:
+// first the pins newer than cond.MaxID, then the pins older than cond.MinID.
+// The truncated flag is set when the page may continue beyond the pins kept in the cache.
+func (c *cacheRepo) GetTimeline(ctx context.Context, userID int, cond pin.Condition, count int) ([]int, bool, error) {
+	key := prefixTimeline + strconv.Itoa(userID)
+
+	pipe := c.client.Pipeline()
+	exists := pipe.Exists(ctx, key)
+	length := pipe.ZCard(ctx, key)
+	newer := pipe.ZRevRangeByScore(ctx, key, &redis.ZRangeBy{
+		Max:   "+inf",
+		Min:   "(" + strconv.Itoa(cond.MaxID),
+		Count: int64(count),
+	})
+	var older *redis.StringSliceCmd
+	if cond.MinID > 0 {
+		older = pipe.ZRevRangeByScore(ctx, key, &redis.ZRangeBy{
+			Max:   "(" + strconv.Itoa(cond.MinID),
+			Min:   "(" + timelineMarker,
+			Count: int64(count),
+		})
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, false, fmt.Errorf("get timeline from cache: %w", err)
+	}
+	if exists.Val() == 0 {
+		return nil, false, &ErrNotCached{}
+	}
+
+	members := newer.Val()
+	if older != nil {
+		members = append(members, older.Val()...)
+	}
+	members = members[:min(count, len(members))]
+
+	pinIDs := make([]int, 0, len(members))
+	for _, member := range members {
+		pinID, err := strconv.Atoi(member)
+		if err != nil {
+			return nil, false, fmt.Errorf("parse pin id from timeline: %w", err)
+		}
+		pinIDs = append(pinIDs, pinID)
+	}
+	return pinIDs, len(pinIDs) < count && length.Val() >= MaxLength, nil
+}
+
+func (c *cacheRepo) SetTimeline(ctx context.Context, userID int, pinIDs []int, lifetime time.Duration) error {
+	key := prefixTimeline + strconv.Itoa(userID)
+
+	members := make([]redis.Z, 0, len(pinIDs)+1)
+	members = append(members, redis.Z{Score: 0, Member: timelineMarker})
+	for _, pinID := range pinIDs[:min(MaxLength, len(pinIDs))] {
+		members = append(members, redis.Z{Score: float64(pinID), Member: pinID})
+	}
+
+	pipe := c.client.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.ZAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, lifetime)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("set timeline in cache: %w", err)
+	}
+	return nil
+}
+
+// PushPin adds the new pin to the built timelines of the followers and trims them to MaxLength.
+func (c *cacheRepo) PushPin(ctx context.Context, followersIDs []int, pinID int) error {
+	for start := 0; start < len(followersIDs); start += pushBatchSize {
+		batch := followersIDs[start:min(start+pushBatchSize, len(followersIDs))]
+		if err := pushPin.Run(ctx, c.client, timelineKeys(batch), pinID, MaxLength).Err(); err != nil {
+			return fmt.Errorf("push pin to timelines in cache: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *cacheRepo) RemovePin(ctx context.Context, followersIDs []int, pinID int) error {
+	if len(followersIDs) == 0 {
+		return nil
+	}
+
+	pipe := c.client.Pipeline()
+	for _, key := range timelineKeys(followersIDs) {
+		pipe.ZRem(ctx, key, pinID)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("remove pin from timelines in cache: %w", err)
+	}
+	return nil
+}
+
+// DeleteTimeline drops the timeline of the user, it is built again on the next read.
+func (c *cacheRepo) DeleteTimeline(ctx context.Context, userID int) error {
+	if err := c.client.Del(ctx, prefixTimeline+strconv.Itoa(userID)).Err(); err != nil {
+		return fmt.Errorf("delete timeline from cache: %w", err)
+	}
+	return nil
+}
+
+func timelineKeys(usersIDs []int) []string {
+	keys := make([]string, 0, len(usersIDs))
+	for _, userID := range usersIDs {
+		keys = append(keys, prefixTimeline+strconv.Itoa(userID))
+	}
+	return keys
+}
@@ -0,0 +1,13 @@
+package timeline
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrNotCached struct{}
+
+func (e *ErrNotCached) Error() string {
+	return "timeline is not cached"
+}
+
+func (e *ErrNotCached) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
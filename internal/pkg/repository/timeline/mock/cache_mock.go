@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cache.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	pin "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCacheRepository is a mock of CacheRepository interface.
+type MockCacheRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheRepositoryMockRecorder
+}
+
+// MockCacheRepositoryMockRecorder is the mock recorder for MockCacheRepository.
+type MockCacheRepositoryMockRecorder struct {
+	mock *MockCacheRepository
+}
+
+// NewMockCacheRepository creates a new mock instance.
+func NewMockCacheRepository(ctrl *gomock.Controller) *MockCacheRepository {
+	mock := &MockCacheRepository{ctrl: ctrl}
+	mock.recorder = &MockCacheRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCacheRepository) EXPECT() *MockCacheRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteTimeline mocks base method.
+func (m *MockCacheRepository) DeleteTimeline(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTimeline", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTimeline indicates an expected call of DeleteTimeline.
+func (mr *MockCacheRepositoryMockRecorder) DeleteTimeline(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTimeline", reflect.TypeOf((*MockCacheRepository)(nil).DeleteTimeline), ctx, userID)
+}
+
+// GetTimeline mocks base method.
+func (m *MockCacheRepository) GetTimeline(ctx context.Context, userID int, cond pin.Condition, count int) ([]int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeline", ctx, userID, cond, count)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTimeline indicates an expected call of GetTimeline.
+func (mr *MockCacheRepositoryMockRecorder) GetTimeline(ctx, userID, cond, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeline", reflect.TypeOf((*MockCacheRepository)(nil).GetTimeline), ctx, userID, cond, count)
+}
+
+// PushPin mocks base method.
+func (m *MockCacheRepository) PushPin(ctx context.Context, followersIDs []int, pinID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushPin", ctx, followersIDs, pinID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushPin indicates an expected call of PushPin.
+func (mr *MockCacheRepositoryMockRecorder) PushPin(ctx, followersIDs, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushPin", reflect.TypeOf((*MockCacheRepository)(nil).PushPin), ctx, followersIDs, pinID)
+}
+
+// RemovePin mocks base method.
+func (m *MockCacheRepository) RemovePin(ctx context.Context, followersIDs []int, pinID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePin", ctx, followersIDs, pinID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePin indicates an expected call of RemovePin.
+func (mr *MockCacheRepositoryMockRecorder) RemovePin(ctx, followersIDs, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePin", reflect.TypeOf((*MockCacheRepository)(nil).RemovePin), ctx, followersIDs, pinID)
+}
+
+// SetTimeline mocks base method.
+func (m *MockCacheRepository) SetTimeline(ctx context.Context, userID int, pinIDs []int, lifetime time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTimeline", ctx, userID, pinIDs, lifetime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTimeline indicates an expected call of SetTimeline.
+func (mr *MockCacheRepositoryMockRecorder) SetTimeline(ctx, userID, pinIDs, lifetime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTimeline", reflect.TypeOf((*MockCacheRepository)(nil).SetTimeline), ctx, userID, pinIDs, lifetime)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pin "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetFollowedPinIDs mocks base method.
+func (m *MockRepository) GetFollowedPinIDs(ctx context.Context, userID, maxFollowers, count int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowedPinIDs", ctx, userID, maxFollowers, count)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowedPinIDs indicates an expected call of GetFollowedPinIDs.
+func (mr *MockRepositoryMockRecorder) GetFollowedPinIDs(ctx, userID, maxFollowers, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowedPinIDs", reflect.TypeOf((*MockRepository)(nil).GetFollowedPinIDs), ctx, userID, maxFollowers, count)
+}
+
+// GetFollowersIDs mocks base method.
+func (m *MockRepository) GetFollowersIDs(ctx context.Context, userID, limit int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowersIDs", ctx, userID, limit)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowersIDs indicates an expected call of GetFollowersIDs.
+func (mr *MockRepositoryMockRecorder) GetFollowersIDs(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowersIDs", reflect.TypeOf((*MockRepository)(nil).GetFollowersIDs), ctx, userID, limit)
+}
+
+// GetPulledPinIDs mocks base method.
+func (m *MockRepository) GetPulledPinIDs(ctx context.Context, userID, minFollowers int, cond pin.Condition, count int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPulledPinIDs", ctx, userID, minFollowers, cond, count)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPulledPinIDs indicates an expected call of GetPulledPinIDs.
+func (mr *MockRepositoryMockRecorder) GetPulledPinIDs(ctx, userID, minFollowers, cond, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPulledPinIDs", reflect.TypeOf((*MockRepository)(nil).GetPulledPinIDs), ctx, userID, minFollowers, cond, count)
+}
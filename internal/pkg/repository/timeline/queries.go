@@ -0,0 +1,39 @@
+package timeline
+
+var (
+	SelectFollowersIDs = "SELECT who FROM subscription_user WHERE whom = $1 LIMIT $2;"
+
+	// the timeline kept in the cache is built only from the pins of the followed users
+	// that are pushed to it, the followed celebrities are left to SelectPulledPinIDs
+	SelectFollowedPinIDs = `
+	SELECT pin.id
+	FROM pin
+		INNER JOIN subscription_user s ON s.whom = pin.author
+		INNER JOIN profile ON profile.id = pin.author
+	WHERE s.who = $1 AND profile.follower_count <= $2
+		AND pin.deleted_at IS NULL AND pin.public AND NOT pin.draft AND pin.publish_at IS NULL
+	ORDER BY pin.id DESC
+	LIMIT $3;`
+
+	// the pins of the followed authors with too many followers, the pins of the followed boards
+	// and the pins with the liked tags are not pushed to the timelines, they are pulled on reading
+	SelectPulledPinIDs = `
+	SELECT pin.id
+	FROM pin
+	WHERE pin.deleted_at IS NULL AND pin.public AND NOT pin.draft AND pin.publish_at IS NULL
+		AND pin.author <> $1 AND (pin.id < $3 OR pin.id > $4)
+		AND (
+			EXISTS (SELECT 1 FROM subscription_user s INNER JOIN profile ON profile.id = s.whom
+				WHERE s.who = $1 AND s.whom = pin.author AND profile.follower_count > $2)
+			OR EXISTS (SELECT 1 FROM membership m
+				INNER JOIN subscription_board sb ON m.board_id = sb.board_id
+				INNER JOIN board b ON m.board_id = b.id
+				WHERE m.pin_id = pin.id AND sb.user_id = $1 AND b.deleted_at IS NULL
+					AND (b.public OR b.author = $1 OR EXISTS (SELECT 1 FROM contributor c WHERE c.board_id = b.id AND c.user_id = $1)))
+			OR EXISTS (SELECT 1 FROM pin_tag pt WHERE pt.pin_id = pin.id AND pt.tag_id IN (
+				SELECT lt.tag_id FROM like_pin l INNER JOIN pin_tag lt ON l.pin_id = lt.pin_id
+				WHERE l.user_id = $1 GROUP BY lt.tag_id ORDER BY COUNT(*) DESC LIMIT 20))
+		)
+	ORDER BY pin.id DESC
+	LIMIT $5;`
+)
@@ -0,0 +1,59 @@
+package timeline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
+)
+
+//go:generate mockgen -destination=./mock/timeline_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	GetFollowersIDs(ctx context.Context, userID, limit int) ([]int, error)
+	GetFollowedPinIDs(ctx context.Context, userID, maxFollowers, count int) ([]int, error)
+	GetPulledPinIDs(ctx context.Context, userID, minFollowers int, cond pin.Condition, count int) ([]int, error)
+}
+
+type timelineRepoPG struct {
+	db pgtype.PgxPoolIface
+}
+
+func NewTimelineRepoPG(db pgtype.PgxPoolIface) *timelineRepoPG {
+	return &timelineRepoPG{db}
+}
+
+func (t *timelineRepoPG) GetFollowersIDs(ctx context.Context, userID, limit int) ([]int, error) {
+	return t.selectIDs(ctx, SelectFollowersIDs, userID, limit)
+}
+
+// GetFollowedPinIDs returns the ids of the newest pins of the authors followed by the user
+// that have no more than maxFollowers followers.
+func (t *timelineRepoPG) GetFollowedPinIDs(ctx context.Context, userID, maxFollowers, count int) ([]int, error) {
+	return t.selectIDs(ctx, SelectFollowedPinIDs, userID, maxFollowers, count)
+}
+
+// GetPulledPinIDs returns the ids of the pins of the following feed that are not pushed to the timeline:
+// the pins of the followed authors that have more than minFollowers followers,
+// the pins of the followed boards and the pins with the tags the user likes.
+func (t *timelineRepoPG) GetPulledPinIDs(ctx context.Context, userID, minFollowers int, cond pin.Condition, count int) ([]int, error) {
+	return t.selectIDs(ctx, SelectPulledPinIDs, userID, minFollowers, cond.MinID, cond.MaxID, count)
+}
+
+func (t *timelineRepoPG) selectIDs(ctx context.Context, query string, args ...any) ([]int, error) {
+	rows, err := t.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select ids for timeline from storage: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []int{}
+	var id int
+	for rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan id for timeline: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
@@ -0,0 +1,53 @@
+package timeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+)
+
+func TestGetPulledPinIDs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewTimelineRepoPG(pool)
+
+	pool.ExpectQuery("profile.follower_count > \\$2(.|\\s)*subscription_board(.|\\s)*pin_tag").
+		WithArgs(1, 10000, 40, 90, 5).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(95).AddRow(32))
+
+	pinIDs, err := repo.GetPulledPinIDs(ctx, 1, 10000, pin.Condition{MinID: 40, MaxID: 90}, 5)
+	require.NoError(t, err)
+	require.Equal(t, []int{95, 32}, pinIDs)
+	require.NoError(t, pool.ExpectationsWereMet())
+}
+
+func TestGetFollowedPinIDs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewTimelineRepoPG(pool)
+
+	pool.ExpectQuery("INNER JOIN subscription_user s ON s.whom = pin.author").
+		WithArgs(1, 10000, 800).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(70).AddRow(12))
+
+	pinIDs, err := repo.GetFollowedPinIDs(ctx, 1, 10000, 800)
+	require.NoError(t, err)
+	require.Equal(t, []int{70, 12}, pinIDs)
+	require.NoError(t, pool.ExpectationsWereMet())
+}
@@ -0,0 +1,48 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+var ErrNotFoundToken = errors.New("the token was not found or has expired")
+
+//go:generate mockgen -destination=./mock/token_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	AddToken(ctx context.Context, token string, userID int, lifetime time.Duration) error
+	TakeUserIDByToken(ctx context.Context, token string) (int, error)
+}
+
+// tokenRepo keeps one-time tokens issued to users, the prefix separates tokens of different purposes.
+type tokenRepo struct {
+	client *redis.Client
+	prefix string
+}
+
+func NewTokenRepo(client *redis.Client, prefix string) *tokenRepo {
+	return &tokenRepo{client, prefix}
+}
+
+func (t *tokenRepo) AddToken(ctx context.Context, token string, userID int, lifetime time.Duration) error {
+	err := t.client.Set(ctx, t.prefix+token, userID, lifetime).Err()
+	if err != nil {
+		return fmt.Errorf("add token in storage: %w", err)
+	}
+	return nil
+}
+
+// TakeUserIDByToken returns the owner of the token and deletes the token, so it can be used only once.
+func (t *tokenRepo) TakeUserIDByToken(ctx context.Context, token string) (int, error) {
+	userID, err := t.client.GetDel(ctx, t.prefix+token).Int()
+	if err == redis.Nil {
+		return 0, ErrNotFoundToken
+	}
+	if err != nil {
+		return 0, fmt.Errorf("take user id by token from storage: %w", err)
+	}
+	return userID, nil
+}
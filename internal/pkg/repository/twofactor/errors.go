@@ -0,0 +1,33 @@
+package twofactor
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrNotFoundRecoveryCode struct{}
+
+func (e *ErrNotFoundRecoveryCode) Error() string {
+	return "recovery code not found"
+}
+
+func (e *ErrNotFoundRecoveryCode) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
+
+type ErrAlreadyEnabled struct{}
+
+func (e *ErrAlreadyEnabled) Error() string {
+	return "two-factor authentication is already enabled"
+}
+
+func (e *ErrAlreadyEnabled) Type() errPkg.Type {
+	return errPkg.ErrAlreadyExists
+}
+
+type ErrUsedStep struct{}
+
+func (e *ErrUsedStep) Error() string {
+	return "totp code of this time step has already been used"
+}
+
+func (e *ErrUsedStep) Type() errPkg.Type {
+	return errPkg.ErrAlreadyExists
+}
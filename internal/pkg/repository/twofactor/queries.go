@@ -0,0 +1,13 @@
+package twofactor
+
+var (
+	SelectSecret        = "SELECT COALESCE(totp_secret, ''), totp_enabled_at IS NOT NULL FROM profile WHERE id = $1 AND deleted_at IS NULL;"
+	UpdatePendingSecret = "UPDATE profile SET totp_secret = $2 WHERE id = $1 AND totp_enabled_at IS NULL AND deleted_at IS NULL;"
+	UpdateEnabled       = "UPDATE profile SET totp_enabled_at = now() WHERE id = $1 AND totp_secret IS NOT NULL;"
+	UpdateDisabled      = "UPDATE profile SET totp_secret = NULL, totp_enabled_at = NULL WHERE id = $1;"
+	UpdateLastStep      = "UPDATE profile SET totp_last_step = $2 WHERE id = $1 AND totp_last_step < $2;"
+
+	InsertRecoveryCode     = "INSERT INTO totp_recovery_code (user_id, code_hash) VALUES ($1, $2);"
+	DeleteRecoveryCode     = "DELETE FROM totp_recovery_code WHERE user_id = $1 AND code_hash = $2;"
+	DeleteAllRecoveryCodes = "DELETE FROM totp_recovery_code WHERE user_id = $1;"
+)
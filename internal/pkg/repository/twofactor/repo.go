@@ -0,0 +1,124 @@
+package twofactor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/internal/pgtype"
+	userRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+)
+
+//go:generate mockgen -destination=./mock/twofactor_mock.go -package=mock -source=repo.go Repository
+type Repository interface {
+	GetSecret(ctx context.Context, userID int) (secret string, enabled bool, err error)
+	SetPendingSecret(ctx context.Context, userID int, secret string) error
+	Enable(ctx context.Context, userID int, recoveryCodeHashes []string) error
+	Disable(ctx context.Context, userID int) error
+	UseRecoveryCode(ctx context.Context, userID int, codeHash string) error
+	UseStep(ctx context.Context, userID int, step int64) error
+}
+
+type twoFactorRepoPG struct {
+	db pgtype.PgxPoolIface
+}
+
+func NewTwoFactorRepoPG(db pgtype.PgxPoolIface) *twoFactorRepoPG {
+	return &twoFactorRepoPG{db}
+}
+
+func (t *twoFactorRepoPG) GetSecret(ctx context.Context, userID int) (secret string, enabled bool, err error) {
+	err = t.db.QueryRow(ctx, SelectSecret, userID).Scan(&secret, &enabled)
+	if err == pgx.ErrNoRows {
+		return "", false, &userRepo.ErrNonExistingUser{}
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("get totp secret from storage: %w", err)
+	}
+	return secret, enabled, nil
+}
+
+// SetPendingSecret saves the secret which is not used for login until it is enabled.
+func (t *twoFactorRepoPG) SetPendingSecret(ctx context.Context, userID int, secret string) error {
+	status, err := t.db.Exec(ctx, UpdatePendingSecret, userID, secret)
+	if err != nil {
+		return fmt.Errorf("set pending totp secret in storage: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return &ErrAlreadyEnabled{}
+	}
+	return nil
+}
+
+// Enable starts to require the saved secret on login and replaces the recovery codes of the user.
+func (t *twoFactorRepoPG) Enable(ctx context.Context, userID int, recoveryCodeHashes []string) error {
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction for enable totp: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, UpdateEnabled, userID); err != nil {
+		return fmt.Errorf("enable totp in storage: %w", err)
+	}
+	if _, err = tx.Exec(ctx, DeleteAllRecoveryCodes, userID); err != nil {
+		return fmt.Errorf("delete old recovery codes from storage: %w", err)
+	}
+
+	for _, hash := range recoveryCodeHashes {
+		if _, err = tx.Exec(ctx, InsertRecoveryCode, userID, hash); err != nil {
+			return fmt.Errorf("insert recovery code in storage: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction for enable totp: %w", err)
+	}
+	return nil
+}
+
+func (t *twoFactorRepoPG) Disable(ctx context.Context, userID int) error {
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction for disable totp: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, UpdateDisabled, userID); err != nil {
+		return fmt.Errorf("disable totp in storage: %w", err)
+	}
+	if _, err = tx.Exec(ctx, DeleteAllRecoveryCodes, userID); err != nil {
+		return fmt.Errorf("delete recovery codes from storage: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction for disable totp: %w", err)
+	}
+	return nil
+}
+
+// UseRecoveryCode deletes the recovery code, so it can be used only once.
+func (t *twoFactorRepoPG) UseRecoveryCode(ctx context.Context, userID int, codeHash string) error {
+	status, err := t.db.Exec(ctx, DeleteRecoveryCode, userID, codeHash)
+	if err != nil {
+		return fmt.Errorf("use recovery code in storage: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return &ErrNotFoundRecoveryCode{}
+	}
+	return nil
+}
+
+// UseStep saves the time step of the accepted totp code, it fails if the code
+// of this or a later step has already been accepted, so the code can be used only once.
+func (t *twoFactorRepoPG) UseStep(ctx context.Context, userID int, step int64) error {
+	status, err := t.db.Exec(ctx, UpdateLastStep, userID, step)
+	if err != nil {
+		return fmt.Errorf("use totp step in storage: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return &ErrUsedStep{}
+	}
+	return nil
+}
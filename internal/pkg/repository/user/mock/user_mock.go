@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	user "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	user0 "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
@@ -50,6 +51,21 @@ func (mr *MockRepositoryMockRecorder) AddNewUser(ctx, user interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNewUser", reflect.TypeOf((*MockRepository)(nil).AddNewUser), ctx, user)
 }
 
+// CheckProfileAccess mocks base method.
+func (m *MockRepository) CheckProfileAccess(ctx context.Context, ownerID, viewerID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckProfileAccess", ctx, ownerID, viewerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckProfileAccess indicates an expected call of CheckProfileAccess.
+func (mr *MockRepositoryMockRecorder) CheckProfileAccess(ctx, ownerID, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckProfileAccess", reflect.TypeOf((*MockRepository)(nil).CheckProfileAccess), ctx, ownerID, viewerID)
+}
+
 // CheckUserExistence mocks base method.
 func (m *MockRepository) CheckUserExistence(ctx context.Context, userID int) error {
 	m.ctrl.T.Helper()
@@ -64,6 +80,20 @@ func (mr *MockRepositoryMockRecorder) CheckUserExistence(ctx, userID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserExistence", reflect.TypeOf((*MockRepository)(nil).CheckUserExistence), ctx, userID)
 }
 
+// DeleteUser mocks base method.
+func (m *MockRepository) DeleteUser(ctx context.Context, userID int, deletedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID, deletedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockRepositoryMockRecorder) DeleteUser(ctx, userID, deletedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockRepository)(nil).DeleteUser), ctx, userID, deletedAt)
+}
+
 // EditUserAvatar mocks base method.
 func (m *MockRepository) EditUserAvatar(ctx context.Context, userID int, avatar string) error {
 	m.ctrl.T.Helper()
@@ -92,6 +122,22 @@ func (mr *MockRepositoryMockRecorder) EditUserInfo(ctx, userID, updateFields int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditUserInfo", reflect.TypeOf((*MockRepository)(nil).EditUserInfo), ctx, userID, updateFields)
 }
 
+// GetAccess mocks base method.
+func (m *MockRepository) GetAccess(ctx context.Context, userID int) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccess", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAccess indicates an expected call of GetAccess.
+func (mr *MockRepositoryMockRecorder) GetAccess(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccess", reflect.TypeOf((*MockRepository)(nil).GetAccess), ctx, userID)
+}
+
 // GetAllUserData mocks base method.
 func (m *MockRepository) GetAllUserData(ctx context.Context, userID int) (*user.User, error) {
 	m.ctrl.T.Helper()
@@ -107,6 +153,53 @@ func (mr *MockRepositoryMockRecorder) GetAllUserData(ctx, userID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUserData", reflect.TypeOf((*MockRepository)(nil).GetAllUserData), ctx, userID)
 }
 
+// GetDeletedUserByUsername mocks base method.
+func (m *MockRepository) GetDeletedUserByUsername(ctx context.Context, username string) (*user.User, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUserByUsername", ctx, username)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeletedUserByUsername indicates an expected call of GetDeletedUserByUsername.
+func (mr *MockRepositoryMockRecorder) GetDeletedUserByUsername(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUserByUsername", reflect.TypeOf((*MockRepository)(nil).GetDeletedUserByUsername), ctx, username)
+}
+
+// GetEmailVerification mocks base method.
+func (m *MockRepository) GetEmailVerification(ctx context.Context, userID int) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailVerification", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEmailVerification indicates an expected call of GetEmailVerification.
+func (mr *MockRepositoryMockRecorder) GetEmailVerification(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailVerification", reflect.TypeOf((*MockRepository)(nil).GetEmailVerification), ctx, userID)
+}
+
+// GetPasswordByID mocks base method.
+func (m *MockRepository) GetPasswordByID(ctx context.Context, userID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordByID", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPasswordByID indicates an expected call of GetPasswordByID.
+func (mr *MockRepositoryMockRecorder) GetPasswordByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordByID", reflect.TypeOf((*MockRepository)(nil).GetPasswordByID), ctx, userID)
+}
+
 // GetProfileData mocks base method.
 func (m *MockRepository) GetProfileData(ctx context.Context, userID int) (*user.User, int, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +248,21 @@ func (mr *MockRepositoryMockRecorder) GetUserData(ctx, userID, currUserID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserData", reflect.TypeOf((*MockRepository)(nil).GetUserData), ctx, userID, currUserID)
 }
 
+// GetUserIdByEmail mocks base method.
+func (m *MockRepository) GetUserIdByEmail(ctx context.Context, email string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdByEmail", ctx, email)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdByEmail indicates an expected call of GetUserIdByEmail.
+func (mr *MockRepositoryMockRecorder) GetUserIdByEmail(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdByEmail", reflect.TypeOf((*MockRepository)(nil).GetUserIdByEmail), ctx, email)
+}
+
 // GetUserIdByUsername mocks base method.
 func (m *MockRepository) GetUserIdByUsername(ctx context.Context, username string) (int, error) {
 	m.ctrl.T.Helper()
@@ -185,3 +293,61 @@ func (mr *MockRepositoryMockRecorder) GetUsernameAndAvatarByID(ctx, userID inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsernameAndAvatarByID", reflect.TypeOf((*MockRepository)(nil).GetUsernameAndAvatarByID), ctx, userID)
 }
+
+// IsPrivate mocks base method.
+func (m *MockRepository) IsPrivate(ctx context.Context, userID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPrivate", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPrivate indicates an expected call of IsPrivate.
+func (mr *MockRepositoryMockRecorder) IsPrivate(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPrivate", reflect.TypeOf((*MockRepository)(nil).IsPrivate), ctx, userID)
+}
+
+// PurgeUsersDeletedBefore mocks base method.
+func (m *MockRepository) PurgeUsersDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeUsersDeletedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeUsersDeletedBefore indicates an expected call of PurgeUsersDeletedBefore.
+func (mr *MockRepositoryMockRecorder) PurgeUsersDeletedBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeUsersDeletedBefore", reflect.TypeOf((*MockRepository)(nil).PurgeUsersDeletedBefore), ctx, before)
+}
+
+// RestoreUser mocks base method.
+func (m *MockRepository) RestoreUser(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockRepositoryMockRecorder) RestoreUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockRepository)(nil).RestoreUser), ctx, userID)
+}
+
+// SetEmailVerified mocks base method.
+func (m *MockRepository) SetEmailVerified(ctx context.Context, userID int, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEmailVerified", ctx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEmailVerified indicates an expected call of SetEmailVerified.
+func (mr *MockRepositoryMockRecorder) SetEmailVerified(ctx, userID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailVerified", reflect.TypeOf((*MockRepository)(nil).SetEmailVerified), ctx, userID, email)
+}
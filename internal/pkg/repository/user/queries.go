@@ -1,19 +1,43 @@
 package user
 
 var (
-	InsertNewUser = "INSERT INTO profile (username, password, email) VALUES ($1, $2, $3);"
+	InsertNewUser = "INSERT INTO profile (username, password, email) VALUES ($1, $2, $3) RETURNING id;"
 
-	SelectAuthByUsername         = "SELECT id, password, email FROM profile WHERE username = $1;"
+	SelectAuthByUsername         = "SELECT id, password, email FROM profile WHERE username = $1 AND deleted_at IS NULL;"
+	SelectDeletedAuthByUsername  = "SELECT id, password, email, deleted_at FROM profile WHERE username = $1 AND deleted_at IS NOT NULL;"
+	SelectPasswordByID           = "SELECT password FROM profile WHERE id = $1 AND deleted_at IS NULL;"
 	SelectUsernameAndAvatar      = "SELECT username, avatar FROM profile WHERE id = $1;"
 	SelectUserDataExceptPassword = "SELECT username, email, avatar, name, surname, about_me FROM profile WHERE id = $1;"
 
-	UpdateAvatarProfile    = "UPDATE profile SET avatar = $1 WHERE id = $2;"
-	SelectUserIdByUsername = "SELECT id FROM profile WHERE username = $1;"
-	SelectLastUserID       = "SELECT id FROM profile ORDER BY id DESC LIMIT 1;"
-	CheckUserExistence     = "SELECT username FROM profile WHERE id = $1 AND deleted_at IS NULL;"
-	GetUserInfo            = `
+	UpdateAvatarProfile     = "UPDATE profile SET avatar = $1 WHERE id = $2;"
+	SelectUserIdByUsername  = "SELECT id FROM profile WHERE username = $1;"
+	SelectUserIdByEmail     = "SELECT id FROM profile WHERE email = $1 AND deleted_at IS NULL;"
+	SelectEmailVerification = "SELECT email, email_verified_at IS NOT NULL FROM profile WHERE id = $1 AND deleted_at IS NULL;"
+	UpdateEmailVerified     = "UPDATE profile SET email_verified_at = now() WHERE id = $1 AND email = $2 AND deleted_at IS NULL;"
+	SelectLastUserID        = "SELECT id FROM profile ORDER BY id DESC LIMIT 1;"
+	CheckUserExistence      = "SELECT username FROM profile WHERE id = $1 AND deleted_at IS NULL;"
+	SelectPrivate           = "SELECT private FROM profile WHERE id = $1 AND deleted_at IS NULL;"
+	SelectAccess            = `SELECT role, suspended_at IS NOT NULL AND (suspended_until IS NULL OR suspended_until > now())
+							   FROM profile WHERE id = $1 AND deleted_at IS NULL;`
+	SelectProfileAccess = `SELECT NOT private OR id = $2 OR EXISTS (SELECT 1 FROM subscription_user WHERE who = $2 AND whom = id)
+							   FROM profile WHERE id = $1 AND deleted_at IS NULL;`
+
+	UpdateProfileDeleted  = "UPDATE profile SET deleted_at = $2 WHERE id = $1 AND deleted_at IS NULL;"
+	UpdatePinsDeleted     = "UPDATE pin SET deleted_at = $2 WHERE author = $1 AND deleted_at IS NULL;"
+	UpdateBoardsDeleted   = "UPDATE board SET deleted_at = $2 WHERE author = $1 AND deleted_at IS NULL;"
+	UpdateCommentsDeleted = "UPDATE comment SET deleted_at = $2 WHERE author = $1 AND deleted_at IS NULL;"
+
+	// only the content deleted together with the profile is restored, it has the same deletion time
+	UpdatePinsRestored     = "UPDATE pin SET deleted_at = NULL WHERE author = $1 AND deleted_at = (SELECT deleted_at FROM profile WHERE id = $1);"
+	UpdateBoardsRestored   = "UPDATE board SET deleted_at = NULL WHERE author = $1 AND deleted_at = (SELECT deleted_at FROM profile WHERE id = $1);"
+	UpdateCommentsRestored = "UPDATE comment SET deleted_at = NULL WHERE author = $1 AND deleted_at = (SELECT deleted_at FROM profile WHERE id = $1);"
+	UpdateProfileRestored  = "UPDATE profile SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL;"
+
+	DeleteProfilesDeletedBefore = "DELETE FROM profile WHERE deleted_at < $1;"
+
+	GetUserInfo = `
 		SELECT
-			p1.id, p1.username, p1.avatar, COALESCE(p1.name, '') name, COALESCE(p1.surname, '') surname, COALESCE(p1.about_me, '') about_me, s2.who IS NOT NULL as is_subscribed, COUNT(s1.who) subscribers
+			p1.id, p1.username, p1.avatar, COALESCE(p1.name, '') name, COALESCE(p1.surname, '') surname, COALESCE(p1.about_me, '') about_me, p1.private, s2.who IS NOT NULL as is_subscribed, COUNT(s1.who) subscribers
 		FROM
 			profile p1
 		LEFT JOIN 
@@ -25,11 +49,11 @@ var (
 		WHERE
 			p1.id = $2 AND p1.deleted_at IS NULL AND p2.deleted_at IS NULL
 		GROUP BY
-			p1.id, p1.username,p1.avatar, p1.name, p1.surname, p1.about_me, s2.who IS NOT NULL;
+			p1.id, p1.username,p1.avatar, p1.name, p1.surname, p1.about_me, p1.private, s2.who IS NOT NULL;
 		`
 	GetProfileInfo = `
 		SELECT
-			p1.id, p1.username, p1.avatar, COUNT(s.who) subscribers
+			p1.id, p1.username, p1.avatar, p1.private, COUNT(s.who) subscribers
 		FROM
 			profile p1
 		LEFT JOIN 
@@ -39,6 +63,6 @@ var (
 		WHERE
 			p1.id = $1 AND p1.deleted_at IS NULL AND p2.deleted_at IS NULL
 		GROUP BY
-			p1.id, p1.username, p1.avatar;
+			p1.id, p1.username, p1.avatar, p1.private;
 	`
 )
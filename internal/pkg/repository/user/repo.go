@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
@@ -21,12 +22,23 @@ type Repository interface {
 	GetUserByUsername(ctx context.Context, username string) (*user.User, error)
 	GetUsernameAndAvatarByID(ctx context.Context, userID int) (username string, avatar string, err error)
 	GetUserIdByUsername(ctx context.Context, username string) (int, error)
+	GetUserIdByEmail(ctx context.Context, email string) (int, error)
+	GetPasswordByID(ctx context.Context, userID int) (string, error)
+	GetEmailVerification(ctx context.Context, userID int) (email string, verified bool, err error)
+	SetEmailVerified(ctx context.Context, userID int, email string) error
 	GetUserData(ctx context.Context, userID, currUserID int) (user_ *user.User, isSubscribed bool, subsCount int, err error)
 	GetProfileData(ctx context.Context, userID int) (user_ *user.User, subsCount int, err error)
 	CheckUserExistence(ctx context.Context, userID int) error
+	IsPrivate(ctx context.Context, userID int) (bool, error)
+	CheckProfileAccess(ctx context.Context, ownerID, viewerID int) (bool, error)
+	GetAccess(ctx context.Context, userID int) (role string, suspended bool, err error)
 	EditUserAvatar(ctx context.Context, userID int, avatar string) error
 	GetAllUserData(ctx context.Context, userID int) (*user.User, error)
 	EditUserInfo(ctx context.Context, userID int, updateFields S) error
+	DeleteUser(ctx context.Context, userID int, deletedAt time.Time) error
+	GetDeletedUserByUsername(ctx context.Context, username string) (user *user.User, deletedAt time.Time, err error)
+	RestoreUser(ctx context.Context, userID int) error
+	PurgeUsersDeletedBefore(ctx context.Context, before time.Time) (int64, error)
 }
 
 type S map[string]any
@@ -67,8 +79,33 @@ func (u *userRepoPG) CheckUserExistence(ctx context.Context, userID int) error {
 	return nil
 }
 
+func (u *userRepoPG) IsPrivate(ctx context.Context, userID int) (private bool, err error) {
+	if err = u.db.QueryRow(ctx, SelectPrivate, userID).Scan(&private); err != nil {
+		return false, convertErrorPostgres(err)
+	}
+	return private, nil
+}
+
+// CheckProfileAccess reports whether the viewer can see the content of the profile:
+// the profile is public, or the viewer is its owner or the approved follower.
+func (u *userRepoPG) CheckProfileAccess(ctx context.Context, ownerID, viewerID int) (ok bool, err error) {
+	if err = u.db.QueryRow(ctx, SelectProfileAccess, ownerID, viewerID).Scan(&ok); err != nil {
+		return false, convertErrorPostgres(err)
+	}
+	return ok, nil
+}
+
+// GetAccess returns the global role of the user and whether the user is suspended now.
+func (u *userRepoPG) GetAccess(ctx context.Context, userID int) (role string, suspended bool, err error) {
+	err = u.db.QueryRow(ctx, SelectAccess, userID).Scan(&role, &suspended)
+	if err != nil {
+		return "", false, convertErrorPostgres(err)
+	}
+	return role, suspended, nil
+}
+
 func (u *userRepoPG) AddNewUser(ctx context.Context, user *user.User) error {
-	_, err := u.db.Exec(ctx, InsertNewUser, user.Username, user.Password, user.Email)
+	err := u.db.QueryRow(ctx, InsertNewUser, user.Username, user.Password, user.Email).Scan(&user.ID)
 	if err != nil {
 		return fmt.Errorf("add a new profile in storage: %w", err)
 	}
@@ -98,7 +135,7 @@ func (u *userRepoPG) GetUserData(ctx context.Context, userID, currUserID int) (u
 	user_ = &user.User{}
 	if err := u.db.QueryRow(ctx, GetUserInfo, currUserID, userID).Scan(
 		&user_.ID, &user_.Username, &user_.Avatar, &user_.Name, &user_.Surname,
-		&user_.AboutMe, &isSubscribed, &subsCount,
+		&user_.AboutMe, &user_.Private, &isSubscribed, &subsCount,
 	); err != nil {
 		return nil, false, 0, convertErrorPostgres(err)
 	}
@@ -108,7 +145,7 @@ func (u *userRepoPG) GetUserData(ctx context.Context, userID, currUserID int) (u
 func (u *userRepoPG) GetProfileData(ctx context.Context, userID int) (user_ *user.User, subsCount int, err error) {
 	user_ = &user.User{}
 	if err := u.db.QueryRow(ctx, GetProfileInfo, userID).Scan(
-		&user_.ID, &user_.Username, &user_.Avatar, &subsCount,
+		&user_.ID, &user_.Username, &user_.Avatar, &user_.Private, &subsCount,
 	); err != nil {
 		return nil, 0, convertErrorPostgres(err)
 	}
@@ -164,3 +201,113 @@ func (u *userRepoPG) GetUserIdByUsername(ctx context.Context, username string) (
 	}
 	return userID, nil
 }
+
+func (u *userRepoPG) GetUserIdByEmail(ctx context.Context, email string) (int, error) {
+	var userID int
+	if err := u.db.QueryRow(ctx, SelectUserIdByEmail, email).Scan(&userID); err != nil {
+		return 0, convertErrorPostgres(err)
+	}
+	return userID, nil
+}
+
+func (u *userRepoPG) GetPasswordByID(ctx context.Context, userID int) (string, error) {
+	var password string
+	if err := u.db.QueryRow(ctx, SelectPasswordByID, userID).Scan(&password); err != nil {
+		return "", convertErrorPostgres(err)
+	}
+	return password, nil
+}
+
+func (u *userRepoPG) GetEmailVerification(ctx context.Context, userID int) (email string, verified bool, err error) {
+	if err = u.db.QueryRow(ctx, SelectEmailVerification, userID).Scan(&email, &verified); err != nil {
+		return "", false, convertErrorPostgres(err)
+	}
+	return email, verified, nil
+}
+
+// SetEmailVerified marks the email of the user as verified if the user still has this email.
+func (u *userRepoPG) SetEmailVerified(ctx context.Context, userID int, email string) error {
+	status, err := u.db.Exec(ctx, UpdateEmailVerified, userID, email)
+	if err != nil {
+		return convertErrorPostgres(err)
+	}
+	if status.RowsAffected() == 0 {
+		return &ErrNonExistingUser{}
+	}
+	return nil
+}
+
+// DeleteUser marks the profile and its pins, boards and comments as deleted at the same time.
+func (u *userRepoPG) DeleteUser(ctx context.Context, userID int, deletedAt time.Time) error {
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction for delete user: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	status, err := tx.Exec(ctx, UpdateProfileDeleted, userID, deletedAt)
+	if err != nil {
+		return fmt.Errorf("delete profile in storage: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return &ErrNonExistingUser{}
+	}
+
+	for _, query := range []string{UpdatePinsDeleted, UpdateBoardsDeleted, UpdateCommentsDeleted} {
+		if _, err = tx.Exec(ctx, query, userID, deletedAt); err != nil {
+			return fmt.Errorf("delete user content in storage: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction for delete user: %w", err)
+	}
+	return nil
+}
+
+func (u *userRepoPG) GetDeletedUserByUsername(ctx context.Context, username string) (*user.User, time.Time, error) {
+	user := &user.User{Username: username}
+	var deletedAt time.Time
+	err := u.db.QueryRow(ctx, SelectDeletedAuthByUsername, username).Scan(&user.ID, &user.Password, &user.Email, &deletedAt)
+	if err != nil {
+		return nil, time.Time{}, convertErrorPostgres(err)
+	}
+	return user, deletedAt, nil
+}
+
+// RestoreUser undoes DeleteUser, the content deleted by the user before is not restored.
+func (u *userRepoPG) RestoreUser(ctx context.Context, userID int) error {
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction for restore user: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, query := range []string{UpdatePinsRestored, UpdateBoardsRestored, UpdateCommentsRestored} {
+		if _, err = tx.Exec(ctx, query, userID); err != nil {
+			return fmt.Errorf("restore user content in storage: %w", err)
+		}
+	}
+
+	status, err := tx.Exec(ctx, UpdateProfileRestored, userID)
+	if err != nil {
+		return fmt.Errorf("restore profile in storage: %w", err)
+	}
+	if status.RowsAffected() == 0 {
+		return &ErrNonExistingUser{}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction for restore user: %w", err)
+	}
+	return nil
+}
+
+// PurgeUsersDeletedBefore removes the deleted profiles for good, everything related to them is removed by cascade.
+func (u *userRepoPG) PurgeUsersDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	status, err := u.db.Exec(ctx, DeleteProfilesDeletedBefore, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge deleted profiles from storage: %w", err)
+	}
+	return status.RowsAffected(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -22,19 +23,21 @@ func TestAddNewUser(t *testing.T) {
 
 	repoUser := NewUserRepoPG(pool)
 
-	pool.ExpectExec("INSERT INTO profile").
+	pool.ExpectQuery("INSERT INTO profile").
 		WithArgs("my_username", "1234", "a@test.com").
-		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(7))
 
-	err = repoUser.AddNewUser(ctx, &user.User{
+	newUser := &user.User{
 		Username: "my_username",
 		Password: "1234",
 		Email:    "a@test.com",
-	})
+	}
+	err = repoUser.AddNewUser(ctx, newUser)
 	require.NoError(t, err)
+	require.Equal(t, 7, newUser.ID)
 
 	wantErr := errors.New("insert profile fail")
-	pool.ExpectExec("INSERT INTO profile").
+	pool.ExpectQuery("INSERT INTO profile").
 		WithArgs("my_username", "1234", "a@test.com").
 		WillReturnError(wantErr)
 
@@ -203,3 +206,67 @@ func TestGetUserIdByUsername(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 4, id)
 }
+
+func TestDeleteUser(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repoUser := NewUserRepoPG(pool)
+	deletedAt := time.Date(2023, time.December, 1, 12, 0, 0, 0, time.UTC)
+
+	pool.ExpectBegin()
+	pool.ExpectExec("UPDATE profile SET deleted_at").
+		WithArgs(5, deletedAt).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	for _, table := range []string{"pin", "board", "comment"} {
+		pool.ExpectExec("UPDATE "+table+" SET deleted_at").
+			WithArgs(5, deletedAt).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+	}
+	pool.ExpectCommit()
+
+	err = repoUser.DeleteUser(ctx, 5, deletedAt)
+	require.NoError(t, err)
+
+	pool.ExpectBegin()
+	pool.ExpectExec("UPDATE profile SET deleted_at").
+		WithArgs(5, deletedAt).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+	pool.ExpectRollback()
+
+	err = repoUser.DeleteUser(ctx, 5, deletedAt)
+	require.ErrorAs(t, err, new(*ErrNonExistingUser))
+	require.NoError(t, pool.ExpectationsWereMet())
+}
+
+func TestRestoreUser(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repoUser := NewUserRepoPG(pool)
+
+	pool.ExpectBegin()
+	for _, table := range []string{"pin", "board", "comment"} {
+		pool.ExpectExec("UPDATE " + table + " SET deleted_at = NULL").
+			WithArgs(5).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+	}
+	pool.ExpectExec("UPDATE profile SET deleted_at = NULL").
+		WithArgs(5).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	pool.ExpectCommit()
+
+	err = repoUser.RestoreUser(ctx, 5)
+	require.NoError(t, err)
+	require.NoError(t, pool.ExpectationsWereMet())
+}
@@ -0,0 +1,29 @@
+package analytics
+
+import (
+	"fmt"
+
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+)
+
+type ErrBadPeriod struct {
+	Days int
+}
+
+func (e *ErrBadPeriod) Error() string {
+	return fmt.Sprintf("analytics is not reported for %d days, the available periods are 7, 30 and 90 days", e.Days)
+}
+
+func (e *ErrBadPeriod) Type() errPkg.Type {
+	return errPkg.ErrInvalidInput
+}
+
+type ErrNotPinAuthor struct{}
+
+func (e *ErrNotPinAuthor) Error() string {
+	return "analytics of the pin is available only to its author"
+}
+
+func (e *ErrNotPinAuthor) Type() errPkg.Type {
+	return errPkg.ErrNoAccess
+}
@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	analytics "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetAccountAnalytics mocks base method.
+func (m *MockUsecase) GetAccountAnalytics(ctx context.Context, userID, days int) (*analytics.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountAnalytics", ctx, userID, days)
+	ret0, _ := ret[0].(*analytics.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountAnalytics indicates an expected call of GetAccountAnalytics.
+func (mr *MockUsecaseMockRecorder) GetAccountAnalytics(ctx, userID, days interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountAnalytics", reflect.TypeOf((*MockUsecase)(nil).GetAccountAnalytics), ctx, userID, days)
+}
+
+// GetPinAnalytics mocks base method.
+func (m *MockUsecase) GetPinAnalytics(ctx context.Context, pinID, userID, days int) (*analytics.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPinAnalytics", ctx, pinID, userID, days)
+	ret0, _ := ret[0].(*analytics.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPinAnalytics indicates an expected call of GetPinAnalytics.
+func (mr *MockUsecaseMockRecorder) GetPinAnalytics(ctx, pinID, userID, days interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinAnalytics", reflect.TypeOf((*MockUsecase)(nil).GetPinAnalytics), ctx, pinID, userID, days)
+}
+
+// Run mocks base method.
+func (m *MockUsecase) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockUsecaseMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockUsecase)(nil).Run), ctx)
+}
+
+// Track mocks base method.
+func (m *MockUsecase) Track(pinID int, event analytics.EventType) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Track", pinID, event)
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockUsecaseMockRecorder) Track(pinID, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockUsecase)(nil).Track), pinID, event)
+}
@@ -0,0 +1,170 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
+	analyticsRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/analytics"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+const (
+	_bufferSize    = 10000
+	_maxBatch      = 1000
+	_flushInterval = 10 * time.Second
+	_timeoutFlush  = 30 * time.Second
+)
+
+//go:generate mockgen -destination=./mock/analytics_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	Track(pinID int, event entity.EventType)
+	Run(ctx context.Context)
+	GetPinAnalytics(ctx context.Context, pinID, userID, days int) (*entity.Report, error)
+	GetAccountAnalytics(ctx context.Context, userID, days int) (*entity.Report, error)
+}
+
+type statKey struct {
+	pinID int
+	day   time.Time
+}
+
+type analyticsCase struct {
+	log    *logger.Logger
+	repo   analyticsRepo.Repository
+	events chan entity.Event
+}
+
+func New(log *logger.Logger, repo analyticsRepo.Repository) *analyticsCase {
+	return &analyticsCase{
+		log:    log,
+		repo:   repo,
+		events: make(chan entity.Event, _bufferSize),
+	}
+}
+
+// Track records the event without waiting for the storage, the event is dropped when the buffer is full.
+func (a *analyticsCase) Track(pinID int, event entity.EventType) {
+	select {
+	case a.events <- entity.Event{PinID: pinID, Type: event, Time: time.Now()}:
+	default:
+		a.log.Warn("analytics buffer is full, the event has been dropped")
+	}
+}
+
+// Run aggregates the tracked events and writes them to the storage in batches
+// until ctx is done, then the rest of the buffer is written.
+func (a *analyticsCase) Run(ctx context.Context) {
+	ticker := time.NewTicker(_flushInterval)
+	defer ticker.Stop()
+
+	batch := make(map[statKey]*entity.Counters)
+	for {
+		select {
+		case <-ctx.Done():
+			for len(a.events) > 0 {
+				addEvent(batch, <-a.events)
+			}
+			a.flush(batch)
+			return
+		case event := <-a.events:
+			addEvent(batch, event)
+			if len(batch) >= _maxBatch {
+				a.flush(batch)
+			}
+		case <-ticker.C:
+			a.flush(batch)
+		}
+	}
+}
+
+func addEvent(batch map[statKey]*entity.Counters, event entity.Event) {
+	key := statKey{pinID: event.PinID, day: entity.Day(event.Time)}
+	counters, ok := batch[key]
+	if !ok {
+		counters = &entity.Counters{}
+		batch[key] = counters
+	}
+	counters.Add(event.Type)
+}
+
+// flush writes the batch and clears it, the failed batch is dropped so as not to grow unbounded.
+func (a *analyticsCase) flush(batch map[statKey]*entity.Counters) {
+	if len(batch) == 0 {
+		return
+	}
+
+	stats := make([]entity.PinDailyStat, 0, len(batch))
+	for key, counters := range batch {
+		stats = append(stats, entity.PinDailyStat{PinID: key.pinID, Day: key.day, Counters: *counters})
+		delete(batch, key)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), _timeoutFlush)
+	defer cancel()
+	if err := a.repo.AddPinStats(ctx, stats); err != nil {
+		a.log.Error(fmt.Sprintf("write %d pin stats: %s", len(stats), err.Error()))
+	}
+}
+
+func (a *analyticsCase) GetPinAnalytics(ctx context.Context, pinID, userID, days int) (*entity.Report, error) {
+	since, err := periodStart(days)
+	if err != nil {
+		return nil, err
+	}
+
+	authorID, err := a.repo.GetPinAuthorID(ctx, pinID)
+	if err != nil {
+		return nil, fmt.Errorf("get pin analytics: %w", err)
+	}
+	if authorID != userID {
+		return nil, &ErrNotPinAuthor{}
+	}
+
+	stats, err := a.repo.GetPinStats(ctx, pinID, since)
+	if err != nil {
+		return nil, fmt.Errorf("get pin analytics: %w", err)
+	}
+	return buildReport(days, since, stats), nil
+}
+
+func (a *analyticsCase) GetAccountAnalytics(ctx context.Context, userID, days int) (*entity.Report, error) {
+	since, err := periodStart(days)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := a.repo.GetAccountStats(ctx, userID, since)
+	if err != nil {
+		return nil, fmt.Errorf("get account analytics: %w", err)
+	}
+	return buildReport(days, since, stats), nil
+}
+
+// periodStart returns the first day of the period of the last days including today.
+func periodStart(days int) (time.Time, error) {
+	if !slices.Contains(entity.Periods, days) {
+		return time.Time{}, &ErrBadPeriod{Days: days}
+	}
+	return entity.Day(time.Now()).AddDate(0, 0, 1-days), nil
+}
+
+// buildReport fills the days with no events with zeros, so the series has an item for each day of the period.
+func buildReport(days int, since time.Time, stats []entity.DailyStat) *entity.Report {
+	report := &entity.Report{Days: days, Stats: make([]entity.DailyStat, days)}
+	for i := range report.Stats {
+		report.Stats[i].Day = since.AddDate(0, 0, i)
+	}
+
+	for _, stat := range stats {
+		i := int(entity.Day(stat.Day).Sub(since) / (24 * time.Hour))
+		if i < 0 || i >= days {
+			continue
+		}
+		report.Stats[i].Counters = stat.Counters
+		report.Total.Sum(stat.Counters)
+	}
+	return report
+}
@@ -0,0 +1,85 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/analytics/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+func TestRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	uc := New(log, repo)
+
+	uc.Track(3, entity.EventImpression)
+	uc.Track(3, entity.EventImpression)
+	uc.Track(3, entity.EventCloseup)
+	uc.Track(5, entity.EventSave)
+
+	today := entity.Day(time.Now())
+	repo.EXPECT().AddPinStats(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, stats []entity.PinDailyStat) error {
+			require.ElementsMatch(t, []entity.PinDailyStat{
+				{PinID: 3, Day: today, Counters: entity.Counters{Impressions: 2, Closeups: 1}},
+				{PinID: 5, Day: today, Counters: entity.Counters{Saves: 1}},
+			}, stats)
+			return nil
+		}).Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	uc.Run(ctx)
+}
+
+func TestGetPinAnalytics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	uc := New(log, repo)
+
+	today := entity.Day(time.Now())
+	since := today.AddDate(0, 0, -6)
+
+	repo.EXPECT().GetPinAuthorID(ctx, 8).Return(2, nil).Times(2)
+	repo.EXPECT().GetPinStats(ctx, 8, since).Return([]entity.DailyStat{
+		{Day: since.AddDate(0, 0, 1), Counters: entity.Counters{Impressions: 5, Likes: 1}},
+		{Day: today, Counters: entity.Counters{Impressions: 3, Clicks: 2}},
+	}, nil).Times(1)
+
+	report, err := uc.GetPinAnalytics(ctx, 8, 2, 7)
+	require.NoError(t, err)
+	require.Equal(t, 7, report.Days)
+	require.Len(t, report.Stats, 7)
+	require.Equal(t, since, report.Stats[0].Day)
+	require.Equal(t, entity.Counters{}, report.Stats[0].Counters)
+	require.Equal(t, entity.Counters{Impressions: 5, Likes: 1}, report.Stats[1].Counters)
+	require.Equal(t, entity.Counters{Impressions: 3, Clicks: 2}, report.Stats[6].Counters)
+	require.Equal(t, entity.Counters{Impressions: 8, Likes: 1, Clicks: 2}, report.Total)
+
+	_, err = uc.GetPinAnalytics(ctx, 8, 4, 7)
+	require.ErrorAs(t, err, new(*ErrNotPinAuthor))
+
+	_, err = uc.GetPinAnalytics(ctx, 8, 2, 14)
+	require.ErrorAs(t, err, new(*ErrBadPeriod))
+}
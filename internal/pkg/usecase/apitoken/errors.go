@@ -0,0 +1,34 @@
+package apitoken
+
+import (
+	"errors"
+
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+)
+
+var ErrInvalidToken = errors.New("invalid or expired api token")
+
+type ErrInvalidScopes struct {
+	Scopes []string
+}
+
+func (e *ErrInvalidScopes) Error() string {
+	if len(e.Scopes) == 0 {
+		return "the api token must have at least one scope"
+	}
+	return "unknown scopes of the api token"
+}
+
+func (e *ErrInvalidScopes) Type() errPkg.Type {
+	return errPkg.ErrInvalidInput
+}
+
+type ErrTooManyTokens struct{}
+
+func (e *ErrTooManyTokens) Error() string {
+	return "the limit of api tokens is reached, revoke unused ones"
+}
+
+func (e *ErrTooManyTokens) Type() errPkg.Type {
+	return errPkg.ErrNoAccess
+}
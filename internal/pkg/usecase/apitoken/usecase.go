@@ -0,0 +1,147 @@
+package apitoken
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/apitoken"
+	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/apitoken"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+const (
+	// Prefix makes the tokens recognizable, for example by the secret scanners.
+	Prefix           = "pnsp_"
+	MaxTokensPerUser = 20
+
+	lenSecret = 40
+)
+
+//go:generate mockgen -destination=./mock/apitoken_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	Create(ctx context.Context, userID int, name string, scopes []string, lifetime time.Duration) (secret string, token *entity.Token, err error)
+	List(ctx context.Context, userID int) ([]entity.Token, error)
+	Revoke(ctx context.Context, userID, tokenID int) error
+	Check(ctx context.Context, secret string) (*entity.Token, error)
+}
+
+type apiTokenCase struct {
+	log  *logger.Logger
+	repo repo.Repository
+	now  func() time.Time
+}
+
+func New(log *logger.Logger, repo repo.Repository) *apiTokenCase {
+	return &apiTokenCase{
+		log:  log,
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// Create returns the secret of the new token, it is shown to the user only once.
+// The token without lifetime does not expire until it is revoked.
+func (a *apiTokenCase) Create(ctx context.Context, userID int, name string, scopes []string, lifetime time.Duration) (string, *entity.Token, error) {
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	tokens, err := a.repo.GetUserTokens(ctx, userID)
+	if err != nil {
+		return "", nil, fmt.Errorf("create api token: %w", err)
+	}
+	if len(tokens) >= MaxTokensPerUser {
+		return "", nil, &ErrTooManyTokens{}
+	}
+
+	secret, err := crypto.NewRandomString(lenSecret)
+	if err != nil {
+		return "", nil, fmt.Errorf("generate api token: %w", err)
+	}
+	secret = Prefix + secret
+
+	token := &entity.Token{
+		UserID: userID,
+		Name:   name,
+		Scopes: scopes,
+	}
+	if lifetime > 0 {
+		expiresAt := a.now().Add(lifetime)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err = a.repo.AddToken(ctx, token, hashSecret(secret)); err != nil {
+		return "", nil, fmt.Errorf("create api token: %w", err)
+	}
+	return secret, token, nil
+}
+
+func (a *apiTokenCase) List(ctx context.Context, userID int) ([]entity.Token, error) {
+	tokens, err := a.repo.GetUserTokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list api tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+func (a *apiTokenCase) Revoke(ctx context.Context, userID, tokenID int) error {
+	if err := a.repo.DeleteToken(ctx, userID, tokenID); err != nil {
+		return fmt.Errorf("revoke api token: %w", err)
+	}
+	return nil
+}
+
+// Check returns the token with the secret if it is valid.
+func (a *apiTokenCase) Check(ctx context.Context, secret string) (*entity.Token, error) {
+	if !strings.HasPrefix(secret, Prefix) {
+		return nil, ErrInvalidToken
+	}
+
+	var notFound *repo.ErrTokenNotFound
+	token, err := a.repo.UseToken(ctx, hashSecret(secret))
+	if errors.As(err, &notFound) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("check api token: %w", err)
+	}
+	return token, nil
+}
+
+func normalizeScopes(scopes []string) ([]string, error) {
+	unique := make(map[string]struct{}, len(scopes))
+	unknown := []string{}
+	for _, scope := range scopes {
+		if !entity.IsValidScope(scope) {
+			unknown = append(unknown, scope)
+		}
+		unique[scope] = struct{}{}
+	}
+	if len(unknown) > 0 {
+		return nil, &ErrInvalidScopes{Scopes: unknown}
+	}
+	if len(unique) == 0 {
+		return nil, &ErrInvalidScopes{}
+	}
+
+	res := make([]string, 0, len(unique))
+	for scope := range unique {
+		res = append(res, scope)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// hashSecret uses a fast hash since the secrets are random and long enough.
+func hashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
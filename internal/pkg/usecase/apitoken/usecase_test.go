@@ -0,0 +1,87 @@
+package apitoken
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/apitoken"
+	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/apitoken"
+	repoMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/apitoken/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := repoMock.NewMockRepository(ctrl)
+	ac := New(log, repository)
+	now := time.Now()
+	ac.now = func() time.Time { return now }
+
+	var hash string
+	repository.EXPECT().GetUserTokens(ctx, 12).Return(nil, nil).Times(1)
+	repository.EXPECT().AddToken(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, token *entity.Token, h string) error {
+			require.Equal(t, []string{entity.ScopeBoardsRead, entity.ScopePinsWrite}, token.Scopes)
+			require.Equal(t, now.Add(time.Hour), *token.ExpiresAt)
+			hash = h
+			return nil
+		}).Times(1)
+
+	secret, _, err := ac.Create(ctx, 12, "script", []string{entity.ScopePinsWrite, entity.ScopeBoardsRead, entity.ScopePinsWrite}, time.Hour)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(secret, Prefix))
+	require.Equal(t, hashSecret(secret), hash)
+	require.NotContains(t, hash, secret)
+
+	_, _, err = ac.Create(ctx, 12, "script", []string{"pins:admin"}, 0)
+	require.ErrorAs(t, err, new(*ErrInvalidScopes))
+
+	_, _, err = ac.Create(ctx, 12, "script", nil, 0)
+	require.ErrorAs(t, err, new(*ErrInvalidScopes))
+
+	repository.EXPECT().GetUserTokens(ctx, 12).Return(make([]entity.Token, MaxTokensPerUser), nil).Times(1)
+	_, _, err = ac.Create(ctx, 12, "script", []string{entity.ScopePinsRead}, 0)
+	require.ErrorAs(t, err, new(*ErrTooManyTokens))
+}
+
+func TestCheck(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := repoMock.NewMockRepository(ctrl)
+	ac := New(log, repository)
+
+	token := &entity.Token{ID: 1, UserID: 12, Scopes: []string{entity.ScopePinsRead}}
+	repository.EXPECT().UseToken(ctx, hashSecret(Prefix+"secret")).Return(token, nil).Times(1)
+
+	actual, err := ac.Check(ctx, Prefix+"secret")
+	require.NoError(t, err)
+	require.Equal(t, token, actual)
+
+	repository.EXPECT().UseToken(ctx, hashSecret(Prefix+"revoked")).Return(nil, &repo.ErrTokenNotFound{}).Times(1)
+	_, err = ac.Check(ctx, Prefix+"revoked")
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = ac.Check(ctx, "session-key")
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
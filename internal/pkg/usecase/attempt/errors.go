@@ -0,0 +1,20 @@
+package attempt
+
+import (
+	"fmt"
+	"time"
+
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+)
+
+type ErrTooManyAttempts struct {
+	RetryAfter time.Duration
+}
+
+func (e *ErrTooManyAttempts) Error() string {
+	return fmt.Sprintf("too many failed login attempts, retry after %s", e.RetryAfter)
+}
+
+func (e *ErrTooManyAttempts) Type() errPkg.Type {
+	return errPkg.ErrTooManyRequests
+}
@@ -0,0 +1,153 @@
+package attempt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/attempt"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+const (
+	prefixUsername = "user:"
+	prefixIP       = "ip:"
+)
+
+// Policy describes how failed login attempts are throttled.
+type Policy struct {
+	// FreeAttempts is the number of failures that are not followed by a delay.
+	FreeAttempts int
+	// BaseDelay is the delay after the first failure over the free ones, it doubles with every next failure.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// LockoutAfter is the number of failures after which logins are locked for LockoutDuration.
+	LockoutAfter    int
+	LockoutDuration time.Duration
+	// Window is the time after the last failure when the failures are forgotten.
+	Window time.Duration
+}
+
+var (
+	DefaultUsernamePolicy = Policy{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        time.Minute,
+		LockoutAfter:    10,
+		LockoutDuration: 15 * time.Minute,
+		Window:          15 * time.Minute,
+	}
+	DefaultIPPolicy = Policy{
+		FreeAttempts:    20,
+		BaseDelay:       time.Second,
+		MaxDelay:        time.Minute,
+		LockoutAfter:    100,
+		LockoutDuration: time.Hour,
+		Window:          time.Hour,
+	}
+)
+
+// Delay returns the time for which logins are blocked after the failure with the given number.
+func (p Policy) Delay(failures int) time.Duration {
+	if failures >= p.LockoutAfter {
+		return p.LockoutDuration
+	}
+	if failures <= p.FreeAttempts {
+		return 0
+	}
+
+	shift := failures - p.FreeAttempts - 1
+	if shift > 30 || p.BaseDelay<<shift > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return p.BaseDelay << shift
+}
+
+//go:generate mockgen -destination=./mock/attempt_mock.go -package=mock -source=limiter.go Limiter
+type Limiter interface {
+	Check(ctx context.Context, username, ip string) error
+	AddFailure(ctx context.Context, username, ip string) error
+	Reset(ctx context.Context, username string) error
+}
+
+type limiter struct {
+	log            *logger.Logger
+	repo           repo.Repository
+	usernamePolicy Policy
+	ipPolicy       Policy
+}
+
+func New(log *logger.Logger, repo repo.Repository, opts ...Option) *limiter {
+	l := &limiter{
+		log:            log,
+		repo:           repo,
+		usernamePolicy: DefaultUsernamePolicy,
+		ipPolicy:       DefaultIPPolicy,
+	}
+	for _, opt := range opts {
+		opt.apply(l)
+	}
+	return l
+}
+
+// Check returns ErrTooManyAttempts if logins for the username or from the ip are blocked.
+func (l *limiter) Check(ctx context.Context, username, ip string) error {
+	blocked, err := l.repo.BlockedFor(ctx, subjects(username, ip)...)
+	if err != nil {
+		return fmt.Errorf("check login attempts: %w", err)
+	}
+	if blocked > 0 {
+		return &ErrTooManyAttempts{RetryAfter: blocked}
+	}
+	return nil
+}
+
+func (l *limiter) AddFailure(ctx context.Context, username, ip string) error {
+	if err := l.addFailure(ctx, usernameKey(username), l.usernamePolicy); err != nil {
+		return err
+	}
+	if ip == "" {
+		return nil
+	}
+	return l.addFailure(ctx, prefixIP+ip, l.ipPolicy)
+}
+
+// Reset forgets the failures for the username after a successful login. The failures from the ip
+// are kept, otherwise an attacker could reset them by logging into their own account.
+func (l *limiter) Reset(ctx context.Context, username string) error {
+	if err := l.repo.Reset(ctx, usernameKey(username)); err != nil {
+		return fmt.Errorf("reset login attempts: %w", err)
+	}
+	return nil
+}
+
+func (l *limiter) addFailure(ctx context.Context, key string, p Policy) error {
+	failures, err := l.repo.AddFailure(ctx, key, p.Window)
+	if err != nil {
+		return fmt.Errorf("add login failure: %w", err)
+	}
+
+	delay := p.Delay(failures)
+	if delay == 0 {
+		return nil
+	}
+	if failures >= p.LockoutAfter {
+		l.log.Warn("login is locked out", logger.F{"subject", key}, logger.F{"failures", failures})
+	}
+	if err = l.repo.Block(ctx, key, delay); err != nil {
+		return fmt.Errorf("add login failure: %w", err)
+	}
+	return nil
+}
+
+func usernameKey(username string) string {
+	return prefixUsername + strings.ToLower(username)
+}
+
+func subjects(username, ip string) []string {
+	if ip == "" {
+		return []string{usernameKey(username)}
+	}
+	return []string{usernameKey(username), prefixIP + ip}
+}
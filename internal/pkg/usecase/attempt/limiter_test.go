@@ -0,0 +1,107 @@
+package attempt
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/attempt/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyDelay(t *testing.T) {
+	p := Policy{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        10 * time.Second,
+		LockoutAfter:    10,
+		LockoutDuration: 15 * time.Minute,
+	}
+
+	tests := []struct {
+		name     string
+		failures int
+		expDelay time.Duration
+	}{
+		{"free attempt", 3, 0},
+		{"first delayed attempt", 4, time.Second},
+		{"delay doubles", 6, 4 * time.Second},
+		{"delay is capped", 9, 10 * time.Second},
+		{"lockout", 10, 15 * time.Minute},
+		{"after lockout", 100, 15 * time.Minute},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expDelay, p.Delay(test.failures))
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	l := New(log, repo)
+
+	repo.EXPECT().BlockedFor(ctx, "user:clicker123", "ip:127.0.0.1").Return(time.Duration(0), nil).Times(1)
+	require.NoError(t, l.Check(ctx, "Clicker123", "127.0.0.1"))
+
+	repo.EXPECT().BlockedFor(ctx, "user:clicker123", "ip:127.0.0.1").Return(3*time.Second, nil).Times(1)
+	err = l.Check(ctx, "clicker123", "127.0.0.1")
+	var tooMany *ErrTooManyAttempts
+	require.True(t, errors.As(err, &tooMany))
+	require.Equal(t, 3*time.Second, tooMany.RetryAfter)
+
+	repo.EXPECT().BlockedFor(ctx, "user:clicker123").Return(time.Duration(0), nil).Times(1)
+	require.NoError(t, l.Check(ctx, "clicker123", ""))
+}
+
+func TestAddFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	usernamePolicy := Policy{
+		FreeAttempts:    1,
+		BaseDelay:       time.Second,
+		MaxDelay:        time.Minute,
+		LockoutAfter:    5,
+		LockoutDuration: time.Hour,
+		Window:          time.Hour,
+	}
+	l := New(log, repo, UsernamePolicy(usernamePolicy))
+
+	repo.EXPECT().AddFailure(ctx, "user:clicker123", usernamePolicy.Window).Return(1, nil).Times(1)
+	repo.EXPECT().AddFailure(ctx, "ip:127.0.0.1", DefaultIPPolicy.Window).Return(1, nil).Times(1)
+	require.NoError(t, l.AddFailure(ctx, "clicker123", "127.0.0.1"))
+
+	repo.EXPECT().AddFailure(ctx, "user:clicker123", usernamePolicy.Window).Return(5, nil).Times(1)
+	repo.EXPECT().Block(ctx, "user:clicker123", usernamePolicy.LockoutDuration).Return(nil).Times(1)
+	require.NoError(t, l.AddFailure(ctx, "clicker123", ""))
+
+	expErr := errors.New("err")
+	repo.EXPECT().AddFailure(ctx, "user:clicker123", usernamePolicy.Window).Return(0, expErr).Times(1)
+	require.ErrorIs(t, l.AddFailure(ctx, "clicker123", "127.0.0.1"), expErr)
+
+	repo.EXPECT().Reset(ctx, "user:clicker123").Return(nil).Times(1)
+	require.NoError(t, l.Reset(ctx, "clicker123"))
+}
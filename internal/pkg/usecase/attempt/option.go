@@ -0,0 +1,25 @@
+package attempt
+
+type Option interface {
+	apply(*limiter)
+}
+
+type funcOption func(*limiter)
+
+func (f funcOption) apply(l *limiter) {
+	f(l)
+}
+
+// UsernamePolicy sets the policy for failed login attempts to the same account.
+func UsernamePolicy(p Policy) Option {
+	return funcOption(func(l *limiter) {
+		l.usernamePolicy = p
+	})
+}
+
+// IPPolicy sets the policy for failed login attempts from the same ip address.
+func IPPolicy(p Policy) Option {
+	return funcOption(func(l *limiter) {
+		l.ipPolicy = p
+	})
+}
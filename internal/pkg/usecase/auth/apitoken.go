@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	authProto "github.com/go-park-mail-ru/2023_2_OND_team/internal/api/auth"
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/apitoken"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+)
+
+// CreateAPIToken returns the secret of the new token, it can not be got later.
+// The token does not expire if the lifetime is zero.
+func (ac *authCase) CreateAPIToken(ctx context.Context, sess *session.Session, name string, scopes []string,
+	lifetime time.Duration) (string, *entity.Token, error) {
+	req := &authProto.CreateAPITokenRequest{
+		Session: convertToProto(sess),
+		Name:    name,
+		Scopes:  scopes,
+	}
+	if lifetime > 0 {
+		req.Lifetime = durationpb.New(lifetime)
+	}
+
+	token, err := ac.client.CreateAPIToken(ctx, req)
+	if err != nil {
+		return "", nil, convertAPITokenError(err, "create api token")
+	}
+	return token.Secret, convertAPIToken(token), nil
+}
+
+func (ac *authCase) ListAPITokens(ctx context.Context, sess *session.Session) ([]entity.Token, error) {
+	list, err := ac.client.ListAPITokens(ctx, convertToProto(sess))
+	if err != nil {
+		return nil, fmt.Errorf("list api tokens: %w", err)
+	}
+
+	tokens := make([]entity.Token, 0, len(list.Tokens))
+	for _, t := range list.Tokens {
+		tokens = append(tokens, *convertAPIToken(t))
+	}
+	return tokens, nil
+}
+
+func (ac *authCase) RevokeAPIToken(ctx context.Context, sess *session.Session, tokenID int) error {
+	_, err := ac.client.RevokeAPIToken(ctx, &authProto.RevokeAPITokenRequest{
+		Session: convertToProto(sess),
+		Id:      int64(tokenID),
+	})
+	if err != nil {
+		return convertAPITokenError(err, "revoke api token")
+	}
+	return nil
+}
+
+// CheckAPIToken returns the owner and the scopes of the token.
+func (ac *authCase) CheckAPIToken(ctx context.Context, secret string) (int, []string, error) {
+	owner, err := ac.client.CheckAPIToken(ctx, &authProto.APITokenSecret{Secret: secret})
+	if err != nil {
+		return 0, nil, convertAPITokenError(err, "check api token")
+	}
+	return int(owner.UserId), owner.Scopes, nil
+}
+
+func convertAPIToken(t *authProto.APIToken) *entity.Token {
+	token := &entity.Token{
+		ID:        int(t.Id),
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		CreatedAt: t.CreatedAt.AsTime(),
+	}
+	if t.LastUsedAt != nil {
+		lastUsedAt := t.LastUsedAt.AsTime()
+		token.LastUsedAt = &lastUsedAt
+	}
+	if t.ExpiresAt != nil {
+		expiresAt := t.ExpiresAt.AsTime()
+		token.ExpiresAt = &expiresAt
+	}
+	return token
+}
+
+func convertAPITokenError(err error, op string) error {
+	kinds := map[codes.Code]errPkg.Type{
+		codes.NotFound:          errPkg.ErrNotFound,
+		codes.Unauthenticated:   errPkg.ErrNoAuth,
+		codes.InvalidArgument:   errPkg.ErrInvalidInput,
+		codes.ResourceExhausted: errPkg.ErrNoAccess,
+	}
+	if kind, ok := kinds[status.Code(err)]; ok {
+		return &ErrAPIToken{Message: status.Convert(err).Message(), Kind: kind}
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"time"
+
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+)
+
+type ErrSessionNotFound struct{}
+
+func (e *ErrSessionNotFound) Error() string {
+	return "session not found"
+}
+
+func (e *ErrSessionNotFound) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
+
+type ErrWrongPassword struct{}
+
+func (e *ErrWrongPassword) Error() string {
+	return "wrong password"
+}
+
+func (e *ErrWrongPassword) Type() errPkg.Type {
+	return errPkg.ErrNoAccess
+}
+
+type ErrInvalidResetToken struct{}
+
+func (e *ErrInvalidResetToken) Error() string {
+	return "invalid or expired password reset token"
+}
+
+func (e *ErrInvalidResetToken) Type() errPkg.Type {
+	return errPkg.ErrInvalidInput
+}
+
+type ErrInvalidVerificationToken struct{}
+
+func (e *ErrInvalidVerificationToken) Error() string {
+	return "invalid or expired email verification token"
+}
+
+func (e *ErrInvalidVerificationToken) Type() errPkg.Type {
+	return errPkg.ErrInvalidInput
+}
+
+type ErrEmailAlreadyVerified struct{}
+
+func (e *ErrEmailAlreadyVerified) Error() string {
+	return "the email has already been verified"
+}
+
+func (e *ErrEmailAlreadyVerified) Type() errPkg.Type {
+	return errPkg.ErrAlreadyExists
+}
+
+type ErrTooManyAttempts struct {
+	RetryAfter time.Duration
+}
+
+func (e *ErrTooManyAttempts) Error() string {
+	return "too many failed login attempts, try again later"
+}
+
+func (e *ErrTooManyAttempts) Type() errPkg.Type {
+	return errPkg.ErrTooManyRequests
+}
+
+type ErrInvalidSecondFactor struct{}
+
+func (e *ErrInvalidSecondFactor) Error() string {
+	return "invalid second factor code or expired login attempt"
+}
+
+func (e *ErrInvalidSecondFactor) Type() errPkg.Type {
+	return errPkg.ErrNoAuth
+}
+
+type ErrInvalidTOTPCode struct{}
+
+func (e *ErrInvalidTOTPCode) Error() string {
+	return "invalid two-factor authentication code"
+}
+
+func (e *ErrInvalidTOTPCode) Type() errPkg.Type {
+	return errPkg.ErrInvalidInput
+}
+
+// ErrTwoFactorState is returned when the action does not match the current
+// two-factor authentication state, for example enrolling when it is already enabled.
+type ErrTwoFactorState struct {
+	Message string
+}
+
+func (e *ErrTwoFactorState) Error() string {
+	return e.Message
+}
+
+func (e *ErrTwoFactorState) Type() errPkg.Type {
+	return errPkg.ErrAlreadyExists
+}
+
+type ErrAccountNotRestorable struct{}
+
+func (e *ErrAccountNotRestorable) Error() string {
+	return "wrong credentials or the account can no longer be restored"
+}
+
+func (e *ErrAccountNotRestorable) Type() errPkg.Type {
+	return errPkg.ErrNoAuth
+}
+
+// ErrExternalLogin is returned when the login with the identity provider fails,
+// the message of the auth service explains the reason.
+type ErrExternalLogin struct {
+	Message string
+	Kind    errPkg.Type
+}
+
+func (e *ErrExternalLogin) Error() string {
+	return e.Message
+}
+
+func (e *ErrExternalLogin) Type() errPkg.Type {
+	return e.Kind
+}
+
+// ErrAPIToken is returned when an API token can not be created, revoked or used,
+// the message of the auth service explains the reason.
+type ErrAPIToken struct {
+	Message string
+	Kind    errPkg.Type
+}
+
+func (e *ErrAPIToken) Error() string {
+	return e.Message
+}
+
+func (e *ErrAPIToken) Type() errPkg.Type {
+	return e.Kind
+}
+
+type ErrAccountSuspended struct{}
+
+func (e *ErrAccountSuspended) Error() string {
+	return "the account is suspended"
+}
+
+func (e *ErrAccountSuspended) Type() errPkg.Type {
+	return errPkg.ErrNoAccess
+}
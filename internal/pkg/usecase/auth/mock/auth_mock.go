@@ -7,7 +7,9 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	apitoken "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/apitoken"
 	session "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
 	user "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	gomock "github.com/golang/mock/gomock"
@@ -36,13 +38,181 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ActivateTOTP mocks base method.
+func (m *MockUsecase) ActivateTOTP(ctx context.Context, sess *session.Session, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateTOTP", ctx, sess, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateTOTP indicates an expected call of ActivateTOTP.
+func (mr *MockUsecaseMockRecorder) ActivateTOTP(ctx, sess, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateTOTP", reflect.TypeOf((*MockUsecase)(nil).ActivateTOTP), ctx, sess, code)
+}
+
+// BeginOIDCLogin mocks base method.
+func (m *MockUsecase) BeginOIDCLogin(ctx context.Context, provider string, sess *session.Session) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginOIDCLogin", ctx, provider, sess)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BeginOIDCLogin indicates an expected call of BeginOIDCLogin.
+func (mr *MockUsecaseMockRecorder) BeginOIDCLogin(ctx, provider, sess interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginOIDCLogin", reflect.TypeOf((*MockUsecase)(nil).BeginOIDCLogin), ctx, provider, sess)
+}
+
+// ChangePassword mocks base method.
+func (m *MockUsecase) ChangePassword(ctx context.Context, sess *session.Session, oldPassword, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, sess, oldPassword, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUsecaseMockRecorder) ChangePassword(ctx, sess, oldPassword, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUsecase)(nil).ChangePassword), ctx, sess, oldPassword, newPassword)
+}
+
+// CheckAPIToken mocks base method.
+func (m *MockUsecase) CheckAPIToken(ctx context.Context, secret string) (int, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAPIToken", ctx, secret)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CheckAPIToken indicates an expected call of CheckAPIToken.
+func (mr *MockUsecaseMockRecorder) CheckAPIToken(ctx, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAPIToken", reflect.TypeOf((*MockUsecase)(nil).CheckAPIToken), ctx, secret)
+}
+
+// CompleteOIDCLogin mocks base method.
+func (m *MockUsecase) CompleteOIDCLogin(ctx context.Context, state, code string, current *session.Session, meta session.Metadata) (*session.Session, string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOIDCLogin", ctx, state, code, current, meta)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// CompleteOIDCLogin indicates an expected call of CompleteOIDCLogin.
+func (mr *MockUsecaseMockRecorder) CompleteOIDCLogin(ctx, state, code, current, meta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOIDCLogin", reflect.TypeOf((*MockUsecase)(nil).CompleteOIDCLogin), ctx, state, code, current, meta)
+}
+
+// ConfirmEmail mocks base method.
+func (m *MockUsecase) ConfirmEmail(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmail", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmEmail indicates an expected call of ConfirmEmail.
+func (mr *MockUsecaseMockRecorder) ConfirmEmail(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmail", reflect.TypeOf((*MockUsecase)(nil).ConfirmEmail), ctx, token)
+}
+
+// ConfirmPasswordReset mocks base method.
+func (m *MockUsecase) ConfirmPasswordReset(ctx context.Context, token, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPasswordReset", ctx, token, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmPasswordReset indicates an expected call of ConfirmPasswordReset.
+func (mr *MockUsecaseMockRecorder) ConfirmPasswordReset(ctx, token, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPasswordReset", reflect.TypeOf((*MockUsecase)(nil).ConfirmPasswordReset), ctx, token, newPassword)
+}
+
+// CreateAPIToken mocks base method.
+func (m *MockUsecase) CreateAPIToken(ctx context.Context, sess *session.Session, name string, scopes []string, lifetime time.Duration) (string, *apitoken.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIToken", ctx, sess, name, scopes, lifetime)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*apitoken.Token)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockUsecaseMockRecorder) CreateAPIToken(ctx, sess, name, scopes, lifetime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockUsecase)(nil).CreateAPIToken), ctx, sess, name, scopes, lifetime)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockUsecase) DeleteAccount(ctx context.Context, sess *session.Session, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, sess, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockUsecaseMockRecorder) DeleteAccount(ctx, sess, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockUsecase)(nil).DeleteAccount), ctx, sess, password)
+}
+
+// DisableTOTP mocks base method.
+func (m *MockUsecase) DisableTOTP(ctx context.Context, sess *session.Session, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", ctx, sess, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockUsecaseMockRecorder) DisableTOTP(ctx, sess, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockUsecase)(nil).DisableTOTP), ctx, sess, code)
+}
+
+// EnrollTOTP mocks base method.
+func (m *MockUsecase) EnrollTOTP(ctx context.Context, sess *session.Session) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTOTP", ctx, sess)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnrollTOTP indicates an expected call of EnrollTOTP.
+func (mr *MockUsecaseMockRecorder) EnrollTOTP(ctx, sess interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTOTP", reflect.TypeOf((*MockUsecase)(nil).EnrollTOTP), ctx, sess)
+}
+
 // GetUserIDBySession mocks base method.
-func (m *MockUsecase) GetUserIDBySession(ctx context.Context, sess *session.Session) (int, error) {
+func (m *MockUsecase) GetUserIDBySession(ctx context.Context, sess *session.Session) (int, string, time.Time, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUserIDBySession", ctx, sess)
 	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(time.Time)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
 }
 
 // GetUserIDBySession indicates an expected call of GetUserIDBySession.
@@ -51,19 +221,65 @@ func (mr *MockUsecaseMockRecorder) GetUserIDBySession(ctx, sess interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDBySession", reflect.TypeOf((*MockUsecase)(nil).GetUserIDBySession), ctx, sess)
 }
 
+// ListAPITokens mocks base method.
+func (m *MockUsecase) ListAPITokens(ctx context.Context, sess *session.Session) ([]apitoken.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPITokens", ctx, sess)
+	ret0, _ := ret[0].([]apitoken.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPITokens indicates an expected call of ListAPITokens.
+func (mr *MockUsecaseMockRecorder) ListAPITokens(ctx, sess interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokens", reflect.TypeOf((*MockUsecase)(nil).ListAPITokens), ctx, sess)
+}
+
+// ListSessions mocks base method.
+func (m *MockUsecase) ListSessions(ctx context.Context, sess *session.Session) ([]*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, sess)
+	ret0, _ := ret[0].([]*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockUsecaseMockRecorder) ListSessions(ctx, sess interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockUsecase)(nil).ListSessions), ctx, sess)
+}
+
 // Login mocks base method.
-func (m *MockUsecase) Login(ctx context.Context, username, password string) (*session.Session, error) {
+func (m *MockUsecase) Login(ctx context.Context, username, password string, meta session.Metadata) (*session.Session, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", ctx, username, password)
+	ret := m.ctrl.Call(m, "Login", ctx, username, password, meta)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockUsecaseMockRecorder) Login(ctx, username, password, meta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUsecase)(nil).Login), ctx, username, password, meta)
+}
+
+// LoginSecondFactor mocks base method.
+func (m *MockUsecase) LoginSecondFactor(ctx context.Context, token, code string, meta session.Metadata) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginSecondFactor", ctx, token, code, meta)
 	ret0, _ := ret[0].(*session.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Login indicates an expected call of Login.
-func (mr *MockUsecaseMockRecorder) Login(ctx, username, password interface{}) *gomock.Call {
+// LoginSecondFactor indicates an expected call of LoginSecondFactor.
+func (mr *MockUsecaseMockRecorder) LoginSecondFactor(ctx, token, code, meta interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUsecase)(nil).Login), ctx, username, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginSecondFactor", reflect.TypeOf((*MockUsecase)(nil).LoginSecondFactor), ctx, token, code, meta)
 }
 
 // Logout mocks base method.
@@ -80,6 +296,35 @@ func (mr *MockUsecaseMockRecorder) Logout(ctx, sess interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUsecase)(nil).Logout), ctx, sess)
 }
 
+// LogoutAll mocks base method.
+func (m *MockUsecase) LogoutAll(ctx context.Context, sess *session.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutAll", ctx, sess)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogoutAll indicates an expected call of LogoutAll.
+func (mr *MockUsecaseMockRecorder) LogoutAll(ctx, sess interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutAll", reflect.TypeOf((*MockUsecase)(nil).LogoutAll), ctx, sess)
+}
+
+// OIDCProviders mocks base method.
+func (m *MockUsecase) OIDCProviders(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OIDCProviders", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OIDCProviders indicates an expected call of OIDCProviders.
+func (mr *MockUsecaseMockRecorder) OIDCProviders(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OIDCProviders", reflect.TypeOf((*MockUsecase)(nil).OIDCProviders), ctx)
+}
+
 // Register mocks base method.
 func (m *MockUsecase) Register(ctx context.Context, user *user.User) error {
 	m.ctrl.T.Helper()
@@ -93,3 +338,75 @@ func (mr *MockUsecaseMockRecorder) Register(ctx, user interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUsecase)(nil).Register), ctx, user)
 }
+
+// RequestPasswordReset mocks base method.
+func (m *MockUsecase) RequestPasswordReset(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockUsecaseMockRecorder) RequestPasswordReset(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockUsecase)(nil).RequestPasswordReset), ctx, email)
+}
+
+// ResendEmailVerification mocks base method.
+func (m *MockUsecase) ResendEmailVerification(ctx context.Context, sess *session.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendEmailVerification", ctx, sess)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResendEmailVerification indicates an expected call of ResendEmailVerification.
+func (mr *MockUsecaseMockRecorder) ResendEmailVerification(ctx, sess interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendEmailVerification", reflect.TypeOf((*MockUsecase)(nil).ResendEmailVerification), ctx, sess)
+}
+
+// RestoreAccount mocks base method.
+func (m *MockUsecase) RestoreAccount(ctx context.Context, username, password string, meta session.Metadata) (*session.Session, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAccount", ctx, username, password, meta)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RestoreAccount indicates an expected call of RestoreAccount.
+func (mr *MockUsecaseMockRecorder) RestoreAccount(ctx, username, password, meta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAccount", reflect.TypeOf((*MockUsecase)(nil).RestoreAccount), ctx, username, password, meta)
+}
+
+// RevokeAPIToken mocks base method.
+func (m *MockUsecase) RevokeAPIToken(ctx context.Context, sess *session.Session, tokenID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIToken", ctx, sess, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIToken indicates an expected call of RevokeAPIToken.
+func (mr *MockUsecaseMockRecorder) RevokeAPIToken(ctx, sess, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIToken", reflect.TypeOf((*MockUsecase)(nil).RevokeAPIToken), ctx, sess, tokenID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockUsecase) RevokeSession(ctx context.Context, sess *session.Session, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, sess, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockUsecaseMockRecorder) RevokeSession(ctx, sess, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockUsecase)(nil).RevokeSession), ctx, sess, sessionID)
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authProto "github.com/go-park-mail-ru/2023_2_OND_team/internal/api/auth"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+)
+
+func (ac *authCase) OIDCProviders(ctx context.Context) ([]string, error) {
+	list, err := ac.client.OIDCProviders(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("get oidc providers: %w", err)
+	}
+	return list.Providers, nil
+}
+
+// BeginOIDCLogin returns the url of the provider to redirect the user to and the state of the flow,
+// if the session is not nil the identity is linked to its user instead of the login.
+func (ac *authCase) BeginOIDCLogin(ctx context.Context, provider string, sess *session.Session) (string, string, error) {
+	req := &authProto.OIDCBeginRequest{Provider: provider}
+	if sess != nil {
+		req.Session = convertToProto(sess)
+	}
+
+	authURL, err := ac.client.BeginOIDCLogin(ctx, req)
+	if err != nil {
+		return "", "", convertOIDCError(err, "begin oidc login")
+	}
+	return authURL.Url, authURL.State, nil
+}
+
+// CompleteOIDCLogin logs in like Login, when the identity has been linked neither session nor token is returned.
+// The current session of the browser, if any, is required to complete the link flow.
+func (ac *authCase) CompleteOIDCLogin(ctx context.Context, state, code string, current *session.Session,
+	meta session.Metadata) (*session.Session, string, bool, error) {
+	cb := &authProto.OIDCCallback{
+		State:     state,
+		Code:      code,
+		UserAgent: meta.UserAgent,
+		Ip:        meta.IP,
+	}
+	if current != nil {
+		cb.Session = convertToProto(current)
+	}
+
+	resp, err := ac.client.CompleteOIDCLogin(ctx, cb)
+	if err != nil {
+		return nil, "", false, convertOIDCError(err, "complete oidc login")
+	}
+	if resp.Linked {
+		return nil, "", true, nil
+	}
+
+	sess, secondFactorToken := convertLoginResponse(resp.Login)
+	return sess, secondFactorToken, false, nil
+}
+
+func convertOIDCError(err error, op string) error {
+	kinds := map[codes.Code]errPkg.Type{
+		codes.NotFound:         errPkg.ErrNotFound,
+		codes.Unauthenticated:  errPkg.ErrNoAuth,
+		codes.PermissionDenied: errPkg.ErrNoAccess,
+		codes.AlreadyExists:    errPkg.ErrAlreadyExists,
+	}
+	if kind, ok := kinds[status.Code(err)]; ok {
+		return &ErrExternalLogin{Message: status.Convert(err).Message(), Kind: kind}
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
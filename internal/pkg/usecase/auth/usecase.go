@@ -3,19 +3,46 @@ package auth
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	authProto "github.com/go-park-mail-ru/2023_2_OND_team/internal/api/auth"
+	tokenEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/apitoken"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 //go:generate mockgen -destination=./mock/auth_mock.go -package=mock -source=usecase.go Usecase
 type Usecase interface {
 	Register(ctx context.Context, user *entity.User) error
-	Login(ctx context.Context, username, password string) (*session.Session, error)
-	GetUserIDBySession(ctx context.Context, sess *session.Session) (int, error)
+	Login(ctx context.Context, username, password string, meta session.Metadata) (sess *session.Session, secondFactorToken string, err error)
+	LoginSecondFactor(ctx context.Context, token, code string, meta session.Metadata) (*session.Session, error)
+	GetUserIDBySession(ctx context.Context, sess *session.Session) (userID int, role string, expire time.Time, err error)
 	Logout(ctx context.Context, sess *session.Session) error
+	LogoutAll(ctx context.Context, sess *session.Session) error
+	ListSessions(ctx context.Context, sess *session.Session) ([]*session.Session, error)
+	RevokeSession(ctx context.Context, sess *session.Session, sessionID string) error
+	ChangePassword(ctx context.Context, sess *session.Session, oldPassword, newPassword string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, token, newPassword string) error
+	ResendEmailVerification(ctx context.Context, sess *session.Session) error
+	ConfirmEmail(ctx context.Context, token string) error
+	EnrollTOTP(ctx context.Context, sess *session.Session) (secret, uri string, err error)
+	ActivateTOTP(ctx context.Context, sess *session.Session, code string) (recoveryCodes []string, err error)
+	DisableTOTP(ctx context.Context, sess *session.Session, code string) error
+	DeleteAccount(ctx context.Context, sess *session.Session, password string) error
+	RestoreAccount(ctx context.Context, username, password string, meta session.Metadata) (sess *session.Session, secondFactorToken string, err error)
+	OIDCProviders(ctx context.Context) ([]string, error)
+	BeginOIDCLogin(ctx context.Context, provider string, sess *session.Session) (authURL, state string, err error)
+	CompleteOIDCLogin(ctx context.Context, state, code string, current *session.Session, meta session.Metadata) (sess *session.Session, secondFactorToken string, linked bool, err error)
+	CreateAPIToken(ctx context.Context, sess *session.Session, name string, scopes []string, lifetime time.Duration) (secret string, token *tokenEntity.Token, err error)
+	ListAPITokens(ctx context.Context, sess *session.Session) ([]tokenEntity.Token, error)
+	RevokeAPIToken(ctx context.Context, sess *session.Session, tokenID int) error
+	CheckAPIToken(ctx context.Context, secret string) (userID int, scopes []string, err error)
 }
 
 type authCase struct {
@@ -41,40 +68,271 @@ func (ac *authCase) Register(ctx context.Context, user *entity.User) error {
 }
 
 func (ac *authCase) Logout(ctx context.Context, sess *session.Session) error {
-	_, err := ac.client.Logout(ctx, &authProto.Session{
-		Key:    sess.Key,
-		UserID: int64(sess.UserID),
-		Expire: timestamppb.New(sess.Expire),
-	})
+	_, err := ac.client.Logout(ctx, convertToProto(sess))
 	if err != nil {
 		return fmt.Errorf("logout: %w", err)
 	}
 	return nil
 }
 
-func (ac *authCase) Login(ctx context.Context, username, password string) (*session.Session, error) {
-	sess, err := ac.client.Login(ctx, &authProto.Credentials{
-		Username: username,
+func (ac *authCase) LogoutAll(ctx context.Context, sess *session.Session) error {
+	_, err := ac.client.LogoutAll(ctx, convertToProto(sess))
+	if err != nil {
+		return fmt.Errorf("logout all: %w", err)
+	}
+	return nil
+}
+
+func (ac *authCase) ListSessions(ctx context.Context, sess *session.Session) ([]*session.Session, error) {
+	list, err := ac.client.ListSessions(ctx, convertToProto(sess))
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+
+	sessions := make([]*session.Session, 0, len(list.Sessions))
+	for _, s := range list.Sessions {
+		sessions = append(sessions, convertFromProto(s))
+	}
+	return sessions, nil
+}
+
+func (ac *authCase) RevokeSession(ctx context.Context, sess *session.Session, sessionID string) error {
+	_, err := ac.client.RevokeSession(ctx, &authProto.RevokeSessionRequest{
+		Current: convertToProto(sess),
+		Id:      sessionID,
+	})
+	if status.Code(err) == codes.NotFound {
+		return &ErrSessionNotFound{}
+	}
+	if err != nil {
+		return fmt.Errorf("revoke session: %w", err)
+	}
+	return nil
+}
+
+func (ac *authCase) ChangePassword(ctx context.Context, sess *session.Session, oldPassword, newPassword string) error {
+	_, err := ac.client.ChangePassword(ctx, &authProto.ChangePasswordRequest{
+		Session:     convertToProto(sess),
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	})
+	if status.Code(err) == codes.PermissionDenied {
+		return &ErrWrongPassword{}
+	}
+	if err != nil {
+		return fmt.Errorf("change password: %w", err)
+	}
+	return nil
+}
+
+func (ac *authCase) RequestPasswordReset(ctx context.Context, email string) error {
+	_, err := ac.client.RequestPasswordReset(ctx, &authProto.PasswordResetRequest{Email: email})
+	if err != nil {
+		return fmt.Errorf("request password reset: %w", err)
+	}
+	return nil
+}
+
+func (ac *authCase) ConfirmPasswordReset(ctx context.Context, token, newPassword string) error {
+	_, err := ac.client.ConfirmPasswordReset(ctx, &authProto.PasswordResetConfirm{
+		Token:       token,
+		NewPassword: newPassword,
+	})
+	if status.Code(err) == codes.InvalidArgument {
+		return &ErrInvalidResetToken{}
+	}
+	if err != nil {
+		return fmt.Errorf("confirm password reset: %w", err)
+	}
+	return nil
+}
+
+func (ac *authCase) ResendEmailVerification(ctx context.Context, sess *session.Session) error {
+	_, err := ac.client.ResendEmailVerification(ctx, convertToProto(sess))
+	if status.Code(err) == codes.AlreadyExists {
+		return &ErrEmailAlreadyVerified{}
+	}
+	if err != nil {
+		return fmt.Errorf("resend email verification: %w", err)
+	}
+	return nil
+}
+
+func (ac *authCase) ConfirmEmail(ctx context.Context, token string) error {
+	_, err := ac.client.ConfirmEmail(ctx, &authProto.EmailVerificationToken{Token: token})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.InvalidArgument:
+		return &ErrInvalidVerificationToken{}
+	case codes.AlreadyExists:
+		return &ErrEmailAlreadyVerified{}
+	}
+	return fmt.Errorf("confirm email: %w", err)
+}
+
+// Login returns either a new session or, if the user has enabled two-factor
+// authentication, the token to be passed with the code to LoginSecondFactor.
+func (ac *authCase) Login(ctx context.Context, username, password string, meta session.Metadata) (*session.Session, string, error) {
+	resp, err := ac.client.Login(ctx, &authProto.Credentials{
+		Username:  username,
+		Password:  password,
+		UserAgent: meta.UserAgent,
+		Ip:        meta.IP,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.ResourceExhausted:
+		return nil, "", &ErrTooManyAttempts{RetryAfter: retryDelay(err)}
+	case codes.PermissionDenied:
+		return nil, "", &ErrAccountSuspended{}
+	default:
+		return nil, "", fmt.Errorf("login: %w", err)
+	}
+	sess, secondFactorToken := convertLoginResponse(resp)
+	return sess, secondFactorToken, nil
+}
+
+func (ac *authCase) LoginSecondFactor(ctx context.Context, token, code string, meta session.Metadata) (*session.Session, error) {
+	sess, err := ac.client.LoginSecondFactor(ctx, &authProto.SecondFactor{
+		Token:     token,
+		Code:      code,
+		UserAgent: meta.UserAgent,
+		Ip:        meta.IP,
+	})
+	if status.Code(err) == codes.Unauthenticated {
+		return nil, &ErrInvalidSecondFactor{}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("login second factor: %w", err)
+	}
+	return convertFromProto(sess), nil
+}
+
+// GetUserIDBySession returns the owner of the session with the global role and the refreshed session expiration time.
+func (ac *authCase) GetUserIDBySession(ctx context.Context, sess *session.Session) (int, string, time.Time, error) {
+	userID, err := ac.client.GetUserID(ctx, convertToProto(sess))
+	if err != nil {
+		return 0, "", time.Time{}, fmt.Errorf("get user id by session: %w", err)
+	}
+	return int(userID.Id), userID.Role, userID.Expire.AsTime(), nil
+}
+
+func (ac *authCase) EnrollTOTP(ctx context.Context, sess *session.Session) (string, string, error) {
+	enrollment, err := ac.client.EnrollTOTP(ctx, convertToProto(sess))
+	if err != nil {
+		return "", "", convertTwoFactorError(err, "enroll totp")
+	}
+	return enrollment.Secret, enrollment.Uri, nil
+}
+
+func (ac *authCase) ActivateTOTP(ctx context.Context, sess *session.Session, code string) ([]string, error) {
+	recoveryCodes, err := ac.client.ActivateTOTP(ctx, &authProto.TOTPCode{
+		Session: convertToProto(sess),
+		Code:    code,
+	})
+	if err != nil {
+		return nil, convertTwoFactorError(err, "activate totp")
+	}
+	return recoveryCodes.Codes, nil
+}
+
+func (ac *authCase) DisableTOTP(ctx context.Context, sess *session.Session, code string) error {
+	_, err := ac.client.DisableTOTP(ctx, &authProto.TOTPCode{
+		Session: convertToProto(sess),
+		Code:    code,
+	})
+	if err != nil {
+		return convertTwoFactorError(err, "disable totp")
+	}
+	return nil
+}
+
+func (ac *authCase) DeleteAccount(ctx context.Context, sess *session.Session, password string) error {
+	_, err := ac.client.DeleteAccount(ctx, &authProto.DeleteAccountRequest{
+		Session:  convertToProto(sess),
 		Password: password,
 	})
+	if status.Code(err) == codes.PermissionDenied {
+		return &ErrWrongPassword{}
+	}
 	if err != nil {
-		return nil, fmt.Errorf("login: %w", err)
+		return fmt.Errorf("delete account: %w", err)
+	}
+	return nil
+}
+
+// RestoreAccount undoes the deletion of the account during the grace period and logs in like Login.
+func (ac *authCase) RestoreAccount(ctx context.Context, username, password string, meta session.Metadata) (*session.Session, string, error) {
+	resp, err := ac.client.RestoreAccount(ctx, &authProto.Credentials{
+		Username:  username,
+		Password:  password,
+		UserAgent: meta.UserAgent,
+		Ip:        meta.IP,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.ResourceExhausted:
+		return nil, "", &ErrTooManyAttempts{RetryAfter: retryDelay(err)}
+	case codes.Unauthenticated:
+		return nil, "", &ErrAccountNotRestorable{}
+	case codes.PermissionDenied:
+		return nil, "", &ErrAccountSuspended{}
+	default:
+		return nil, "", fmt.Errorf("restore account: %w", err)
+	}
+	sess, secondFactorToken := convertLoginResponse(resp)
+	return sess, secondFactorToken, nil
+}
+
+func convertLoginResponse(resp *authProto.LoginResponse) (*session.Session, string) {
+	if resp.SecondFactorToken != "" {
+		return nil, resp.SecondFactorToken
+	}
+	return convertFromProto(resp.Session), ""
+}
+
+func convertTwoFactorError(err error, op string) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return &ErrInvalidTOTPCode{}
+	case codes.FailedPrecondition, codes.AlreadyExists:
+		return &ErrTwoFactorState{Message: status.Convert(err).Message()}
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
+
+// retryDelay extracts the delay from the RetryInfo details of the status, zero if there is none.
+func retryDelay(err error) time.Duration {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return info.RetryDelay.AsDuration()
+		}
 	}
+	return 0
+}
+
+func convertToProto(sess *session.Session) *authProto.Session {
+	return &authProto.Session{
+		Key:       sess.Key,
+		UserID:    int64(sess.UserID),
+		Expire:    timestamppb.New(sess.Expire),
+		UserAgent: sess.UserAgent,
+		Ip:        sess.IP,
+	}
+}
+
+func convertFromProto(sess *authProto.Session) *session.Session {
 	return &session.Session{
+		ID:     sess.Id,
 		Key:    sess.Key,
 		UserID: int(sess.UserID),
 		Expire: sess.Expire.AsTime(),
-	}, nil
-}
-
-func (ac *authCase) GetUserIDBySession(ctx context.Context, sess *session.Session) (int, error) {
-	userID, err := ac.client.GetUserID(ctx, &authProto.Session{
-		Key:    sess.Key,
-		UserID: int64(sess.UserID),
-		Expire: timestamppb.New(sess.Expire),
-	})
-	if err != nil {
-		return 0, fmt.Errorf("get user id by session: %w", err)
+		Metadata: session.Metadata{
+			UserAgent: sess.UserAgent,
+			IP:        sess.Ip,
+			CreatedAt: sess.CreatedAt.AsTime(),
+			LastSeen:  sess.LastSeen.AsTime(),
+		},
 	}
-	return int(userID.Id), nil
 }
@@ -0,0 +1,23 @@
+package block
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrSelfBlock struct{}
+
+func (e *ErrSelfBlock) Error() string {
+	return "can't block yourself"
+}
+
+func (e *ErrSelfBlock) Type() errPkg.Type {
+	return errPkg.ErrInvalidInput
+}
+
+type ErrBlocked struct{}
+
+func (e *ErrBlocked) Error() string {
+	return "the action is not available because of the block between the users"
+}
+
+func (e *ErrBlocked) Type() errPkg.Type {
+	return errPkg.ErrNoAccess
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// BlockUser mocks base method.
+func (m *MockUsecase) BlockUser(ctx context.Context, blockerID, blockedID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUser", ctx, blockerID, blockedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockUsecaseMockRecorder) BlockUser(ctx, blockerID, blockedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockUsecase)(nil).BlockUser), ctx, blockerID, blockedID)
+}
+
+// CheckBlocked mocks base method.
+func (m *MockUsecase) CheckBlocked(ctx context.Context, userID1, userID2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBlocked", ctx, userID1, userID2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckBlocked indicates an expected call of CheckBlocked.
+func (mr *MockUsecaseMockRecorder) CheckBlocked(ctx, userID1, userID2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBlocked", reflect.TypeOf((*MockUsecase)(nil).CheckBlocked), ctx, userID1, userID2)
+}
+
+// GetBlockedUsers mocks base method.
+func (m *MockUsecase) GetBlockedUsers(ctx context.Context, blockerID, lastID, count int) ([]user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUsers", ctx, blockerID, lastID, count)
+	ret0, _ := ret[0].([]user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockUsecaseMockRecorder) GetBlockedUsers(ctx, blockerID, lastID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockUsecase)(nil).GetBlockedUsers), ctx, blockerID, lastID, count)
+}
+
+// UnblockUser mocks base method.
+func (m *MockUsecase) UnblockUser(ctx context.Context, blockerID, blockedID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", ctx, blockerID, blockedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockUsecaseMockRecorder) UnblockUser(ctx, blockerID, blockedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockUsecase)(nil).UnblockUser), ctx, blockerID, blockedID)
+}
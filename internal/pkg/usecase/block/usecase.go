@@ -0,0 +1,106 @@
+package block
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	blockRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/block"
+	uRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+//go:generate mockgen -destination=./mock/block_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	BlockUser(ctx context.Context, blockerID, blockedID int) error
+	UnblockUser(ctx context.Context, blockerID, blockedID int) error
+	GetBlockedUsers(ctx context.Context, blockerID, lastID, count int) ([]user.User, error)
+	CheckBlocked(ctx context.Context, userID1, userID2 int) error
+}
+
+type recommendationInvalidator interface {
+	InvalidateRecommendations(ctx context.Context, userID int) error
+}
+
+type timelineInvalidator interface {
+	InvalidateTimeline(ctx context.Context, userID int) error
+}
+
+type blockCase struct {
+	log       *logger.Logger
+	repo      blockRepo.Repository
+	userRepo  uRepo.Repository
+	recs      recommendationInvalidator
+	timelines timelineInvalidator
+	sanitizer *bluemonday.Policy
+}
+
+func New(log *logger.Logger, repo blockRepo.Repository, userRepo uRepo.Repository,
+	recs recommendationInvalidator, timelines timelineInvalidator, sanitizer *bluemonday.Policy) *blockCase {
+
+	return &blockCase{
+		log:       log,
+		repo:      repo,
+		userRepo:  userRepo,
+		recs:      recs,
+		timelines: timelines,
+		sanitizer: sanitizer,
+	}
+}
+
+func (b *blockCase) BlockUser(ctx context.Context, blockerID, blockedID int) error {
+	if blockerID == blockedID {
+		return &ErrSelfBlock{}
+	}
+
+	if err := b.userRepo.CheckUserExistence(ctx, blockedID); err != nil {
+		return err
+	}
+
+	if err := b.repo.BlockUser(ctx, blockerID, blockedID); err != nil {
+		return fmt.Errorf("block user: %w", err)
+	}
+
+	// the block removes the users from the recommendations and the subscriptions of each other
+	for _, userID := range []int{blockerID, blockedID} {
+		if err := b.recs.InvalidateRecommendations(ctx, userID); err != nil {
+			b.log.Warn(err.Error())
+		}
+		if err := b.timelines.InvalidateTimeline(ctx, userID); err != nil {
+			b.log.Warn(err.Error())
+		}
+	}
+	return nil
+}
+
+func (b *blockCase) UnblockUser(ctx context.Context, blockerID, blockedID int) error {
+	if err := b.repo.UnblockUser(ctx, blockerID, blockedID); err != nil {
+		return fmt.Errorf("unblock user: %w", err)
+	}
+	return nil
+}
+
+func (b *blockCase) GetBlockedUsers(ctx context.Context, blockerID, lastID, count int) ([]user.User, error) {
+	users, err := b.repo.GetBlockedUsers(ctx, blockerID, lastID, count)
+	if err != nil {
+		return nil, fmt.Errorf("get blocked users: %w", err)
+	}
+
+	for i := range users {
+		users[i].Username = b.sanitizer.Sanitize(users[i].Username)
+	}
+	return users, nil
+}
+
+// CheckBlocked returns ErrBlocked if any of the users has blocked the other one.
+func (b *blockCase) CheckBlocked(ctx context.Context, userID1, userID2 int) error {
+	blocked, err := b.repo.IsBlockedBetween(ctx, userID1, userID2)
+	if err != nil {
+		return fmt.Errorf("check block between users: %w", err)
+	}
+	if blocked {
+		return &ErrBlocked{}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/require"
+
+	blockRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/block"
+	blockMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/block/mock"
+	uRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	userMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user/mock"
+	recMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/recommendation/mock"
+	timelineMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/timeline/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+func TestBlockUser(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := blockMock.NewMockRepository(ctrl)
+	userRepository := userMock.NewMockRepository(ctrl)
+	recommendations := recMock.NewMockUsecase(ctrl)
+	timelines := timelineMock.NewMockUsecase(ctrl)
+	bc := New(log, repository, userRepository, recommendations, timelines, bluemonday.UGCPolicy())
+
+	err = bc.BlockUser(ctx, 1, 1)
+	require.ErrorAs(t, err, new(*ErrSelfBlock))
+
+	userRepository.EXPECT().CheckUserExistence(ctx, 404).Return(&uRepo.ErrNonExistingUser{}).Times(1)
+	err = bc.BlockUser(ctx, 1, 404)
+	require.ErrorAs(t, err, new(*uRepo.ErrNonExistingUser))
+
+	userRepository.EXPECT().CheckUserExistence(ctx, 2).Return(nil).Times(2)
+	repository.EXPECT().BlockUser(ctx, 1, 2).Return(nil).Times(1)
+	recommendations.EXPECT().InvalidateRecommendations(ctx, 1).Return(nil).Times(1)
+	recommendations.EXPECT().InvalidateRecommendations(ctx, 2).Return(nil).Times(1)
+	timelines.EXPECT().InvalidateTimeline(ctx, 1).Return(nil).Times(1)
+	timelines.EXPECT().InvalidateTimeline(ctx, 2).Return(nil).Times(1)
+	err = bc.BlockUser(ctx, 1, 2)
+	require.NoError(t, err)
+
+	repository.EXPECT().BlockUser(ctx, 1, 2).Return(&blockRepo.ErrAlreadyBlocked{}).Times(1)
+	err = bc.BlockUser(ctx, 1, 2)
+	require.ErrorAs(t, err, new(*blockRepo.ErrAlreadyBlocked))
+}
+
+func TestCheckBlocked(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := blockMock.NewMockRepository(ctrl)
+	bc := New(log, repository, userMock.NewMockRepository(ctrl), recMock.NewMockUsecase(ctrl), timelineMock.NewMockUsecase(ctrl), bluemonday.UGCPolicy())
+
+	repository.EXPECT().IsBlockedBetween(ctx, 1, 2).Return(false, nil).Times(1)
+	require.NoError(t, bc.CheckBlocked(ctx, 1, 2))
+
+	repository.EXPECT().IsBlockedBetween(ctx, 2, 1).Return(true, nil).Times(1)
+	err = bc.CheckBlocked(ctx, 2, 1)
+	require.ErrorAs(t, err, new(*ErrBlocked))
+}
@@ -8,4 +8,8 @@ var (
 	ErrNoPinOnBoard    = errors.New("no such pin on board")
 	ErrInvalidUserID   = errors.New("invalid user id has been provided")
 	ErrNoAccess        = errors.New("no access for this action")
+	ErrAlreadyFollowed = errors.New("board is already followed")
+	ErrNotFollowed     = errors.New("board is not followed")
+	ErrPinNotSavable   = errors.New("pin is not available for saving")
+	ErrPinAlreadySaved = errors.New("pin has already been saved on the board")
 )
@@ -26,6 +26,16 @@ func (bCase *boardUsecase) GetBoardsByUsername(ctx context.Context, username str
 		isAuthor = true
 	}
 
+	if !isAuthor {
+		hasAccess, err := bCase.userRepo.CheckProfileAccess(ctx, userID, currUserID)
+		if err != nil {
+			return nil, fmt.Errorf("check profile access in get boards by username usecase: %w", err)
+		}
+		if !hasAccess {
+			return []entity.BoardWithContent{}, nil
+		}
+	}
+
 	contributorBoardsIDs, err := bCase.boardRepo.GetContributorBoardsIDs(ctx, currUserID)
 	if err != nil {
 		return nil, fmt.Errorf("get contributor boards in get boards by username usecase: %w", err)
@@ -70,6 +80,16 @@ func (bCase *boardUsecase) GetCertainBoard(ctx context.Context, boardID int) (en
 		hasAccess = true
 	}
 
+	if !hasAccess {
+		profileAccess, err := bCase.userRepo.CheckProfileAccess(ctx, boardAuthorID, currUserID)
+		if err != nil {
+			return entity.BoardWithContent{}, "", fmt.Errorf("check profile access in get certain board: %w", err)
+		}
+		if !profileAccess {
+			return entity.BoardWithContent{}, "", ErrNoSuchBoard
+		}
+	}
+
 	board, username, err := bCase.boardRepo.GetBoardByID(ctx, boardID, hasAccess)
 	if err != nil {
 		switch err {
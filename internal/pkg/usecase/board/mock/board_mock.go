@@ -154,6 +154,49 @@ func (mr *MockUsecaseMockRecorder) GetCertainBoard(ctx, boardID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertainBoard", reflect.TypeOf((*MockUsecase)(nil).GetCertainBoard), ctx, boardID)
 }
 
+// RepinOnBoard mocks base method.
+func (m *MockUsecase) RepinOnBoard(ctx context.Context, boardID, pinID, userID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepinOnBoard", ctx, boardID, pinID, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepinOnBoard indicates an expected call of RepinOnBoard.
+func (mr *MockUsecaseMockRecorder) RepinOnBoard(ctx, boardID, pinID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepinOnBoard", reflect.TypeOf((*MockUsecase)(nil).RepinOnBoard), ctx, boardID, pinID, userID)
+}
+
+// SubscribeToBoard mocks base method.
+func (m *MockUsecase) SubscribeToBoard(ctx context.Context, boardID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToBoard", ctx, boardID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeToBoard indicates an expected call of SubscribeToBoard.
+func (mr *MockUsecaseMockRecorder) SubscribeToBoard(ctx, boardID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToBoard", reflect.TypeOf((*MockUsecase)(nil).SubscribeToBoard), ctx, boardID, userID)
+}
+
+// UnsubscribeFromBoard mocks base method.
+func (m *MockUsecase) UnsubscribeFromBoard(ctx context.Context, boardID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeFromBoard", ctx, boardID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsubscribeFromBoard indicates an expected call of UnsubscribeFromBoard.
+func (mr *MockUsecaseMockRecorder) UnsubscribeFromBoard(ctx, boardID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeFromBoard", reflect.TypeOf((*MockUsecase)(nil).UnsubscribeFromBoard), ctx, boardID, userID)
+}
+
 // UpdateBoardInfo mocks base method.
 func (m *MockUsecase) UpdateBoardInfo(ctx context.Context, updatedBoard board.Board, tagTitles []string) error {
 	m.ctrl.T.Helper()
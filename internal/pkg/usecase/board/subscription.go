@@ -0,0 +1,87 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
+	repoBoard "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/board"
+)
+
+// SubscribeToBoard makes the user a follower of the board, the new pins of the board get into the feed
+// of its followers. The private board can be followed only by its author and contributors.
+func (b *boardUsecase) SubscribeToBoard(ctx context.Context, boardID, userID int) error {
+	if _, err := b.boardRepo.GetBoardAuthorByBoardID(ctx, boardID); err != nil {
+		switch err {
+		case repository.ErrNoData:
+			return ErrNoSuchBoard
+		default:
+			return fmt.Errorf("get board author for subscribe to board: %w", err)
+		}
+	}
+
+	protection, err := b.boardRepo.GetProtectionStatusBoard(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("get protection status for subscribe to board: %w", err)
+	}
+	if protection != repoBoard.ProtectionPublic {
+		role, err := b.boardRepo.RoleUserHaveOnThisBoard(ctx, boardID, userID)
+		if err != nil {
+			return fmt.Errorf("get user role for subscribe to board: %w", err)
+		}
+		if role&(repoBoard.Author|repoBoard.ContributorForAdding|repoBoard.ContributorForReading) == 0 {
+			return ErrNoSuchBoard
+		}
+	}
+
+	if err = b.boardRepo.SubscribeToBoard(ctx, userID, boardID); err != nil {
+		if errors.Is(err, repository.ErrNoDataAffected) {
+			return ErrAlreadyFollowed
+		}
+		return fmt.Errorf("subscribe to board: %w", err)
+	}
+	b.invalidateTimeline(ctx, userID)
+	return nil
+}
+
+func (b *boardUsecase) UnsubscribeFromBoard(ctx context.Context, boardID, userID int) error {
+	if err := b.boardRepo.UnsubscribeFromBoard(ctx, userID, boardID); err != nil {
+		if errors.Is(err, repository.ErrNoDataAffected) {
+			return ErrNotFollowed
+		}
+		return fmt.Errorf("unsubscribe from board: %w", err)
+	}
+	b.invalidateTimeline(ctx, userID)
+	return nil
+}
+
+// invalidateTimeline drops the following feed timeline of the user whose subscriptions have changed,
+// the failure isn't critical, the timeline expires anyway.
+func (b *boardUsecase) invalidateTimeline(ctx context.Context, userID int) {
+	if !b.timelineIsEnable {
+		return
+	}
+	if err := b.timelineCase.InvalidateTimeline(ctx, userID); err != nil {
+		b.log.Warn(err.Error())
+	}
+}
+
+func (b *boardUsecase) notifyNewPins(boardID int) {
+	ctx, cancel := context.WithTimeout(context.Background(), _timeoutNotification)
+	defer cancel()
+
+	if err := b.notifyCase.NotifyNewPinsOnBoard(ctx, boardID); err != nil {
+		b.log.Warn(err.Error())
+	}
+}
+
+// pushToTimelines fans the saved copy of the pin out to the timelines of the followers of the user who saved it.
+func (b *boardUsecase) pushToTimelines(authorID, pinID int) {
+	ctx, cancel := context.WithTimeout(context.Background(), _timeoutTimeline)
+	defer cancel()
+
+	if err := b.timelineCase.PushPin(ctx, authorID, pinID); err != nil {
+		b.log.Warn(err.Error())
+	}
+}
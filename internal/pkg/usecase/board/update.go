@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	entityAnalytics "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/board"
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/board"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
@@ -84,6 +85,44 @@ func (bCase *boardUsecase) UpdateBoardInfo(ctx context.Context, updatedBoard ent
 	return nil
 }
 
+// RepinOnBoard saves someone else's pin on the board of the user and returns the id of the saved copy.
+func (bCase *boardUsecase) RepinOnBoard(ctx context.Context, boardID, pinID, userID int) (int, error) {
+	if err := bCase.verifier.CheckEmailVerified(ctx, userID); err != nil {
+		return 0, fmt.Errorf("repin on board: %w", err)
+	}
+
+	role, err := bCase.boardRepo.RoleUserHaveOnThisBoard(ctx, boardID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("get role for repin: %w", err)
+	}
+	if role&(repoBoard.Author|repoBoard.ContributorForAdding) == 0 {
+		return 0, ErrNoAccess
+	}
+
+	newPinID, public, err := bCase.boardRepo.RepinOnBoard(ctx, boardID, pinID, userID)
+	if err != nil {
+		switch err {
+		case repository.ErrNoData:
+			return 0, ErrPinNotSavable
+		case repository.ErrNoDataAffected:
+			return 0, ErrPinAlreadySaved
+		default:
+			return 0, fmt.Errorf("repin on board: %w", err)
+		}
+	}
+
+	if bCase.trackIsEnable {
+		bCase.analyticsCase.Track(pinID, entityAnalytics.EventSave)
+	}
+	if bCase.notifyIsEnable {
+		go bCase.notifyNewPins(boardID)
+	}
+	if public && bCase.timelineIsEnable {
+		go bCase.pushToTimelines(userID, newPinID)
+	}
+	return newPinID, nil
+}
+
 func (b *boardUsecase) FixPinsOnBoard(ctx context.Context, boardID int, pinIds []int, userID int) error {
 	role, err := b.boardRepo.RoleUserHaveOnThisBoard(ctx, boardID, userID)
 	if err != nil {
@@ -97,5 +136,9 @@ func (b *boardUsecase) FixPinsOnBoard(ctx context.Context, boardID int, pinIds [
 	if err != nil {
 		return fmt.Errorf("fix pins on board: %w", err)
 	}
+
+	if b.notifyIsEnable {
+		go b.notifyNewPins(boardID)
+	}
 	return nil
 }
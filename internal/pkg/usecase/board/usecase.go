@@ -2,11 +2,16 @@ package board
 
 import (
 	"context"
+	"time"
 
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/board"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
 	boardRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/board"
 	userRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/analytics"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/realtime/notification"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/timeline"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 	"github.com/microcosm-cc/bluemonday"
 )
@@ -20,17 +25,47 @@ type Usecase interface {
 	UpdateBoardInfo(ctx context.Context, updatedBoard entity.Board, tagTitles []string) error
 	DeleteCertainBoard(ctx context.Context, boardID int) error
 	FixPinsOnBoard(ctx context.Context, boardID int, pinIds []int, userID int) error
+	RepinOnBoard(ctx context.Context, boardID, pinID, userID int) (int, error)
 	DeletePinFromBoard(ctx context.Context, boardID, pinID int) error
 	CheckAvailabilityFeedPinCfgOnBoard(ctx context.Context, cfg pin.FeedPinConfig, userID int, isAuth bool) error
+	SubscribeToBoard(ctx context.Context, boardID, userID int) error
+	UnsubscribeFromBoard(ctx context.Context, boardID, userID int) error
 }
 
+const (
+	_timeoutNotification = 5 * time.Minute
+	_timeoutTimeline     = time.Minute
+)
+
 type boardUsecase struct {
-	log       *logger.Logger
-	boardRepo boardRepo.Repository
-	userRepo  userRepo.Repository
-	sanitizer *bluemonday.Policy
+	log              *logger.Logger
+	boardRepo        boardRepo.Repository
+	userRepo         userRepo.Repository
+	notifyCase       notification.Usecase
+	sanitizer        *bluemonday.Policy
+	analyticsCase    analytics.Usecase
+	timelineCase     timeline.Usecase
+	verifier         user.EmailVerifier
+	notifyIsEnable   bool
+	trackIsEnable    bool
+	timelineIsEnable bool
 }
 
-func New(logger *logger.Logger, boardRepo boardRepo.Repository, userRepo userRepo.Repository, sanitizer *bluemonday.Policy) *boardUsecase {
-	return &boardUsecase{log: logger, boardRepo: boardRepo, userRepo: userRepo, sanitizer: sanitizer}
+func New(logger *logger.Logger, boardRepo boardRepo.Repository, userRepo userRepo.Repository,
+	notifyCase notification.Usecase, sanitizer *bluemonday.Policy, analyticsCase analytics.Usecase,
+	timelineCase timeline.Usecase, verifier user.EmailVerifier) *boardUsecase {
+
+	return &boardUsecase{
+		log:              logger,
+		boardRepo:        boardRepo,
+		userRepo:         userRepo,
+		notifyCase:       notifyCase,
+		sanitizer:        sanitizer,
+		analyticsCase:    analyticsCase,
+		timelineCase:     timelineCase,
+		verifier:         verifier,
+		notifyIsEnable:   notifyCase != nil,
+		trackIsEnable:    analyticsCase != nil,
+		timelineIsEnable: timelineCase != nil,
+	}
 }
@@ -5,12 +5,18 @@ import (
 	"testing"
 	"time"
 
+	entityAnalytics "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/board"
 	uEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
+	repoBoard "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/board"
 	mock_board "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/board/mock"
 	mock_user "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user/mock"
+	mock_analytics "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/analytics/mock"
+	mock_timeline "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/timeline/mock"
+	userUsecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
+	mock_user_case "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user/mock"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 	"github.com/golang/mock/gomock"
 	"github.com/microcosm-cc/bluemonday"
@@ -26,6 +32,7 @@ type (
 	GetContributorsByBoardID func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int)
 	GetBoardByID             func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int, hasAccess bool)
 	GetUserIdByUsername      func(mockRepo *mock_user.MockRepository, ctx context.Context, username string)
+	CheckProfileAccess       func(mockRepo *mock_user.MockRepository, ctx context.Context, ownerID int)
 	DeleteBoardByID          func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int)
 )
 
@@ -80,7 +87,7 @@ func TestBoardUsecase_CreateNewBoard(t *testing.T) {
 				Public:      test.newBoard.Public,
 			}, test.tagTitles)
 
-			boardUsecase := New(log, mockBoardRepo, nil, sanitizer)
+			boardUsecase := New(log, mockBoardRepo, nil, nil, sanitizer, nil, nil, nil)
 			newBoardID, err := boardUsecase.CreateNewBoard(test.inCtx, test.newBoard, test.tagTitles)
 
 			if test.wantErr {
@@ -197,7 +204,7 @@ func TestBoardUsecase_UpdateBoardInfo(t *testing.T) {
 				Public:      test.updatedBoard.Public,
 			}, test.tagTitles)
 
-			boardUsecase := New(log, mockBoardRepo, nil, sanitizer)
+			boardUsecase := New(log, mockBoardRepo, nil, nil, sanitizer, nil, nil, nil)
 			err := boardUsecase.UpdateBoardInfo(test.inCtx, test.updatedBoard, test.tagTitles)
 
 			if test.wantErr {
@@ -233,6 +240,7 @@ func TestBoardUsecase_GetBoardsByUsername(t *testing.T) {
 			username: "validGuy",
 			GetUserIdByUsername: func(mockRepo *mock_user.MockRepository, ctx context.Context, username string) {
 				mockRepo.EXPECT().GetUserIdByUsername(ctx, username).Return(3, nil).Times(1)
+				mockRepo.EXPECT().CheckProfileAccess(ctx, 3, 1).Return(true, nil).Times(1)
 			},
 			GetContributorBoardsIDs: func(mockRepo *mock_board.MockRepository, ctx context.Context, contributorID int) {
 				mockRepo.EXPECT().GetContributorBoardsIDs(ctx, contributorID).Return([]int{1, 2, 3}, nil).Times(1)
@@ -310,7 +318,7 @@ func TestBoardUsecase_GetBoardsByUsername(t *testing.T) {
 			test.GetContributorBoardsIDs(mockBoardRepo, test.inCtx, 1)
 			test.GetBoardsByUserID(mockBoardRepo, test.inCtx, 3, *new(bool), []int{1, 2, 3})
 
-			boardUsecase := New(log, mockBoardRepo, mockUserRepo, sanitizer)
+			boardUsecase := New(log, mockBoardRepo, mockUserRepo, nil, sanitizer, nil, nil, nil)
 			userBoards, err := boardUsecase.GetBoardsByUsername(test.inCtx, test.username)
 
 			if test.wantErr {
@@ -336,6 +344,7 @@ func TestBoardUsecase_GetCertainBoard(t *testing.T) {
 		boardID                  int
 		GetBoardAuthorByBoardID  GetBoardAuthorByBoardID
 		GetContributorsByBoardID GetContributorsByBoardID
+		CheckProfileAccess       CheckProfileAccess
 		GetBoardByID             GetBoardByID
 		hasAccess                bool
 		expBoard                 entity.BoardWithContent
@@ -353,6 +362,8 @@ func TestBoardUsecase_GetCertainBoard(t *testing.T) {
 			GetContributorsByBoardID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int) {
 				mockRepo.EXPECT().GetContributorsByBoardID(ctx, boardID).Return([]uEntity.User{}, nil).Times(1)
 			},
+			CheckProfileAccess: func(mockRepo *mock_user.MockRepository, ctx context.Context, ownerID int) {
+			},
 			GetBoardByID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int, hasAccess bool) {
 				mockRepo.EXPECT().GetBoardByID(ctx, boardID, hasAccess).Return(entity.BoardWithContent{
 					BoardInfo: entity.Board{
@@ -390,6 +401,8 @@ func TestBoardUsecase_GetCertainBoard(t *testing.T) {
 			GetContributorsByBoardID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int) {
 				mockRepo.EXPECT().GetContributorsByBoardID(ctx, boardID).Return([]uEntity.User{{ID: 1}}, nil).Times(1)
 			},
+			CheckProfileAccess: func(mockRepo *mock_user.MockRepository, ctx context.Context, ownerID int) {
+			},
 			GetBoardByID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int, hasAccess bool) {
 				mockRepo.EXPECT().GetBoardByID(ctx, boardID, hasAccess).Return(entity.BoardWithContent{
 					BoardInfo: entity.Board{
@@ -427,6 +440,9 @@ func TestBoardUsecase_GetCertainBoard(t *testing.T) {
 			GetContributorsByBoardID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int) {
 				mockRepo.EXPECT().GetContributorsByBoardID(ctx, boardID).Return([]uEntity.User{{ID: 123}}, nil).Times(1)
 			},
+			CheckProfileAccess: func(mockRepo *mock_user.MockRepository, ctx context.Context, ownerID int) {
+				mockRepo.EXPECT().CheckProfileAccess(ctx, ownerID, 1).Return(true, nil).Times(1)
+			},
 			GetBoardByID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int, hasAccess bool) {
 				mockRepo.EXPECT().GetBoardByID(ctx, boardID, hasAccess).Return(entity.BoardWithContent{}, "", repository.ErrNoData).Times(1)
 			},
@@ -445,6 +461,9 @@ func TestBoardUsecase_GetCertainBoard(t *testing.T) {
 			GetContributorsByBoardID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int) {
 				mockRepo.EXPECT().GetContributorsByBoardID(ctx, boardID).Return([]uEntity.User{{ID: 123}}, nil).Times(1)
 			},
+			CheckProfileAccess: func(mockRepo *mock_user.MockRepository, ctx context.Context, ownerID int) {
+				mockRepo.EXPECT().CheckProfileAccess(ctx, ownerID, 0).Return(true, nil).Times(1)
+			},
 			GetBoardByID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int, hasAccess bool) {
 				mockRepo.EXPECT().GetBoardByID(ctx, boardID, hasAccess).Return(entity.BoardWithContent{}, "", repository.ErrNoData).Times(1)
 			},
@@ -463,6 +482,9 @@ func TestBoardUsecase_GetCertainBoard(t *testing.T) {
 			GetContributorsByBoardID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int) {
 				mockRepo.EXPECT().GetContributorsByBoardID(ctx, boardID).Return([]uEntity.User{{ID: 123}}, nil).Times(1)
 			},
+			CheckProfileAccess: func(mockRepo *mock_user.MockRepository, ctx context.Context, ownerID int) {
+				mockRepo.EXPECT().CheckProfileAccess(ctx, ownerID, 0).Return(true, nil).Times(1)
+			},
 			GetBoardByID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int, hasAccess bool) {
 				mockRepo.EXPECT().GetBoardByID(ctx, boardID, hasAccess).Return(entity.BoardWithContent{
 					BoardInfo: entity.Board{
@@ -490,6 +512,25 @@ func TestBoardUsecase_GetCertainBoard(t *testing.T) {
 			},
 			expUsername: "user",
 		},
+		{
+			name:    "public board of private profile, request from not follower",
+			inCtx:   context.WithValue(context.Background(), auth.KeyCurrentUserID, 1),
+			boardID: 22,
+			GetBoardAuthorByBoardID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int) {
+				mockRepo.EXPECT().GetBoardAuthorByBoardID(ctx, boardID).Return(2, nil).Times(1)
+			},
+			GetContributorsByBoardID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int) {
+				mockRepo.EXPECT().GetContributorsByBoardID(ctx, boardID).Return([]uEntity.User{}, nil).Times(1)
+			},
+			CheckProfileAccess: func(mockRepo *mock_user.MockRepository, ctx context.Context, ownerID int) {
+				mockRepo.EXPECT().CheckProfileAccess(ctx, ownerID, 1).Return(false, nil).Times(1)
+			},
+			GetBoardByID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int, hasAccess bool) {
+			},
+			expBoard: entity.BoardWithContent{},
+			wantErr:  true,
+			expErr:   ErrNoSuchBoard,
+		},
 		{
 			name:    "invalid board id",
 			inCtx:   context.Background(),
@@ -499,6 +540,8 @@ func TestBoardUsecase_GetCertainBoard(t *testing.T) {
 			},
 			GetContributorsByBoardID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int) {
 			},
+			CheckProfileAccess: func(mockRepo *mock_user.MockRepository, ctx context.Context, ownerID int) {
+			},
 			GetBoardByID: func(mockRepo *mock_board.MockRepository, ctx context.Context, boardID int, hasAccess bool) {
 			},
 			expBoard: entity.BoardWithContent{},
@@ -513,11 +556,13 @@ func TestBoardUsecase_GetCertainBoard(t *testing.T) {
 			defer ctl.Finish()
 
 			mockBoardRepo := mock_board.NewMockRepository(ctl)
+			mockUserRepo := mock_user.NewMockRepository(ctl)
 			test.GetBoardAuthorByBoardID(mockBoardRepo, test.inCtx, test.boardID)
 			test.GetContributorsByBoardID(mockBoardRepo, test.inCtx, test.boardID)
+			test.CheckProfileAccess(mockUserRepo, test.inCtx, 2)
 			test.GetBoardByID(mockBoardRepo, test.inCtx, test.boardID, test.hasAccess)
 
-			boardUsecase := New(log, mockBoardRepo, nil, sanitizer)
+			boardUsecase := New(log, mockBoardRepo, mockUserRepo, nil, sanitizer, nil, nil, nil)
 			board, _, err := boardUsecase.GetCertainBoard(test.inCtx, test.boardID)
 
 			if test.wantErr {
@@ -604,7 +649,7 @@ func TestBoardUsecase_DeleteCertainBoard(t *testing.T) {
 			test.GetBoardAuthorByBoardID(mockBoardRepo, test.inCtx, test.boardID)
 			test.DeleteBoardByID(mockBoardRepo, test.inCtx, test.boardID)
 
-			boardUsecase := New(log, mockBoardRepo, nil, sanitizer)
+			boardUsecase := New(log, mockBoardRepo, nil, nil, sanitizer, nil, nil, nil)
 			err = boardUsecase.DeleteCertainBoard(test.inCtx, test.boardID)
 
 			if test.wantErr {
@@ -615,3 +660,127 @@ func TestBoardUsecase_DeleteCertainBoard(t *testing.T) {
 		})
 	}
 }
+
+func TestBoardUsecase_SubscribeToBoard(t *testing.T) {
+	log, err := logger.New(logger.RFC3339FormatTime())
+	if err != nil {
+		t.Fatalf("test: log init - %s", err.Error())
+	}
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	ctx := context.Background()
+	mockBoardRepo := mock_board.NewMockRepository(ctl)
+	mockTimeline := mock_timeline.NewMockUsecase(ctl)
+	boardUsecase := New(log, mockBoardRepo, nil, nil, sanitizer, nil, mockTimeline, nil)
+
+	mockBoardRepo.EXPECT().GetBoardAuthorByBoardID(ctx, 404).Return(0, repository.ErrNoData).Times(1)
+	err = boardUsecase.SubscribeToBoard(ctx, 404, 1)
+	require.ErrorIs(t, err, ErrNoSuchBoard)
+
+	mockBoardRepo.EXPECT().GetBoardAuthorByBoardID(ctx, 22).Return(2, nil).Times(2)
+	mockBoardRepo.EXPECT().GetProtectionStatusBoard(ctx, 22).Return(repoBoard.ProtectionPrivate, nil).Times(2)
+	mockBoardRepo.EXPECT().RoleUserHaveOnThisBoard(ctx, 22, 1).Return(repoBoard.RegularUser, nil).Times(1)
+	err = boardUsecase.SubscribeToBoard(ctx, 22, 1)
+	require.ErrorIs(t, err, ErrNoSuchBoard)
+
+	mockBoardRepo.EXPECT().RoleUserHaveOnThisBoard(ctx, 22, 3).Return(repoBoard.ContributorForReading, nil).Times(1)
+	mockBoardRepo.EXPECT().SubscribeToBoard(ctx, 3, 22).Return(nil).Times(1)
+	mockTimeline.EXPECT().InvalidateTimeline(ctx, 3).Return(nil).Times(1)
+	err = boardUsecase.SubscribeToBoard(ctx, 22, 3)
+	require.NoError(t, err)
+
+	mockBoardRepo.EXPECT().GetBoardAuthorByBoardID(ctx, 23).Return(2, nil).Times(1)
+	mockBoardRepo.EXPECT().GetProtectionStatusBoard(ctx, 23).Return(repoBoard.ProtectionPublic, nil).Times(1)
+	mockBoardRepo.EXPECT().SubscribeToBoard(ctx, 1, 23).Return(repository.ErrNoDataAffected).Times(1)
+	err = boardUsecase.SubscribeToBoard(ctx, 23, 1)
+	require.ErrorIs(t, err, ErrAlreadyFollowed)
+
+	mockBoardRepo.EXPECT().UnsubscribeFromBoard(ctx, 1, 23).Return(repository.ErrNoDataAffected).Times(1)
+	err = boardUsecase.UnsubscribeFromBoard(ctx, 23, 1)
+	require.ErrorIs(t, err, ErrNotFollowed)
+
+	mockBoardRepo.EXPECT().UnsubscribeFromBoard(ctx, 3, 22).Return(nil).Times(1)
+	mockTimeline.EXPECT().InvalidateTimeline(ctx, 3).Return(nil).Times(1)
+	err = boardUsecase.UnsubscribeFromBoard(ctx, 22, 3)
+	require.NoError(t, err)
+}
+
+func TestBoardUsecase_RepinOnBoard(t *testing.T) {
+	log, err := logger.New(logger.RFC3339FormatTime())
+	if err != nil {
+		t.Fatalf("test: log init - %s", err.Error())
+	}
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	ctx := context.Background()
+	mockBoardRepo := mock_board.NewMockRepository(ctl)
+	mockAnalytics := mock_analytics.NewMockUsecase(ctl)
+	mockVerifier := mock_user_case.NewMockUsecase(ctl)
+	boardUsecase := New(log, mockBoardRepo, nil, nil, sanitizer, mockAnalytics, nil, mockVerifier)
+
+	mockVerifier.EXPECT().CheckEmailVerified(ctx, 3).Return(&userUsecase.ErrEmailNotVerified{}).Times(1)
+	_, err = boardUsecase.RepinOnBoard(ctx, 22, 40, 3)
+	var notVerified *userUsecase.ErrEmailNotVerified
+	require.ErrorAs(t, err, &notVerified)
+
+	mockVerifier.EXPECT().CheckEmailVerified(ctx, 1).Return(nil).Times(1)
+	mockVerifier.EXPECT().CheckEmailVerified(ctx, 2).Return(nil).Times(3)
+	mockBoardRepo.EXPECT().RoleUserHaveOnThisBoard(ctx, 22, 1).Return(repoBoard.ContributorForReading, nil).Times(1)
+	_, err = boardUsecase.RepinOnBoard(ctx, 22, 40, 1)
+	require.ErrorIs(t, err, ErrNoAccess)
+
+	mockBoardRepo.EXPECT().RoleUserHaveOnThisBoard(ctx, 22, 2).Return(repoBoard.Author, nil).Times(3)
+	mockBoardRepo.EXPECT().RepinOnBoard(ctx, 22, 40, 2).Return(0, false, repository.ErrNoData).Times(1)
+	_, err = boardUsecase.RepinOnBoard(ctx, 22, 40, 2)
+	require.ErrorIs(t, err, ErrPinNotSavable)
+
+	// the repeated save is not tracked
+	mockBoardRepo.EXPECT().RepinOnBoard(ctx, 22, 42, 2).Return(0, false, repository.ErrNoDataAffected).Times(1)
+	_, err = boardUsecase.RepinOnBoard(ctx, 22, 42, 2)
+	require.ErrorIs(t, err, ErrPinAlreadySaved)
+
+	mockBoardRepo.EXPECT().RepinOnBoard(ctx, 22, 41, 2).Return(57, false, nil).Times(1)
+	mockAnalytics.EXPECT().Track(41, entityAnalytics.EventSave).Times(1)
+	newPinID, err := boardUsecase.RepinOnBoard(ctx, 22, 41, 2)
+	require.NoError(t, err)
+	require.Equal(t, 57, newPinID)
+}
+
+func TestBoardUsecase_RepinOnPublicBoard(t *testing.T) {
+	log, err := logger.New(logger.RFC3339FormatTime())
+	if err != nil {
+		t.Fatalf("test: log init - %s", err.Error())
+	}
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	ctx := context.Background()
+	mockBoardRepo := mock_board.NewMockRepository(ctl)
+	mockTimeline := mock_timeline.NewMockUsecase(ctl)
+	mockVerifier := mock_user_case.NewMockUsecase(ctl)
+	boardUsecase := New(log, mockBoardRepo, nil, nil, sanitizer, nil, mockTimeline, mockVerifier)
+
+	pushed := make(chan struct{})
+	mockVerifier.EXPECT().CheckEmailVerified(ctx, 2).Return(nil).Times(1)
+	mockBoardRepo.EXPECT().RoleUserHaveOnThisBoard(ctx, 22, 2).Return(repoBoard.Author, nil).Times(1)
+	mockBoardRepo.EXPECT().RepinOnBoard(ctx, 22, 41, 2).Return(57, true, nil).Times(1)
+	mockTimeline.EXPECT().PushPin(gomock.Any(), 2, 57).DoAndReturn(func(_ context.Context, _, _ int) error {
+		close(pushed)
+		return nil
+	}).Times(1)
+
+	newPinID, err := boardUsecase.RepinOnBoard(ctx, 22, 41, 2)
+	require.NoError(t, err)
+	require.Equal(t, 57, newPinID)
+
+	select {
+	case <-pushed:
+	case <-time.After(time.Second):
+		t.Fatal("the saved copy of the pin is not pushed to the timelines")
+	}
+}
@@ -24,6 +24,10 @@ type availablePinChecker interface {
 	GetAuthorIdOfThePin(ctx context.Context, pinID int) (int, error)
 }
 
+type blockChecker interface {
+	CheckBlocked(ctx context.Context, userID1, userID2 int) error
+}
+
 const _timeoutNotification = 5 * time.Minute
 
 type commentCase struct {
@@ -31,14 +35,16 @@ type commentCase struct {
 
 	notifyCase notification.Usecase
 	repo       commentRepo.Repository
+	blocks     blockChecker
 
 	notifyIsEnable bool
 }
 
-func New(repo commentRepo.Repository, checker availablePinChecker, notifyCase notification.Usecase) *commentCase {
+func New(repo commentRepo.Repository, checker availablePinChecker, blocks blockChecker, notifyCase notification.Usecase) *commentCase {
 	comCase := &commentCase{
 		availablePinChecker: checker,
 		repo:                repo,
+		blocks:              blocks,
 		notifyCase:          notifyCase,
 	}
 
@@ -54,6 +60,14 @@ func (c *commentCase) PutCommentOnPin(ctx context.Context, userID int, comment *
 		return 0, fmt.Errorf("put comment on not available pin: %w", err)
 	}
 
+	authorID, err := c.GetAuthorIdOfThePin(ctx, comment.PinID)
+	if err != nil {
+		return 0, fmt.Errorf("get author of the pin for put comment: %w", err)
+	}
+	if err = c.blocks.CheckBlocked(ctx, authorID, userID); err != nil {
+		return 0, fmt.Errorf("put comment on pin of the blocking user: %w", err)
+	}
+
 	comment.Author = &user.User{ID: userID}
 
 	id, err := c.repo.AddComment(ctx, comment)
@@ -75,7 +89,7 @@ func (c *commentCase) GetFeedCommentOnPin(ctx context.Context, userID, pinID, co
 		return nil, 0, fmt.Errorf("put comment on not available pin: %w", err)
 	}
 
-	feed, err := c.repo.GetCommensToPin(ctx, pinID, lastID, count)
+	feed, err := c.repo.GetCommensToPin(ctx, userID, pinID, lastID, count)
 	if err != nil {
 		err = fmt.Errorf("get feed comment on pin: %w", err)
 	}
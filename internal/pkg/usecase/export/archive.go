@@ -0,0 +1,286 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"strings"
+
+	boardEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/board"
+	messageEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/message"
+	pinEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	userEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/image"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+// pageSize is the count of the records requested from the paginated repositories at once.
+const pageSize = 100
+
+const (
+	fileProfile       = "profile.json"
+	filePins          = "pins.json"
+	fileBoards        = "boards.json"
+	fileComments      = "comments.json"
+	fileLikes         = "likes.json"
+	fileSubscriptions = "subscriptions.json"
+	fileMessages      = "messages.json"
+	dirImages         = "images/"
+)
+
+type archivePin struct {
+	pinEntity.Pin
+	// Files are the copied images of the pin in their order
+	Files []string `json:"files,omitempty"`
+}
+
+type archiveBoard struct {
+	boardEntity.Board
+	Tags   []string `json:"tags"`
+	PinIDs []int    `json:"pin_ids"`
+}
+
+type archiveSubscriptions struct {
+	Subscriptions []userEntity.SubscriptionUser `json:"subscriptions"`
+	Subscribers   []userEntity.SubscriptionUser `json:"subscribers"`
+}
+
+type archiveChat struct {
+	With     userEntity.User         `json:"user"`
+	Messages []messageEntity.Message `json:"messages"`
+}
+
+// collect writes into w the zip archive with all the data of the user.
+func (e *exportCase) collect(ctx context.Context, userID int, w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	steps := []func(context.Context, *zip.Writer, int) error{
+		e.writeProfile,
+		e.writePins,
+		e.writeBoards,
+		e.writeComments,
+		e.writeLikes,
+		e.writeSubscriptions,
+		e.writeMessages,
+	}
+	for _, step := range steps {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := step(ctx, zw, userID); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func (e *exportCase) writeProfile(ctx context.Context, zw *zip.Writer, userID int) error {
+	profile, err := e.src.User.GetAllUserData(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("collect profile: %w", err)
+	}
+	profile.Password = ""
+	return writeJSON(zw, fileProfile, profile)
+}
+
+// writePins also copies the images of the pins, the image which cannot be opened is skipped.
+func (e *exportCase) writePins(ctx context.Context, zw *zip.Writer, userID int) error {
+	pins, err := e.src.Pin.GetPinsByAuthor(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("collect pins: %w", err)
+	}
+
+	archivePins := make([]archivePin, 0, len(pins))
+	for _, pin := range pins {
+		pin.Author = nil
+		images := pin.Images
+		if len(images) == 0 {
+			images = []string{pin.Picture}
+		}
+
+		files := make([]string, 0, len(images))
+		for _, picture := range images {
+			file, err := e.copyImage(zw, picture)
+			if err != nil {
+				e.log.Warn("skip image of the pin in data export: "+err.Error(), logger.F{"pin_id", pin.ID})
+				continue
+			}
+			files = append(files, file)
+		}
+		archivePins = append(archivePins, archivePin{Pin: pin, Files: files})
+	}
+	return writeJSON(zw, filePins, archivePins)
+}
+
+func (e *exportCase) copyImage(zw *zip.Writer, picture string) (string, error) {
+	filename := strings.TrimPrefix(picture, image.PrefixURLImage)
+	if filename == picture {
+		return "", fmt.Errorf("picture %s is not stored locally", picture)
+	}
+
+	img, err := e.src.Image.OpenImage(filename)
+	if err != nil {
+		return "", err
+	}
+	defer img.Close()
+
+	name := dirImages + path.Base(filename)
+	dst, err := zw.Create(name)
+	if err != nil {
+		return "", err
+	}
+	if _, err = io.Copy(dst, img); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func (e *exportCase) writeBoards(ctx context.Context, zw *zip.Writer, userID int) error {
+	boards, err := e.src.Board.GetBoardsByUserID(ctx, userID, true, nil)
+	if err != nil {
+		return fmt.Errorf("collect boards: %w", err)
+	}
+	membership, err := e.src.Board.GetMembershipByAuthor(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("collect boards: %w", err)
+	}
+
+	archiveBoards := make([]archiveBoard, 0, len(boards))
+	for _, board := range boards {
+		pinIDs := membership[board.BoardInfo.ID]
+		if pinIDs == nil {
+			pinIDs = []int{}
+		}
+		archiveBoards = append(archiveBoards, archiveBoard{
+			Board:  board.BoardInfo,
+			Tags:   board.TagTitles,
+			PinIDs: pinIDs,
+		})
+	}
+	return writeJSON(zw, fileBoards, archiveBoards)
+}
+
+func (e *exportCase) writeComments(ctx context.Context, zw *zip.Writer, userID int) error {
+	comments, err := e.src.Comment.GetCommentsByAuthor(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("collect comments: %w", err)
+	}
+	for i := range comments {
+		comments[i].Author = nil
+	}
+	return writeJSON(zw, fileComments, comments)
+}
+
+func (e *exportCase) writeLikes(ctx context.Context, zw *zip.Writer, userID int) error {
+	pinIDs, err := e.src.Pin.GetLikedPinIDs(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("collect likes: %w", err)
+	}
+	return writeJSON(zw, fileLikes, map[string][]int{"pin_ids": pinIDs})
+}
+
+func (e *exportCase) writeSubscriptions(ctx context.Context, zw *zip.Writer, userID int) error {
+	subscriptions, err := collectPages(func(lastID int) ([]userEntity.SubscriptionUser, int, error) {
+		users, err := e.src.Subscription.GetUserSubscriptions(ctx, userID, pageSize, lastID, userID)
+		return users, lastSubscriptionID(users), err
+	})
+	if err != nil {
+		return fmt.Errorf("collect subscriptions: %w", err)
+	}
+
+	subscribers, err := collectPages(func(lastID int) ([]userEntity.SubscriptionUser, int, error) {
+		users, err := e.src.Subscription.GetUserSubscribers(ctx, userID, pageSize, lastID, userID)
+		return users, lastSubscriptionID(users), err
+	})
+	if err != nil {
+		return fmt.Errorf("collect subscribers: %w", err)
+	}
+
+	return writeJSON(zw, fileSubscriptions, archiveSubscriptions{
+		Subscriptions: subscriptions,
+		Subscribers:   subscribers,
+	})
+}
+
+func (e *exportCase) writeMessages(ctx context.Context, zw *zip.Writer, userID int) error {
+	chats, err := collectPages(func(lastID int) (messageEntity.FeedUserChats, int, error) {
+		if lastID == math.MaxInt32 {
+			lastID = 0
+		}
+		chats, err := e.src.Message.GetUserChats(ctx, userID, pageSize, lastID)
+		if len(chats) == 0 {
+			return chats, 0, err
+		}
+		return chats, chats[len(chats)-1].MessageLastID, err
+	})
+	if err != nil {
+		return fmt.Errorf("collect chats: %w", err)
+	}
+
+	// the chat with the same user can be returned on several pages
+	seen := make(map[int]struct{}, len(chats))
+	archiveChats := make([]archiveChat, 0, len(chats))
+	for _, chat := range chats {
+		if _, ok := seen[chat.WichWhomChat.ID]; ok {
+			continue
+		}
+		seen[chat.WichWhomChat.ID] = struct{}{}
+
+		messages, err := collectPages(func(lastID int) ([]messageEntity.Message, int, error) {
+			if lastID == math.MaxInt32 {
+				lastID = 0
+			}
+			messages, err := e.src.Message.GetMessages(ctx, messageEntity.Chat{userID, chat.WichWhomChat.ID}, pageSize, lastID)
+			if len(messages) == 0 {
+				return messages, 0, err
+			}
+			return messages, messages[len(messages)-1].ID, err
+		})
+		if err != nil {
+			return fmt.Errorf("collect messages: %w", err)
+		}
+		archiveChats = append(archiveChats, archiveChat{With: chat.WichWhomChat, Messages: messages})
+	}
+	return writeJSON(zw, fileMessages, archiveChats)
+}
+
+// collectPages requests the pages going down from the biggest id while the page is full.
+func collectPages[T any, S ~[]T](page func(lastID int) (S, int, error)) ([]T, error) {
+	res := make([]T, 0)
+	for lastID := math.MaxInt32; ; {
+		items, nextLastID, err := page(lastID)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, items...)
+		if len(items) < pageSize || nextLastID >= lastID {
+			return res, nil
+		}
+		lastID = nextLastID
+	}
+}
+
+func lastSubscriptionID(users []userEntity.SubscriptionUser) int {
+	if len(users) == 0 {
+		return 0
+	}
+	return users[len(users)-1].ID
+}
+
+func writeJSON(zw *zip.Writer, name string, v any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("create %s in archive: %w", name, err)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(v); err != nil {
+		return fmt.Errorf("write %s in archive: %w", name, err)
+	}
+	return nil
+}
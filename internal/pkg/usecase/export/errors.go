@@ -0,0 +1,13 @@
+package export
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrExportNotReady struct{}
+
+func (e *ErrExportNotReady) Error() string {
+	return "the archive of the data export is not ready yet"
+}
+
+func (e *ErrExportNotReady) Type() errPkg.Type {
+	return errPkg.ErrAlreadyExists
+}
@@ -0,0 +1,176 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/export"
+	boardRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/board"
+	commentRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/comment"
+	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/export"
+	imgRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/image"
+	messageRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/message"
+	pinRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/pin"
+	subRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/subscription"
+	userRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+const (
+	// BuildTimeout limits the time of the archive assembling, after it the export is failed.
+	BuildTimeout = 10 * time.Minute
+	// ArchiveLifeTime is the time during which the ready archive can be downloaded.
+	ArchiveLifeTime = 7 * 24 * time.Hour
+
+	lenExportID = 32
+)
+
+//go:generate mockgen -destination=./mock/export_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	RequestExport(ctx context.Context, userID int) (*entity.Export, error)
+	GetExport(ctx context.Context, userID int, exportID string) (*entity.Export, error)
+	OpenArchive(ctx context.Context, userID int, exportID string) (io.ReadCloser, error)
+	RemoveExpiredExports(ctx context.Context) (int, error)
+}
+
+// Sources are the repositories from which the data of the user is collected.
+type Sources struct {
+	User         userRepo.Repository
+	Pin          pinRepo.Repository
+	Board        boardRepo.Repository
+	Comment      commentRepo.Repository
+	Subscription subRepo.Repository
+	Message      messageRepo.Repository
+	Image        imgRepo.Repository
+}
+
+type exportCase struct {
+	log      *logger.Logger
+	repo     repo.Repository
+	archives repo.ArchiveStorage
+	src      Sources
+	now      func() time.Time
+}
+
+func New(log *logger.Logger, repo repo.Repository, archives repo.ArchiveStorage, src Sources) *exportCase {
+	return &exportCase{
+		log:      log,
+		repo:     repo,
+		archives: archives,
+		src:      src,
+		now:      time.Now,
+	}
+}
+
+// RequestExport starts to assemble the archive in the background. If the previous
+// export of the user is still in progress, it is returned instead of a new one.
+func (e *exportCase) RequestExport(ctx context.Context, userID int) (*entity.Export, error) {
+	var notFound *repo.ErrExportNotFound
+	last, err := e.repo.GetLastExport(ctx, userID)
+	if err != nil && !errors.As(err, &notFound) {
+		return nil, fmt.Errorf("request data export: %w", err)
+	}
+	if err == nil && last.Status == entity.StatusPending && e.now().Sub(last.CreatedAt) < BuildTimeout {
+		return last, nil
+	}
+
+	exportID, err := crypto.NewRandomString(lenExportID)
+	if err != nil {
+		return nil, fmt.Errorf("generate data export id: %w", err)
+	}
+
+	export := &entity.Export{ID: exportID, UserID: userID}
+	if err = e.repo.AddExport(ctx, export); err != nil {
+		return nil, fmt.Errorf("request data export: %w", err)
+	}
+
+	go e.build(export)
+	return export, nil
+}
+
+func (e *exportCase) GetExport(ctx context.Context, userID int, exportID string) (*entity.Export, error) {
+	export, err := e.repo.GetExport(ctx, exportID)
+	if err != nil {
+		return nil, fmt.Errorf("get data export: %w", err)
+	}
+	if e.now().Sub(export.CreatedAt) > ArchiveLifeTime {
+		e.removeArchive(exportID)
+		return nil, &repo.ErrExportNotFound{}
+	}
+	if export.UserID != userID {
+		return nil, &repo.ErrExportNotFound{}
+	}
+	return export, nil
+}
+
+func (e *exportCase) OpenArchive(ctx context.Context, userID int, exportID string) (io.ReadCloser, error) {
+	export, err := e.GetExport(ctx, userID, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if export.Status != entity.StatusReady {
+		return nil, &ErrExportNotReady{}
+	}
+
+	archive, err := e.archives.OpenArchive(exportID)
+	if err != nil {
+		return nil, fmt.Errorf("open data export archive: %w", err)
+	}
+	return archive, nil
+}
+
+// RemoveExpiredExports deletes the exports whose archives can not be downloaded anymore
+// together with the archives and returns their number.
+func (e *exportCase) RemoveExpiredExports(ctx context.Context) (int, error) {
+	exportIDs, err := e.repo.DeleteExpiredExports(ctx, e.now().Add(-ArchiveLifeTime))
+	if err != nil {
+		return 0, fmt.Errorf("remove expired data exports: %w", err)
+	}
+
+	for _, exportID := range exportIDs {
+		e.removeArchive(exportID)
+	}
+	return len(exportIDs), nil
+}
+
+func (e *exportCase) removeArchive(exportID string) {
+	if err := e.archives.RemoveArchive(exportID); err != nil {
+		e.log.Error(err.Error(), logger.F{"export_id", exportID})
+	}
+}
+
+// build assembles the archive and saves the result of the export, it is independent of the request context.
+func (e *exportCase) build(export *entity.Export) {
+	ctx, cancel := context.WithTimeout(context.Background(), BuildTimeout)
+	defer cancel()
+
+	status := entity.StatusReady
+	if err := e.writeArchive(ctx, export); err != nil {
+		e.log.Error("build data export: "+err.Error(), logger.F{"export_id", export.ID})
+		status = entity.StatusFailed
+		e.removeArchive(export.ID)
+	}
+
+	if err := e.repo.SetStatus(ctx, export.ID, status); err != nil {
+		e.log.Error(err.Error())
+		return
+	}
+	export.Status = status
+}
+
+func (e *exportCase) writeArchive(ctx context.Context, export *entity.Export) error {
+	file, err := e.archives.CreateArchive(export.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = e.collect(ctx, export.UserID, file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,204 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/export"
+	messageEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/message"
+	pinEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	userEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	boardMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/board/mock"
+	commentMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/comment/mock"
+	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/export"
+	repoMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/export/mock"
+	imgMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/image/mock"
+	messageMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/message/mock"
+	pinMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/pin/mock"
+	subMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/subscription/mock"
+	userMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/image"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type bufferCloser struct {
+	bytes.Buffer
+}
+
+func (bufferCloser) Close() error { return nil }
+
+func TestGetExport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := repoMock.NewMockRepository(ctrl)
+	archives := repoMock.NewMockArchiveStorage(ctrl)
+	ec := New(log, repository, archives, Sources{})
+
+	pending := &entity.Export{ID: "abc", UserID: 12, Status: entity.StatusPending, CreatedAt: time.Now()}
+	repository.EXPECT().GetExport(ctx, "abc").Return(pending, nil).Times(3)
+
+	export, err := ec.GetExport(ctx, 12, "abc")
+	require.NoError(t, err)
+	require.Equal(t, pending, export)
+
+	_, err = ec.GetExport(ctx, 13, "abc")
+	require.ErrorAs(t, err, new(*repo.ErrExportNotFound))
+
+	_, err = ec.OpenArchive(ctx, 12, "abc")
+	require.ErrorAs(t, err, new(*ErrExportNotReady))
+
+	ready := &entity.Export{ID: "abc", UserID: 12, Status: entity.StatusReady, CreatedAt: time.Now()}
+	repository.EXPECT().GetExport(ctx, "abc").Return(ready, nil).Times(1)
+	archives.EXPECT().OpenArchive("abc").Return(io.NopCloser(&bytes.Buffer{}), nil).Times(1)
+
+	_, err = ec.OpenArchive(ctx, 12, "abc")
+	require.NoError(t, err)
+
+	expired := &entity.Export{ID: "abc", UserID: 12, Status: entity.StatusReady, CreatedAt: time.Now().Add(-ArchiveLifeTime - time.Hour)}
+	repository.EXPECT().GetExport(ctx, "abc").Return(expired, nil).Times(1)
+	archives.EXPECT().RemoveArchive("abc").Return(nil).Times(1)
+
+	_, err = ec.OpenArchive(ctx, 12, "abc")
+	require.ErrorAs(t, err, new(*repo.ErrExportNotFound))
+}
+
+func TestRemoveExpiredExports(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := repoMock.NewMockRepository(ctrl)
+	archives := repoMock.NewMockArchiveStorage(ctrl)
+	ec := New(log, repository, archives, Sources{})
+	now := time.Now()
+	ec.now = func() time.Time { return now }
+
+	repository.EXPECT().DeleteExpiredExports(ctx, now.Add(-ArchiveLifeTime)).Return([]string{"abc", "def"}, nil).Times(1)
+	archives.EXPECT().RemoveArchive("abc").Return(nil).Times(1)
+	// the archive which can not be removed does not stop the others
+	archives.EXPECT().RemoveArchive("def").Return(errors.New("permission denied")).Times(1)
+
+	removed, err := ec.RemoveExpiredExports(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+}
+
+func TestRequestExportInProgress(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := repoMock.NewMockRepository(ctrl)
+	ec := New(log, repository, repoMock.NewMockArchiveStorage(ctrl), Sources{})
+
+	pending := &entity.Export{ID: "abc", UserID: 12, Status: entity.StatusPending, CreatedAt: time.Now()}
+	repository.EXPECT().GetLastExport(ctx, 12).Return(pending, nil).Times(1)
+
+	export, err := ec.RequestExport(ctx, 12)
+	require.NoError(t, err)
+	require.Equal(t, pending, export)
+}
+
+func TestWriteArchive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user := userMock.NewMockRepository(ctrl)
+	pin := pinMock.NewMockRepository(ctrl)
+	board := boardMock.NewMockRepository(ctrl)
+	comment := commentMock.NewMockRepository(ctrl)
+	sub := subMock.NewMockRepository(ctrl)
+	message := messageMock.NewMockRepository(ctrl)
+	img := imgMock.NewMockRepository(ctrl)
+	archives := repoMock.NewMockArchiveStorage(ctrl)
+
+	ec := New(log, repoMock.NewMockRepository(ctrl), archives, Sources{
+		User:         user,
+		Pin:          pin,
+		Board:        board,
+		Comment:      comment,
+		Subscription: sub,
+		Message:      message,
+		Image:        img,
+	})
+
+	buf := &bufferCloser{}
+	archives.EXPECT().CreateArchive("abc").Return(buf, nil).Times(1)
+
+	user.EXPECT().GetAllUserData(gomock.Any(), 12).
+		Return(&userEntity.User{ID: 12, Username: "green", Password: "hash"}, nil).Times(1)
+	pin.EXPECT().GetPinsByAuthor(gomock.Any(), 12).Return([]pinEntity.Pin{
+		{ID: 1, Picture: image.PrefixURLImage + "upload/pins/img.png", Images: []string{
+			image.PrefixURLImage + "upload/pins/img.png", image.PrefixURLImage + "upload/pins/second.png",
+		}},
+		{ID: 2, Picture: "https://example.com/img.png"},
+	}, nil).Times(1)
+	img.EXPECT().OpenImage("upload/pins/img.png").Return(io.NopCloser(bytes.NewBufferString("png")), nil).Times(1)
+	img.EXPECT().OpenImage("upload/pins/second.png").Return(io.NopCloser(bytes.NewBufferString("second")), nil).Times(1)
+	board.EXPECT().GetBoardsByUserID(gomock.Any(), 12, true, nil).Return(nil, nil).Times(1)
+	board.EXPECT().GetMembershipByAuthor(gomock.Any(), 12).Return(map[int][]int{}, nil).Times(1)
+	comment.EXPECT().GetCommentsByAuthor(gomock.Any(), 12).Return(nil, nil).Times(1)
+	pin.EXPECT().GetLikedPinIDs(gomock.Any(), 12).Return([]int{1}, nil).Times(1)
+	sub.EXPECT().GetUserSubscriptions(gomock.Any(), 12, pageSize, gomock.Any(), 12).Return(nil, nil).Times(1)
+	sub.EXPECT().GetUserSubscribers(gomock.Any(), 12, pageSize, gomock.Any(), 12).Return(nil, nil).Times(1)
+	message.EXPECT().GetUserChats(gomock.Any(), 12, pageSize, 0).Return(messageEntity.FeedUserChats{
+		{MessageLastID: 5, WichWhomChat: userEntity.User{ID: 13}},
+	}, nil).Times(1)
+	message.EXPECT().GetMessages(gomock.Any(), messageEntity.Chat{12, 13}, pageSize, 0).
+		Return([]messageEntity.Message{{ID: 5, From: 12, To: 13}}, nil).Times(1)
+
+	err = ec.writeArchive(context.Background(), &entity.Export{ID: "abc", UserID: 12})
+	require.NoError(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		files[f.Name] = string(content)
+	}
+
+	require.Equal(t, "png", files[dirImages+"img.png"])
+	require.NotContains(t, files[fileProfile], "hash")
+	require.Equal(t, "second", files[dirImages+"second.png"])
+	require.Regexp(t, `"files": \[\s*"images/img.png",\s*"images/second.png"\s*\]`, files[filePins])
+	require.Contains(t, files[fileMessages], `"from": 12`)
+	for _, name := range []string{fileBoards, fileComments, fileLikes, fileSubscriptions} {
+		require.Contains(t, files, name)
+	}
+}
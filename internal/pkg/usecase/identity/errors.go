@@ -0,0 +1,53 @@
+package identity
+
+import errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+
+type ErrUnknownProvider struct{}
+
+func (e *ErrUnknownProvider) Error() string {
+	return "unknown identity provider"
+}
+
+func (e *ErrUnknownProvider) Type() errPkg.Type {
+	return errPkg.ErrNotFound
+}
+
+type ErrExternalAuthentication struct{}
+
+func (e *ErrExternalAuthentication) Error() string {
+	return "failed to authenticate the user at the identity provider"
+}
+
+func (e *ErrExternalAuthentication) Type() errPkg.Type {
+	return errPkg.ErrNoAuth
+}
+
+type ErrEmailNotVerified struct{}
+
+func (e *ErrEmailNotVerified) Error() string {
+	return "the identity provider has not verified the email of the user"
+}
+
+func (e *ErrEmailNotVerified) Type() errPkg.Type {
+	return errPkg.ErrNoAccess
+}
+
+type ErrEmailTaken struct{}
+
+func (e *ErrEmailTaken) Error() string {
+	return "the email is used by another account, log in to it and link the provider"
+}
+
+func (e *ErrEmailTaken) Type() errPkg.Type {
+	return errPkg.ErrAlreadyExists
+}
+
+type ErrLinkSessionMismatch struct{}
+
+func (e *ErrLinkSessionMismatch) Error() string {
+	return "linking of the identity provider was started by another user"
+}
+
+func (e *ErrLinkSessionMismatch) Type() errPkg.Type {
+	return errPkg.ErrNoAccess
+}
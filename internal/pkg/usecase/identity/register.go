@@ -0,0 +1,108 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	userEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
+	userRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/oidc"
+)
+
+const (
+	// the limits are in bytes as in the validation of the registration
+	minLenUsername = 4
+	maxLenUsername = 40
+	lenSuffix      = 4
+	// triesUsername is the count of the random suffixes tried when the username is taken.
+	triesUsername = 5
+)
+
+// register creates the profile with a random password, the user can set their own one
+// with the password reset. The email is considered verified since the provider has verified it.
+func (i *identityCase) register(ctx context.Context, claims *oidc.Claims) (int, error) {
+	if claims.Email == "" || !claims.EmailVerified {
+		return 0, &ErrEmailNotVerified{}
+	}
+
+	var nonExisting *userRepo.ErrNonExistingUser
+	_, err := i.users.GetUserIdByEmail(ctx, claims.Email)
+	if err == nil {
+		return 0, &ErrEmailTaken{}
+	}
+	if !errors.As(err, &nonExisting) {
+		return 0, err
+	}
+
+	username, err := i.freeUsername(ctx, claims)
+	if err != nil {
+		return 0, err
+	}
+	password, err := crypto.NewRandomString(lenPassword)
+	if err != nil {
+		return 0, fmt.Errorf("generate password: %w", err)
+	}
+
+	user := &userEntity.User{
+		Username: username,
+		Email:    claims.Email,
+		Password: password,
+	}
+	if err = i.registrar.Register(ctx, user); err != nil {
+		return 0, err
+	}
+
+	if err = i.users.SetEmailVerified(ctx, user.ID, user.Email); err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
+
+// freeUsername makes the username from the claims, a random suffix is added if it is taken.
+func (i *identityCase) freeUsername(ctx context.Context, claims *oidc.Claims) (string, error) {
+	base := usernameFromClaims(claims)
+	username := base
+	for try := 0; try < triesUsername; try++ {
+		_, err := i.users.GetUserIdByUsername(ctx, username)
+		if errors.Is(err, repository.ErrNoData) {
+			return username, nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		suffix, err := crypto.NewRandomString(lenSuffix)
+		if err != nil {
+			return "", fmt.Errorf("generate username suffix: %w", err)
+		}
+		username = base + suffix
+	}
+	return "", fmt.Errorf("no free username for %s", base)
+}
+
+func usernameFromClaims(claims *oidc.Claims) string {
+	source := claims.PreferredUsername
+	if source == "" {
+		source, _, _ = strings.Cut(claims.Email, "@")
+	}
+
+	username := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			return r
+		}
+		return -1
+	}, source)
+	for len(username) > maxLenUsername {
+		runes := []rune(username)
+		username = string(runes[:len(runes)-1])
+	}
+	if len(username) < minLenUsername {
+		username = "user" + username
+	}
+	return username
+}
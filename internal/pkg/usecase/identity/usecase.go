@@ -0,0 +1,175 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/identity"
+	userEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/identity"
+	userRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/oidc"
+)
+
+const (
+	// AuthRequestLifeTime is the time given to the user to log in at the provider.
+	AuthRequestLifeTime = 10 * time.Minute
+
+	lenState    = 32
+	lenNonce    = 32
+	lenPassword = 32
+)
+
+// Provider is the OpenID Connect provider, it is implemented by oidc.Provider.
+type Provider interface {
+	AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error)
+	Exchange(ctx context.Context, code, verifier, nonce string) (*oidc.Claims, error)
+}
+
+// Registrar creates the profile of the user who logs in with the provider for the first time.
+type Registrar interface {
+	Register(ctx context.Context, user *userEntity.User) error
+}
+
+//go:generate mockgen -destination=./mock/identity_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	Providers() []string
+	BeginLogin(ctx context.Context, provider string) (authURL, state string, err error)
+	BeginLink(ctx context.Context, provider string, userID int) (authURL, state string, err error)
+	Complete(ctx context.Context, state, code string, sessionUserID int) (userID int, linked bool, err error)
+}
+
+type identityCase struct {
+	log       *logger.Logger
+	repo      repo.Repository
+	states    repo.StateRepository
+	users     userRepo.Repository
+	registrar Registrar
+	providers map[string]Provider
+}
+
+func New(log *logger.Logger, repo repo.Repository, states repo.StateRepository, users userRepo.Repository,
+	registrar Registrar, providers map[string]Provider) *identityCase {
+	return &identityCase{
+		log:       log,
+		repo:      repo,
+		states:    states,
+		users:     users,
+		registrar: registrar,
+		providers: providers,
+	}
+}
+
+func (i *identityCase) Providers() []string {
+	names := make([]string, 0, len(i.providers))
+	for name := range i.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BeginLogin returns the url of the provider to which the user is redirected to log in
+// and the state of the flow, which the caller binds to the browser of the user.
+func (i *identityCase) BeginLogin(ctx context.Context, provider string) (string, string, error) {
+	return i.begin(ctx, provider, 0)
+}
+
+// BeginLink is the same as BeginLogin, but the identity is linked to the profile of the logged in user.
+func (i *identityCase) BeginLink(ctx context.Context, provider string, userID int) (string, string, error) {
+	return i.begin(ctx, provider, userID)
+}
+
+func (i *identityCase) begin(ctx context.Context, provider string, linkUserID int) (string, string, error) {
+	p, ok := i.providers[provider]
+	if !ok {
+		return "", "", &ErrUnknownProvider{}
+	}
+
+	state, err := crypto.NewRandomString(lenState)
+	if err != nil {
+		return "", "", fmt.Errorf("generate oidc state: %w", err)
+	}
+	nonce, err := crypto.NewRandomString(lenNonce)
+	if err != nil {
+		return "", "", fmt.Errorf("generate oidc nonce: %w", err)
+	}
+
+	req := &entity.AuthRequest{
+		Provider:   provider,
+		Nonce:      nonce,
+		Verifier:   oidc.NewVerifier(),
+		LinkUserID: linkUserID,
+	}
+	authURL, err := p.AuthCodeURL(ctx, state, req.Nonce, req.Verifier)
+	if err != nil {
+		return "", "", fmt.Errorf("begin oidc login: %w", err)
+	}
+
+	if err = i.states.AddAuthRequest(ctx, state, req, AuthRequestLifeTime); err != nil {
+		return "", "", fmt.Errorf("begin oidc login: %w", err)
+	}
+	return authURL, state, nil
+}
+
+// Complete finishes the flow started by BeginLogin or BeginLink. On login the user with the linked
+// identity is returned, if there is no such user a new profile is registered with the verified email.
+// The link flow is completed only by the session of the user who started it.
+func (i *identityCase) Complete(ctx context.Context, state, code string, sessionUserID int) (int, bool, error) {
+	req, err := i.states.TakeAuthRequest(ctx, state)
+	if err != nil {
+		return 0, false, fmt.Errorf("complete oidc login: %w", err)
+	}
+	if req.LinkUserID != 0 && req.LinkUserID != sessionUserID {
+		return 0, false, &ErrLinkSessionMismatch{}
+	}
+	p, ok := i.providers[req.Provider]
+	if !ok {
+		return 0, false, &ErrUnknownProvider{}
+	}
+
+	claims, err := p.Exchange(ctx, code, req.Verifier, req.Nonce)
+	if err != nil {
+		i.log.Warn("oidc exchange: "+err.Error(), logger.F{"provider", req.Provider})
+		return 0, false, &ErrExternalAuthentication{}
+	}
+
+	var notFound *repo.ErrIdentityNotFound
+	userID, err := i.repo.GetUserIDByIdentity(ctx, req.Provider, claims.Subject)
+	switch {
+	case err == nil && req.LinkUserID == 0:
+		return userID, false, nil
+	case err == nil && userID == req.LinkUserID:
+		return userID, true, nil
+	case err == nil:
+		return 0, false, &repo.ErrIdentityAlreadyLinked{}
+	case !errors.As(err, &notFound):
+		return 0, false, fmt.Errorf("complete oidc login: %w", err)
+	}
+
+	identity := &entity.Identity{
+		Provider: req.Provider,
+		Subject:  claims.Subject,
+		UserID:   req.LinkUserID,
+		Email:    claims.Email,
+	}
+	if req.LinkUserID != 0 {
+		if err = i.repo.AddIdentity(ctx, identity); err != nil {
+			return 0, false, fmt.Errorf("link external identity: %w", err)
+		}
+		return req.LinkUserID, true, nil
+	}
+
+	if identity.UserID, err = i.register(ctx, claims); err != nil {
+		return 0, false, fmt.Errorf("register with external identity: %w", err)
+	}
+	if err = i.repo.AddIdentity(ctx, identity); err != nil {
+		return 0, false, fmt.Errorf("link external identity: %w", err)
+	}
+	return identity.UserID, false, nil
+}
@@ -0,0 +1,178 @@
+package identity
+
+import (
+	"context"
+	"testing"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/identity"
+	userEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
+	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/identity"
+	repoMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/identity/mock"
+	userRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	userMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/identity/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/oidc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type testCase struct {
+	ic        *identityCase
+	repo      *repoMock.MockRepository
+	states    *repoMock.MockStateRepository
+	users     *userMock.MockRepository
+	registrar *mock.MockRegistrar
+	provider  *mock.MockProvider
+}
+
+func newTestCase(t *testing.T) testCase {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tc := testCase{
+		repo:      repoMock.NewMockRepository(ctrl),
+		states:    repoMock.NewMockStateRepository(ctrl),
+		users:     userMock.NewMockRepository(ctrl),
+		registrar: mock.NewMockRegistrar(ctrl),
+		provider:  mock.NewMockProvider(ctrl),
+	}
+	tc.ic = New(log, tc.repo, tc.states, tc.users, tc.registrar, map[string]Provider{"google": tc.provider})
+	return tc
+}
+
+func (tc testCase) expectExchange(ctx context.Context, linkUserID int, claims *oidc.Claims) {
+	req := &entity.AuthRequest{Provider: "google", Nonce: "nonce", Verifier: "verifier", LinkUserID: linkUserID}
+	tc.states.EXPECT().TakeAuthRequest(ctx, "state").Return(req, nil).Times(1)
+	tc.provider.EXPECT().Exchange(ctx, "code", "verifier", "nonce").Return(claims, nil).Times(1)
+}
+
+func TestBeginLogin(t *testing.T) {
+	ctx := context.Background()
+	tc := newTestCase(t)
+
+	var state, nonce, verifier string
+	tc.provider.EXPECT().AuthCodeURL(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s, n, v string) (string, error) {
+			state, nonce, verifier = s, n, v
+			return "https://accounts.google.com/auth", nil
+		}).Times(1)
+	tc.states.EXPECT().AddAuthRequest(ctx, gomock.Any(), gomock.Any(), AuthRequestLifeTime).
+		DoAndReturn(func(_ context.Context, s string, req *entity.AuthRequest, _ interface{}) error {
+			require.Equal(t, state, s)
+			require.Equal(t, &entity.AuthRequest{Provider: "google", Nonce: nonce, Verifier: verifier, LinkUserID: 12}, req)
+			return nil
+		}).Times(1)
+
+	authURL, returnedState, err := tc.ic.BeginLink(ctx, "google", 12)
+	require.NoError(t, err)
+	require.Equal(t, "https://accounts.google.com/auth", authURL)
+	require.Equal(t, state, returnedState)
+
+	_, _, err = tc.ic.BeginLogin(ctx, "unknown")
+	require.ErrorAs(t, err, new(*ErrUnknownProvider))
+}
+
+func TestCompleteLogin(t *testing.T) {
+	ctx := context.Background()
+	claims := &oidc.Claims{Subject: "sub", Email: "green@example.com", EmailVerified: true, PreferredUsername: "Green Peter"}
+
+	t.Run("linked identity", func(t *testing.T) {
+		tc := newTestCase(t)
+		tc.expectExchange(ctx, 0, claims)
+		tc.repo.EXPECT().GetUserIDByIdentity(ctx, "google", "sub").Return(12, nil).Times(1)
+
+		userID, linked, err := tc.ic.Complete(ctx, "state", "code", 0)
+		require.NoError(t, err)
+		require.Equal(t, 12, userID)
+		require.False(t, linked)
+	})
+
+	t.Run("new user", func(t *testing.T) {
+		tc := newTestCase(t)
+		tc.expectExchange(ctx, 0, claims)
+		tc.repo.EXPECT().GetUserIDByIdentity(ctx, "google", "sub").Return(0, &repo.ErrIdentityNotFound{}).Times(1)
+		tc.users.EXPECT().GetUserIdByEmail(ctx, "green@example.com").Return(0, &userRepo.ErrNonExistingUser{}).Times(1)
+		tc.users.EXPECT().GetUserIdByUsername(ctx, "GreenPeter").Return(3, nil).Times(1)
+		tc.users.EXPECT().GetUserIdByUsername(ctx, gomock.Any()).Return(0, repository.ErrNoData).Times(1)
+		tc.registrar.EXPECT().Register(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, user *userEntity.User) error {
+				require.Regexp(t, "^GreenPeter[0-9a-f]{4}$", user.Username)
+				require.Equal(t, "green@example.com", user.Email)
+				require.NotEmpty(t, user.Password)
+				user.ID = 13
+				return nil
+			}).Times(1)
+		tc.users.EXPECT().SetEmailVerified(ctx, 13, "green@example.com").Return(nil).Times(1)
+		tc.repo.EXPECT().AddIdentity(ctx, &entity.Identity{
+			Provider: "google", Subject: "sub", UserID: 13, Email: "green@example.com",
+		}).Return(nil).Times(1)
+
+		userID, linked, err := tc.ic.Complete(ctx, "state", "code", 0)
+		require.NoError(t, err)
+		require.Equal(t, 13, userID)
+		require.False(t, linked)
+	})
+
+	t.Run("email taken", func(t *testing.T) {
+		tc := newTestCase(t)
+		tc.expectExchange(ctx, 0, claims)
+		tc.repo.EXPECT().GetUserIDByIdentity(ctx, "google", "sub").Return(0, &repo.ErrIdentityNotFound{}).Times(1)
+		tc.users.EXPECT().GetUserIdByEmail(ctx, "green@example.com").Return(3, nil).Times(1)
+
+		_, _, err := tc.ic.Complete(ctx, "state", "code", 0)
+		require.ErrorAs(t, err, new(*ErrEmailTaken))
+	})
+
+	t.Run("email not verified", func(t *testing.T) {
+		tc := newTestCase(t)
+		tc.expectExchange(ctx, 0, &oidc.Claims{Subject: "sub", Email: "green@example.com"})
+		tc.repo.EXPECT().GetUserIDByIdentity(ctx, "google", "sub").Return(0, &repo.ErrIdentityNotFound{}).Times(1)
+
+		_, _, err := tc.ic.Complete(ctx, "state", "code", 0)
+		require.ErrorAs(t, err, new(*ErrEmailNotVerified))
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		tc := newTestCase(t)
+		tc.states.EXPECT().TakeAuthRequest(ctx, "state").Return(nil, &repo.ErrAuthRequestNotFound{}).Times(1)
+
+		_, _, err := tc.ic.Complete(ctx, "state", "code", 0)
+		require.ErrorAs(t, err, new(*repo.ErrAuthRequestNotFound))
+	})
+}
+
+func TestCompleteLink(t *testing.T) {
+	ctx := context.Background()
+	claims := &oidc.Claims{Subject: "sub"}
+
+	tc := newTestCase(t)
+	tc.expectExchange(ctx, 12, claims)
+	tc.repo.EXPECT().GetUserIDByIdentity(ctx, "google", "sub").Return(0, &repo.ErrIdentityNotFound{}).Times(1)
+	tc.repo.EXPECT().AddIdentity(ctx, &entity.Identity{Provider: "google", Subject: "sub", UserID: 12}).Return(nil).Times(1)
+
+	userID, linked, err := tc.ic.Complete(ctx, "state", "code", 12)
+	require.NoError(t, err)
+	require.Equal(t, 12, userID)
+	require.True(t, linked)
+
+	tc.expectExchange(ctx, 12, claims)
+	tc.repo.EXPECT().GetUserIDByIdentity(ctx, "google", "sub").Return(13, nil).Times(1)
+
+	_, _, err = tc.ic.Complete(ctx, "state", "code", 12)
+	require.ErrorAs(t, err, new(*repo.ErrIdentityAlreadyLinked))
+
+	// the flow started by the user 12 is not completed by the session of another user or without a session
+	for _, sessionUserID := range []int{13, 0} {
+		tc.states.EXPECT().TakeAuthRequest(ctx, "state").
+			Return(&entity.AuthRequest{Provider: "google", Nonce: "nonce", Verifier: "verifier", LinkUserID: 12}, nil).Times(1)
+
+		_, _, err = tc.ic.Complete(ctx, "state", "code", sessionUserID)
+		require.ErrorAs(t, err, new(*ErrLinkSessionMismatch))
+	}
+}
@@ -7,12 +7,16 @@ import (
 	"strconv"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	mess "github.com/go-park-mail-ru/2023_2_OND_team/internal/api/messenger"
 	messMS "github.com/go-park-mail-ru/2023_2_OND_team/internal/microservices/messenger/delivery/grpc"
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/message"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/block"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/realtime/chat"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 )
 
@@ -44,14 +48,16 @@ func makeErrEventMessage(err error) EventMessage {
 type messageCase struct {
 	client           mess.MessengerClient
 	realtimeChatCase chat.Usecase
+	verifier         user.EmailVerifier
 	log              *logger.Logger
 	realtimeIsEnable bool
 }
 
-func New(log *logger.Logger, cl mess.MessengerClient, rtChatCase chat.Usecase) *messageCase {
+func New(log *logger.Logger, cl mess.MessengerClient, rtChatCase chat.Usecase, verifier user.EmailVerifier) *messageCase {
 	m := &messageCase{
-		client: cl,
-		log:    log,
+		client:   cl,
+		verifier: verifier,
+		log:      log,
 	}
 
 	if rtChatCase != nil {
@@ -63,11 +69,18 @@ func New(log *logger.Logger, cl mess.MessengerClient, rtChatCase chat.Usecase) *
 }
 
 func (m *messageCase) SendMessage(ctx context.Context, userID int, mes *entity.Message) (int, error) {
+	if err := m.verifier.CheckEmailVerified(ctx, userID); err != nil {
+		return 0, fmt.Errorf("send message: %w", err)
+	}
+
 	msgID, err := m.client.SendMessage(setAuthenticatedMetadataCtx(ctx, userID), &mess.Message{
 		UserFrom: int64(userID),
 		UserTo:   int64(mes.To),
 		Content:  mes.Content.String,
 	})
+	if status.Code(err) == codes.PermissionDenied {
+		return 0, fmt.Errorf("send message by grpc client: %w", &block.ErrBlocked{})
+	}
 	if err != nil {
 		return 0, fmt.Errorf("send message by grpc client")
 	}
@@ -0,0 +1,44 @@
+package moderation
+
+import (
+	"fmt"
+
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+)
+
+type ErrInvalidTarget struct {
+	TargetType string
+}
+
+func (e *ErrInvalidTarget) Error() string {
+	return fmt.Sprintf("invalid target type %q", e.TargetType)
+}
+
+func (e *ErrInvalidTarget) Type() errPkg.Type {
+	return errPkg.ErrInvalidInput
+}
+
+type ErrInvalidRole struct {
+	Role string
+}
+
+func (e *ErrInvalidRole) Error() string {
+	return fmt.Sprintf("unknown role %q", e.Role)
+}
+
+func (e *ErrInvalidRole) Type() errPkg.Type {
+	return errPkg.ErrInvalidInput
+}
+
+// ErrForbiddenTarget is returned when the staff member acts on itself or on another staff member.
+type ErrForbiddenTarget struct {
+	Message string
+}
+
+func (e *ErrForbiddenTarget) Error() string {
+	return e.Message
+}
+
+func (e *ErrForbiddenTarget) Type() errPkg.Type {
+	return errPkg.ErrNoAccess
+}
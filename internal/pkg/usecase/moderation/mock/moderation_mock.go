@@ -0,0 +1,166 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	moderation "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/moderation"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetAuditLog mocks base method.
+func (m *MockUsecase) GetAuditLog(ctx context.Context, lastID, count int) ([]moderation.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, lastID, count)
+	ret0, _ := ret[0].([]moderation.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockUsecaseMockRecorder) GetAuditLog(ctx, lastID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockUsecase)(nil).GetAuditLog), ctx, lastID, count)
+}
+
+// GetReports mocks base method.
+func (m *MockUsecase) GetReports(ctx context.Context, resolved bool, lastID, count int) ([]moderation.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReports", ctx, resolved, lastID, count)
+	ret0, _ := ret[0].([]moderation.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockUsecaseMockRecorder) GetReports(ctx, resolved, lastID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockUsecase)(nil).GetReports), ctx, resolved, lastID, count)
+}
+
+// Hide mocks base method.
+func (m *MockUsecase) Hide(ctx context.Context, actorID int, targetType string, targetID int, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hide", ctx, actorID, targetType, targetID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Hide indicates an expected call of Hide.
+func (mr *MockUsecaseMockRecorder) Hide(ctx, actorID, targetType, targetID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hide", reflect.TypeOf((*MockUsecase)(nil).Hide), ctx, actorID, targetType, targetID, reason)
+}
+
+// Report mocks base method.
+func (m *MockUsecase) Report(ctx context.Context, reporterID int, targetType string, targetID int, reason string) (*moderation.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, reporterID, targetType, targetID, reason)
+	ret0, _ := ret[0].(*moderation.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockUsecaseMockRecorder) Report(ctx, reporterID, targetType, targetID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockUsecase)(nil).Report), ctx, reporterID, targetType, targetID, reason)
+}
+
+// ResolveReport mocks base method.
+func (m *MockUsecase) ResolveReport(ctx context.Context, actorID, reportID int, note string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReport", ctx, actorID, reportID, note)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveReport indicates an expected call of ResolveReport.
+func (mr *MockUsecaseMockRecorder) ResolveReport(ctx, actorID, reportID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReport", reflect.TypeOf((*MockUsecase)(nil).ResolveReport), ctx, actorID, reportID, note)
+}
+
+// SetRole mocks base method.
+func (m *MockUsecase) SetRole(ctx context.Context, actorID, userID int, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", ctx, actorID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRole indicates an expected call of SetRole.
+func (mr *MockUsecaseMockRecorder) SetRole(ctx, actorID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockUsecase)(nil).SetRole), ctx, actorID, userID, role)
+}
+
+// SuspendUser mocks base method.
+func (m *MockUsecase) SuspendUser(ctx context.Context, actorID, userID int, duration time.Duration, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", ctx, actorID, userID, duration, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockUsecaseMockRecorder) SuspendUser(ctx, actorID, userID, duration, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*MockUsecase)(nil).SuspendUser), ctx, actorID, userID, duration, reason)
+}
+
+// Unhide mocks base method.
+func (m *MockUsecase) Unhide(ctx context.Context, actorID int, targetType string, targetID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unhide", ctx, actorID, targetType, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unhide indicates an expected call of Unhide.
+func (mr *MockUsecaseMockRecorder) Unhide(ctx, actorID, targetType, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unhide", reflect.TypeOf((*MockUsecase)(nil).Unhide), ctx, actorID, targetType, targetID)
+}
+
+// UnsuspendUser mocks base method.
+func (m *MockUsecase) UnsuspendUser(ctx context.Context, actorID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsuspendUser", ctx, actorID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsuspendUser indicates an expected call of UnsuspendUser.
+func (mr *MockUsecaseMockRecorder) UnsuspendUser(ctx, actorID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsuspendUser", reflect.TypeOf((*MockUsecase)(nil).UnsuspendUser), ctx, actorID, userID)
+}
@@ -0,0 +1,194 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/moderation"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/moderation"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+//go:generate mockgen -destination=./mock/moderation_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	Report(ctx context.Context, reporterID int, targetType string, targetID int, reason string) (*entity.Report, error)
+	GetReports(ctx context.Context, resolved bool, lastID, count int) ([]entity.Report, error)
+	ResolveReport(ctx context.Context, actorID, reportID int, note string) error
+	Hide(ctx context.Context, actorID int, targetType string, targetID int, reason string) error
+	Unhide(ctx context.Context, actorID int, targetType string, targetID int) error
+	SuspendUser(ctx context.Context, actorID, userID int, duration time.Duration, reason string) error
+	UnsuspendUser(ctx context.Context, actorID, userID int) error
+	SetRole(ctx context.Context, actorID, userID int, role string) error
+	GetAuditLog(ctx context.Context, lastID, count int) ([]entity.AuditEntry, error)
+}
+
+type moderationCase struct {
+	log  *logger.Logger
+	repo repo.Repository
+	now  func() time.Time
+}
+
+func New(log *logger.Logger, repo repo.Repository) *moderationCase {
+	return &moderationCase{
+		log:  log,
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+func (m *moderationCase) Report(ctx context.Context, reporterID int, targetType string, targetID int, reason string) (*entity.Report, error) {
+	if !entity.IsValidTarget(targetType) {
+		return nil, &ErrInvalidTarget{TargetType: targetType}
+	}
+	if targetType == entity.TargetUser && targetID == reporterID {
+		return nil, &ErrForbiddenTarget{Message: "you can not report yourself"}
+	}
+
+	exists, err := m.repo.TargetExists(ctx, targetType, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("report: %w", err)
+	}
+	if !exists {
+		return nil, &repo.ErrTargetNotFound{TargetType: targetType}
+	}
+
+	report := &entity.Report{
+		ReporterID: reporterID,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     reason,
+	}
+	if err = m.repo.AddReport(ctx, report); err != nil {
+		return nil, fmt.Errorf("report: %w", err)
+	}
+	return report, nil
+}
+
+func (m *moderationCase) GetReports(ctx context.Context, resolved bool, lastID, count int) ([]entity.Report, error) {
+	reports, err := m.repo.GetReports(ctx, resolved, lastID, count)
+	if err != nil {
+		return nil, fmt.Errorf("get reports: %w", err)
+	}
+	return reports, nil
+}
+
+func (m *moderationCase) ResolveReport(ctx context.Context, actorID, reportID int, note string) error {
+	err := m.repo.ResolveReport(ctx, reportID, newAuditEntry(actorID, entity.ActionResolveReport, "report", reportID, note))
+	if err != nil {
+		return fmt.Errorf("resolve report: %w", err)
+	}
+	return nil
+}
+
+func (m *moderationCase) Hide(ctx context.Context, actorID int, targetType string, targetID int, reason string) error {
+	if !entity.IsHideable(targetType) {
+		return &ErrInvalidTarget{TargetType: targetType}
+	}
+
+	err := m.repo.SetHidden(ctx, targetType, targetID, true, newAuditEntry(actorID, entity.ActionHide, targetType, targetID, reason))
+	if err != nil {
+		return fmt.Errorf("hide %s: %w", targetType, err)
+	}
+	m.log.Info("content is hidden", logger.F{"target", targetType}, logger.F{"id", targetID}, logger.F{"actor_id", actorID})
+	return nil
+}
+
+func (m *moderationCase) Unhide(ctx context.Context, actorID int, targetType string, targetID int) error {
+	if !entity.IsHideable(targetType) {
+		return &ErrInvalidTarget{TargetType: targetType}
+	}
+
+	err := m.repo.SetHidden(ctx, targetType, targetID, false, newAuditEntry(actorID, entity.ActionUnhide, targetType, targetID, ""))
+	if err != nil {
+		return fmt.Errorf("unhide %s: %w", targetType, err)
+	}
+	return nil
+}
+
+// SuspendUser suspends the user for the duration, the zero duration suspends until it is lifted.
+// The staff members can not be suspended until their role is taken away.
+func (m *moderationCase) SuspendUser(ctx context.Context, actorID, userID int, duration time.Duration, reason string) error {
+	if err := m.checkTargetUser(ctx, actorID, userID); err != nil {
+		return fmt.Errorf("suspend user: %w", err)
+	}
+
+	var until *time.Time
+	details := reason
+	if duration > 0 {
+		t := m.now().Add(duration)
+		until = &t
+		details = fmt.Sprintf("%s (until %s)", reason, t.UTC().Format(time.RFC3339))
+	}
+
+	err := m.repo.SuspendUser(ctx, userID, until, reason, newAuditEntry(actorID, entity.ActionSuspendUser, entity.TargetUser, userID, details))
+	if err != nil {
+		return fmt.Errorf("suspend user: %w", err)
+	}
+	m.log.Info("user is suspended", logger.F{"user_id", userID}, logger.F{"actor_id", actorID})
+	return nil
+}
+
+func (m *moderationCase) UnsuspendUser(ctx context.Context, actorID, userID int) error {
+	err := m.repo.UnsuspendUser(ctx, userID, newAuditEntry(actorID, entity.ActionUnsuspendUser, entity.TargetUser, userID, ""))
+	if err != nil {
+		return fmt.Errorf("unsuspend user: %w", err)
+	}
+	return nil
+}
+
+// SetRole does not allow to change the own role, so there is always at least one admin.
+func (m *moderationCase) SetRole(ctx context.Context, actorID, userID int, role string) error {
+	if !user.IsValidRole(role) {
+		return &ErrInvalidRole{Role: role}
+	}
+	if actorID == userID {
+		return &ErrForbiddenTarget{Message: "you can not change your own role"}
+	}
+
+	oldRole, err := m.repo.GetRole(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("set role: %w", err)
+	}
+
+	err = m.repo.SetRole(ctx, userID, role, newAuditEntry(actorID, entity.ActionSetRole, entity.TargetUser, userID, oldRole+" -> "+role))
+	if err != nil {
+		return fmt.Errorf("set role: %w", err)
+	}
+	m.log.Info("role of the user is changed", logger.F{"user_id", userID}, logger.F{"role", role}, logger.F{"actor_id", actorID})
+	return nil
+}
+
+func (m *moderationCase) GetAuditLog(ctx context.Context, lastID, count int) ([]entity.AuditEntry, error) {
+	entries, err := m.repo.GetAuditLog(ctx, lastID, count)
+	if err != nil {
+		return nil, fmt.Errorf("get audit log: %w", err)
+	}
+	return entries, nil
+}
+
+func (m *moderationCase) checkTargetUser(ctx context.Context, actorID, userID int) error {
+	if actorID == userID {
+		return &ErrForbiddenTarget{Message: "you can not act on your own account"}
+	}
+
+	role, err := m.repo.GetRole(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if role != user.RoleUser {
+		return &ErrForbiddenTarget{Message: "the staff member can not be suspended, take away the role first"}
+	}
+	return nil
+}
+
+func newAuditEntry(actorID int, action, targetType string, targetID int, details string) *entity.AuditEntry {
+	return &entity.AuditEntry{
+		ActorID:    &actorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Details:    details,
+	}
+}
@@ -0,0 +1,117 @@
+package moderation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/moderation"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/moderation"
+	repoMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/moderation/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+func TestSuspendUser(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := repoMock.NewMockRepository(ctrl)
+	mc := New(log, repository)
+	now := time.Now()
+	mc.now = func() time.Time { return now }
+
+	repository.EXPECT().GetRole(ctx, 12).Return(user.RoleUser, nil).Times(1)
+	repository.EXPECT().SuspendUser(ctx, 12, gomock.Any(), "spam", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, until *time.Time, _ string, entry *entity.AuditEntry) error {
+			require.Equal(t, now.Add(24*time.Hour), *until)
+			require.Equal(t, 1, *entry.ActorID)
+			require.Equal(t, entity.ActionSuspendUser, entry.Action)
+			require.Equal(t, 12, entry.TargetID)
+			return nil
+		}).Times(1)
+
+	err = mc.SuspendUser(ctx, 1, 12, 24*time.Hour, "spam")
+	require.NoError(t, err)
+
+	err = mc.SuspendUser(ctx, 1, 1, 0, "spam")
+	require.ErrorAs(t, err, new(*ErrForbiddenTarget))
+
+	repository.EXPECT().GetRole(ctx, 5).Return(user.RoleModerator, nil).Times(1)
+	err = mc.SuspendUser(ctx, 1, 5, 0, "spam")
+	require.ErrorAs(t, err, new(*ErrForbiddenTarget))
+}
+
+func TestSetRole(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := repoMock.NewMockRepository(ctrl)
+	mc := New(log, repository)
+
+	repository.EXPECT().GetRole(ctx, 12).Return(user.RoleUser, nil).Times(1)
+	repository.EXPECT().SetRole(ctx, 12, user.RoleModerator, newAuditEntry(1, entity.ActionSetRole,
+		entity.TargetUser, 12, "user -> moderator")).Return(nil).Times(1)
+
+	err = mc.SetRole(ctx, 1, 12, user.RoleModerator)
+	require.NoError(t, err)
+
+	err = mc.SetRole(ctx, 1, 12, "owner")
+	require.ErrorAs(t, err, new(*ErrInvalidRole))
+
+	err = mc.SetRole(ctx, 1, 1, user.RoleUser)
+	require.ErrorAs(t, err, new(*ErrForbiddenTarget))
+}
+
+func TestReport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := repoMock.NewMockRepository(ctrl)
+	mc := New(log, repository)
+
+	repository.EXPECT().TargetExists(ctx, entity.TargetPin, 7).Return(true, nil).Times(1)
+	repository.EXPECT().AddReport(ctx, &entity.Report{
+		ReporterID: 3,
+		TargetType: entity.TargetPin,
+		TargetID:   7,
+		Reason:     "spam",
+	}).Return(nil).Times(1)
+
+	_, err = mc.Report(ctx, 3, entity.TargetPin, 7, "spam")
+	require.NoError(t, err)
+
+	repository.EXPECT().TargetExists(ctx, entity.TargetPin, 8).Return(false, nil).Times(1)
+	_, err = mc.Report(ctx, 3, entity.TargetPin, 8, "spam")
+	require.ErrorAs(t, err, new(*repo.ErrTargetNotFound))
+
+	_, err = mc.Report(ctx, 3, "message", 8, "spam")
+	require.ErrorAs(t, err, new(*ErrInvalidTarget))
+
+	_, err = mc.Report(ctx, 3, entity.TargetUser, 3, "spam")
+	require.ErrorAs(t, err, new(*ErrForbiddenTarget))
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ConfirmReset mocks base method.
+func (m *MockUsecase) ConfirmReset(ctx context.Context, token, newPassword string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmReset", ctx, token, newPassword)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmReset indicates an expected call of ConfirmReset.
+func (mr *MockUsecaseMockRecorder) ConfirmReset(ctx, token, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmReset", reflect.TypeOf((*MockUsecase)(nil).ConfirmReset), ctx, token, newPassword)
+}
+
+// RequestReset mocks base method.
+func (m *MockUsecase) RequestReset(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestReset", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestReset indicates an expected call of RequestReset.
+func (mr *MockUsecaseMockRecorder) RequestReset(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReset", reflect.TypeOf((*MockUsecase)(nil).RequestReset), ctx, email)
+}
+
+// MockPasswordSetter is a mock of PasswordSetter interface.
+type MockPasswordSetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordSetterMockRecorder
+}
+
+// MockPasswordSetterMockRecorder is the mock recorder for MockPasswordSetter.
+type MockPasswordSetterMockRecorder struct {
+	mock *MockPasswordSetter
+}
+
+// NewMockPasswordSetter creates a new mock instance.
+func NewMockPasswordSetter(ctrl *gomock.Controller) *MockPasswordSetter {
+	mock := &MockPasswordSetter{ctrl: ctrl}
+	mock.recorder = &MockPasswordSetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordSetter) EXPECT() *MockPasswordSetterMockRecorder {
+	return m.recorder
+}
+
+// SetPassword mocks base method.
+func (m *MockPasswordSetter) SetPassword(ctx context.Context, userID int, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPassword", ctx, userID, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPassword indicates an expected call of SetPassword.
+func (mr *MockPasswordSetterMockRecorder) SetPassword(ctx, userID, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPassword", reflect.TypeOf((*MockPasswordSetter)(nil).SetPassword), ctx, userID, password)
+}
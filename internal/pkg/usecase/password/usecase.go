@@ -0,0 +1,100 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tokenRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/token"
+	userRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/mail"
+)
+
+const (
+	ResetTokenLifeTime = time.Hour
+	lenResetToken      = 32
+)
+
+var ErrInvalidResetToken = errors.New("invalid or expired password reset token")
+
+//go:generate mockgen -destination=./mock/password_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	RequestReset(ctx context.Context, email string) error
+	ConfirmReset(ctx context.Context, token, newPassword string) (int, error)
+}
+
+type PasswordSetter interface {
+	SetPassword(ctx context.Context, userID int, password string) error
+}
+
+type passwordCase struct {
+	log      *logger.Logger
+	userRepo userRepo.Repository
+	tokens   tokenRepo.Repository
+	setter   PasswordSetter
+	sender   mail.Sender
+	resetURL string
+}
+
+// New creates a password reset usecase, resetURL is the page of the client
+// to which the reset token is appended as a query parameter.
+func New(log *logger.Logger, userRepo userRepo.Repository, tokens tokenRepo.Repository,
+	setter PasswordSetter, sender mail.Sender, resetURL string) *passwordCase {
+	return &passwordCase{
+		log:      log,
+		userRepo: userRepo,
+		tokens:   tokens,
+		setter:   setter,
+		sender:   sender,
+		resetURL: resetURL,
+	}
+}
+
+func (p *passwordCase) RequestReset(ctx context.Context, email string) error {
+	userID, err := p.userRepo.GetUserIdByEmail(ctx, email)
+	if err != nil {
+		// the caller must not find out whether there is an account with this email
+		p.log.Info("password reset requested for unknown email", logger.F{"error", err.Error()})
+		return nil
+	}
+
+	token, err := crypto.NewRandomString(lenResetToken)
+	if err != nil {
+		return fmt.Errorf("generate password reset token: %w", err)
+	}
+
+	err = p.tokens.AddToken(ctx, token, userID, ResetTokenLifeTime)
+	if err != nil {
+		return fmt.Errorf("request password reset: %w", err)
+	}
+
+	err = p.sender.Send(ctx, mail.Message{
+		To:      email,
+		Subject: "Pinspire password reset",
+		Body: fmt.Sprintf("To reset your password follow the link: %s?token=%s\nThe link is valid for %d minutes.",
+			p.resetURL, token, int(ResetTokenLifeTime.Minutes())),
+	})
+	if err != nil {
+		return fmt.Errorf("send password reset email: %w", err)
+	}
+	return nil
+}
+
+func (p *passwordCase) ConfirmReset(ctx context.Context, token, newPassword string) (int, error) {
+	userID, err := p.tokens.TakeUserIDByToken(ctx, token)
+	if errors.Is(err, tokenRepo.ErrNotFoundToken) {
+		return 0, ErrInvalidResetToken
+	}
+	if err != nil {
+		return 0, fmt.Errorf("confirm password reset: %w", err)
+	}
+
+	err = p.setter.SetPassword(ctx, userID, newPassword)
+	if err != nil {
+		return 0, fmt.Errorf("confirm password reset: %w", err)
+	}
+	return userID, nil
+}
@@ -0,0 +1,93 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tokenRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/token"
+	tokenMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/token/mock"
+	userMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/password/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/mail"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type senderStub struct {
+	sent []mail.Message
+}
+
+func (s *senderStub) Send(_ context.Context, msg mail.Message) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func TestRequestReset(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users := userMock.NewMockRepository(ctrl)
+	tokens := tokenMock.NewMockRepository(ctrl)
+	sender := &senderStub{}
+	resetURL := "https://pinspire.online/password/reset"
+	pc := New(log, users, tokens, mock.NewMockPasswordSetter(ctrl), sender, resetURL)
+
+	expEmail := "clickkk@gmail.com"
+	var token string
+	users.EXPECT().GetUserIdByEmail(ctx, expEmail).Return(12, nil).Times(1)
+	tokens.EXPECT().
+		AddToken(ctx, gomock.Any(), 12, ResetTokenLifeTime).
+		DoAndReturn(func(_ context.Context, t string, _ int, _ interface{}) error {
+			token = t
+			return nil
+		}).
+		Times(1)
+
+	require.NoError(t, pc.RequestReset(ctx, expEmail))
+	require.Len(t, sender.sent, 1)
+	require.Equal(t, expEmail, sender.sent[0].To)
+	require.True(t, strings.Contains(sender.sent[0].Body, resetURL+"?token="+token))
+
+	users.EXPECT().GetUserIdByEmail(ctx, "unknown@gmail.com").Return(0, errors.New("not found")).Times(1)
+
+	require.NoError(t, pc.RequestReset(ctx, "unknown@gmail.com"))
+	require.Len(t, sender.sent, 1)
+}
+
+func TestConfirmReset(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokens := tokenMock.NewMockRepository(ctrl)
+	setter := mock.NewMockPasswordSetter(ctrl)
+	pc := New(log, userMock.NewMockRepository(ctrl), tokens, setter, &senderStub{}, "")
+
+	tokens.EXPECT().TakeUserIDByToken(ctx, "valid").Return(12, nil).Times(1)
+	setter.EXPECT().SetPassword(ctx, 12, "new_password").Return(nil).Times(1)
+
+	userID, err := pc.ConfirmReset(ctx, "valid", "new_password")
+	require.NoError(t, err)
+	require.Equal(t, 12, userID)
+
+	tokens.EXPECT().TakeUserIDByToken(ctx, "used").Return(0, tokenRepo.ErrNotFoundToken).Times(1)
+
+	_, err = pc.ConfirmReset(ctx, "used", "new_password")
+	require.ErrorIs(t, err, ErrInvalidResetToken)
+}
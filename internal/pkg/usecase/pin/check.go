@@ -15,6 +15,9 @@ var (
 	ErrForbiddenAction = errors.New("this action is not available to the user")
 	ErrEmptyBatch      = errors.New("an empty batch was received")
 	ErrSizeBatch       = errors.New("the batch size exceeds the maximum possible")
+	ErrBadPublishTime  = errors.New("the publication time should be in the future and not set for a draft")
+	ErrBadSourceURL    = errors.New("the source url should be an absolute http or https url")
+	ErrNoSourceURL     = errors.New("pin has no source url")
 )
 
 const MaxSizeBatchPin = 100
@@ -50,8 +53,22 @@ func (p *pinCase) isAvailablePinForViewingUser(ctx context.Context, pin *entity.
 	if pin.DeletedAt.Valid {
 		return ErrPinDeleted
 	}
+	if pin.Author.ID == userID {
+		return nil
+	}
+	if !pin.Published() {
+		return ErrPinNotAccess
+	}
 
-	if pin.Public || pin.Author.ID == userID {
+	if pin.Public {
+		// the public pins of the private profile are seen only by its approved followers
+		ok, err := p.users.CheckProfileAccess(ctx, pin.Author.ID, userID)
+		if err != nil {
+			return fmt.Errorf("fail check available pin: %w", err)
+		}
+		if !ok {
+			return ErrPinNotAccess
+		}
 		return nil
 	}
 	if userID == user.UserUnknown {
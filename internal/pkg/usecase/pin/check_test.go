@@ -69,7 +69,7 @@ func TestIsAvailablePinForFixOnBoard(t *testing.T) {
 	}
 
 	repo := mock.NewMockRepository(ctrl)
-	pinCase := New(log, nil, repo)
+	pinCase := New(log, nil, repo, nil, nil, nil, nil)
 	pinID, userID := 123, 1
 	pin := &entity.Pin{Author: &user.User{ID: userID}, DeletedAt: pgtype.Timestamptz{Valid: true}}
 
@@ -0,0 +1,41 @@
+package pin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/image"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/validator/image/check"
+)
+
+var ErrPicturesNumber = fmt.Errorf("the pin should have from 1 to %d pictures", entity.MaxImages)
+
+// Picture is the image uploaded for the pin.
+type Picture struct {
+	MimeType string
+	Size     int64
+	Content  io.Reader
+}
+
+// uploadPictures checks and saves the pictures in the given order, it stops at the first invalid one.
+func (p *pinCase) uploadPictures(ctx context.Context, pictures []Picture) ([]string, error) {
+	if len(pictures) == 0 || len(pictures) > entity.MaxImages {
+		return nil, ErrPicturesNumber
+	}
+
+	images := make([]string, 0, len(pictures))
+	for _, picture := range pictures {
+		url, err := p.UploadImage(ctx, "pins/", picture.MimeType, picture.Size, picture.Content, check.BothSidesFallIntoRange(100, 6000))
+		if err != nil {
+			if errors.Is(err, image.ErrExplicitImage) {
+				return nil, image.ErrExplicitImage
+			}
+			return nil, fmt.Errorf("uploading a picture of the pin: %w", err)
+		}
+		images = append(images, url)
+	}
+	return images, nil
+}
@@ -3,13 +3,24 @@ package pin
 import (
 	"context"
 	"fmt"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
 )
 
 func (p *pinCase) SetLikeFromUser(ctx context.Context, pinID, userID int) (int, error) {
 	if err := p.isAvailablePinForSetLike(ctx, pinID, userID); err != nil {
 		return 0, fmt.Errorf("set like from user: %w", err)
 	}
-	return p.repo.SetLike(ctx, pinID, userID)
+
+	countLikes, err := p.repo.SetLike(ctx, pinID, userID)
+	if err == repository.ErrNoDataAffected {
+		return 0, ErrPinNotAccess
+	}
+	if err == nil && p.trackIsEnable {
+		p.tracker.Track(pinID, analytics.EventLike)
+	}
+	return countLikes, err
 }
 
 func (p *pinCase) DeleteLikeFromUser(ctx context.Context, pinID, userID int) (int, error) {
@@ -10,7 +10,9 @@ import (
 
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/pin/mock"
+	mockUser "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user/mock"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 )
 
@@ -26,7 +28,7 @@ func TestSetLikeOnAvailablePin(t *testing.T) {
 	}
 
 	repo := mock.NewMockRepository(ctrl)
-	pinCase := New(log, nil, repo)
+	pinCase := New(log, nil, repo, nil, nil, nil, nil)
 	wantCountLike := 12
 	pinID, userID := 123, 1
 	pin := &entity.Pin{
@@ -79,7 +81,7 @@ func TestSetLikeOnNotAvailablePin(t *testing.T) {
 	}
 
 	repo := mock.NewMockRepository(ctrl)
-	pinCase := New(log, nil, repo)
+	pinCase := New(log, nil, repo, nil, nil, nil, nil)
 	wantCountLike := 0
 	pinID, userID := 123, 1
 	pin := &entity.Pin{
@@ -128,6 +130,56 @@ func TestSetLikeOnNotAvailablePin(t *testing.T) {
 	require.Equal(t, wantCountLike, actualCoutnLike)
 }
 
+func TestSetLikeOnPinOfPrivateProfile(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	users := mockUser.NewMockUsecase(ctrl)
+	pinCase := New(log, nil, repo, users, nil, nil, nil)
+	pinID, userID := 123, 1
+	pin := &entity.Pin{
+		ID:     pinID,
+		Author: &user.User{ID: 7},
+		Public: true,
+	}
+
+	repo.EXPECT().
+		GetPinByID(ctx, pinID, false).
+		Return(pin, nil).
+		Times(1)
+	users.EXPECT().
+		CheckProfileAccess(ctx, 7, userID).
+		Return(false, nil).
+		Times(1)
+
+	_, actualErr := pinCase.SetLikeFromUser(ctx, pinID, userID)
+	require.ErrorIs(t, actualErr, ErrPinNotAccess)
+
+	repo.EXPECT().
+		GetPinByID(ctx, pinID, false).
+		Return(pin, nil).
+		Times(1)
+	users.EXPECT().
+		CheckProfileAccess(ctx, 7, userID).
+		Return(true, nil).
+		Times(1)
+	repo.EXPECT().
+		SetLike(ctx, pinID, userID).
+		Return(0, repository.ErrNoDataAffected).
+		Times(1)
+
+	_, actualErr = pinCase.SetLikeFromUser(ctx, pinID, userID)
+	require.ErrorIs(t, actualErr, ErrPinNotAccess)
+}
+
 func TestDeleteLikeFromUser(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -140,7 +192,7 @@ func TestDeleteLikeFromUser(t *testing.T) {
 	}
 
 	repo := mock.NewMockRepository(ctrl)
-	pinCase := New(log, nil, repo)
+	pinCase := New(log, nil, repo, nil, nil, nil, nil)
 	pinID, userID := 123, 1
 	wantCountLike := 999
 	repo.EXPECT().
@@ -165,7 +217,7 @@ func TestCheckUserHasSetLike(t *testing.T) {
 	}
 
 	repo := mock.NewMockRepository(ctrl)
-	pinCase := New(log, nil, repo)
+	pinCase := New(log, nil, repo, nil, nil, nil, nil)
 	pinID, userID := 123, 1
 
 	repo.EXPECT().
@@ -6,9 +6,9 @@ package mock
 
 import (
 	context "context"
-	io "io"
 	reflect "reflect"
 
+	analytics "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
 	pin "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
 	pin0 "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/pin"
 	gomock "github.com/golang/mock/gomock"
@@ -53,17 +53,17 @@ func (mr *MockUsecaseMockRecorder) CheckUserHasSetLike(ctx, pinID, userID interf
 }
 
 // CreateNewPin mocks base method.
-func (m *MockUsecase) CreateNewPin(ctx context.Context, pin *pin.Pin, mimeTypePicture string, sizePicture int64, picture io.Reader) error {
+func (m *MockUsecase) CreateNewPin(ctx context.Context, pin *pin.Pin, pictures []pin0.Picture) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNewPin", ctx, pin, mimeTypePicture, sizePicture, picture)
+	ret := m.ctrl.Call(m, "CreateNewPin", ctx, pin, pictures)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateNewPin indicates an expected call of CreateNewPin.
-func (mr *MockUsecaseMockRecorder) CreateNewPin(ctx, pin, mimeTypePicture, sizePicture, picture interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) CreateNewPin(ctx, pin, pictures interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewPin", reflect.TypeOf((*MockUsecase)(nil).CreateNewPin), ctx, pin, mimeTypePicture, sizePicture, picture)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewPin", reflect.TypeOf((*MockUsecase)(nil).CreateNewPin), ctx, pin, pictures)
 }
 
 // DeleteLikeFromUser mocks base method.
@@ -137,6 +137,36 @@ func (mr *MockUsecaseMockRecorder) IsAvailablePinForFixOnBoard(ctx, pinID, userI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAvailablePinForFixOnBoard", reflect.TypeOf((*MockUsecase)(nil).IsAvailablePinForFixOnBoard), ctx, pinID, userID)
 }
 
+// OpenSourceURL mocks base method.
+func (m *MockUsecase) OpenSourceURL(ctx context.Context, pinID, userID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenSourceURL", ctx, pinID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenSourceURL indicates an expected call of OpenSourceURL.
+func (mr *MockUsecaseMockRecorder) OpenSourceURL(ctx, pinID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenSourceURL", reflect.TypeOf((*MockUsecase)(nil).OpenSourceURL), ctx, pinID, userID)
+}
+
+// PublishScheduledPins mocks base method.
+func (m *MockUsecase) PublishScheduledPins(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduledPins", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduledPins indicates an expected call of PublishScheduledPins.
+func (mr *MockUsecaseMockRecorder) PublishScheduledPins(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduledPins", reflect.TypeOf((*MockUsecase)(nil).PublishScheduledPins), ctx)
+}
+
 // SetLikeFromUser mocks base method.
 func (m *MockUsecase) SetLikeFromUser(ctx context.Context, pinID, userID int) (int, error) {
 	m.ctrl.T.Helper()
@@ -181,3 +211,156 @@ func (mr *MockUsecaseMockRecorder) ViewFeedPin(ctx, userID, cfg interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewFeedPin", reflect.TypeOf((*MockUsecase)(nil).ViewFeedPin), ctx, userID, cfg)
 }
+
+// Mocktimeline is a mock of timeline interface.
+type Mocktimeline struct {
+	ctrl     *gomock.Controller
+	recorder *MocktimelineMockRecorder
+}
+
+// MocktimelineMockRecorder is the mock recorder for Mocktimeline.
+type MocktimelineMockRecorder struct {
+	mock *Mocktimeline
+}
+
+// NewMocktimeline creates a new mock instance.
+func NewMocktimeline(ctrl *gomock.Controller) *Mocktimeline {
+	mock := &Mocktimeline{ctrl: ctrl}
+	mock.recorder = &MocktimelineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocktimeline) EXPECT() *MocktimelineMockRecorder {
+	return m.recorder
+}
+
+// GetFollowingFeed mocks base method.
+func (m *Mocktimeline) GetFollowingFeed(ctx context.Context, userID int, cfg pin.FeedPinConfig) (pin.FeedPin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowingFeed", ctx, userID, cfg)
+	ret0, _ := ret[0].(pin.FeedPin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowingFeed indicates an expected call of GetFollowingFeed.
+func (mr *MocktimelineMockRecorder) GetFollowingFeed(ctx, userID, cfg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowingFeed", reflect.TypeOf((*Mocktimeline)(nil).GetFollowingFeed), ctx, userID, cfg)
+}
+
+// PushPin mocks base method.
+func (m *Mocktimeline) PushPin(ctx context.Context, authorID, pinID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushPin", ctx, authorID, pinID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushPin indicates an expected call of PushPin.
+func (mr *MocktimelineMockRecorder) PushPin(ctx, authorID, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushPin", reflect.TypeOf((*Mocktimeline)(nil).PushPin), ctx, authorID, pinID)
+}
+
+// RemovePin mocks base method.
+func (m *Mocktimeline) RemovePin(ctx context.Context, pinID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePin", ctx, pinID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePin indicates an expected call of RemovePin.
+func (mr *MocktimelineMockRecorder) RemovePin(ctx, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePin", reflect.TypeOf((*Mocktimeline)(nil).RemovePin), ctx, pinID)
+}
+
+// Mockpreviews is a mock of previews interface.
+type Mockpreviews struct {
+	ctrl     *gomock.Controller
+	recorder *MockpreviewsMockRecorder
+}
+
+// MockpreviewsMockRecorder is the mock recorder for Mockpreviews.
+type MockpreviewsMockRecorder struct {
+	mock *Mockpreviews
+}
+
+// NewMockpreviews creates a new mock instance.
+func NewMockpreviews(ctrl *gomock.Controller) *Mockpreviews {
+	mock := &Mockpreviews{ctrl: ctrl}
+	mock.recorder = &MockpreviewsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockpreviews) EXPECT() *MockpreviewsMockRecorder {
+	return m.recorder
+}
+
+// FetchPreview mocks base method.
+func (m *Mockpreviews) FetchPreview(ctx context.Context, pinID int, sourceURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPreview", ctx, pinID, sourceURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FetchPreview indicates an expected call of FetchPreview.
+func (mr *MockpreviewsMockRecorder) FetchPreview(ctx, pinID, sourceURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPreview", reflect.TypeOf((*Mockpreviews)(nil).FetchPreview), ctx, pinID, sourceURL)
+}
+
+// GetPreview mocks base method.
+func (m *Mockpreviews) GetPreview(ctx context.Context, pinID int) (*pin.LinkPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreview", ctx, pinID)
+	ret0, _ := ret[0].(*pin.LinkPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreview indicates an expected call of GetPreview.
+func (mr *MockpreviewsMockRecorder) GetPreview(ctx, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreview", reflect.TypeOf((*Mockpreviews)(nil).GetPreview), ctx, pinID)
+}
+
+// Mocktracker is a mock of tracker interface.
+type Mocktracker struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrackerMockRecorder
+}
+
+// MocktrackerMockRecorder is the mock recorder for Mocktracker.
+type MocktrackerMockRecorder struct {
+	mock *Mocktracker
+}
+
+// NewMocktracker creates a new mock instance.
+func NewMocktracker(ctrl *gomock.Controller) *Mocktracker {
+	mock := &Mocktracker{ctrl: ctrl}
+	mock.recorder = &MocktrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocktracker) EXPECT() *MocktrackerMockRecorder {
+	return m.recorder
+}
+
+// Track mocks base method.
+func (m *Mocktracker) Track(pinID int, event analytics.EventType) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Track", pinID, event)
+}
+
+// Track indicates an expected call of Track.
+func (mr *MocktrackerMockRecorder) Track(pinID, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*Mocktracker)(nil).Track), pinID, event)
+}
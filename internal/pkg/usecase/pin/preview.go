@@ -0,0 +1,39 @@
+package pin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
+)
+
+const _timeoutPreview = 30 * time.Second
+
+// OpenSourceURL returns the source url of the pin available to the user and counts the outbound click.
+func (p *pinCase) OpenSourceURL(ctx context.Context, pinID, userID int) (string, error) {
+	pin, err := p.repo.GetPinByID(ctx, pinID, false)
+	if err != nil {
+		return "", fmt.Errorf("get a pin to open its source: %w", err)
+	}
+	if err = p.isAvailablePinForViewingUser(ctx, pin, userID); err != nil {
+		return "", err
+	}
+	if !pin.SourceURL.Valid {
+		return "", ErrNoSourceURL
+	}
+
+	if p.trackIsEnable && pin.Author.ID != userID {
+		p.tracker.Track(pinID, analytics.EventClick)
+	}
+	return pin.SourceURL.String, nil
+}
+
+func (p *pinCase) fetchPreview(pinID int, sourceURL string) {
+	ctx, cancel := context.WithTimeout(context.Background(), _timeoutPreview)
+	defer cancel()
+
+	if err := p.previews.FetchPreview(ctx, pinID, sourceURL); err != nil {
+		p.log.Warn(err.Error())
+	}
+}
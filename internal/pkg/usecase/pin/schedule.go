@@ -0,0 +1,30 @@
+package pin
+
+import (
+	"context"
+	"fmt"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+)
+
+// publishHooks runs everything that follows the publication of the pin.
+func (p *pinCase) publishHooks(pin *entity.Pin) {
+	if pin.Public {
+		go p.pushToTimelines(pin.Author.ID, pin.ID)
+	}
+}
+
+// PublishScheduledPins publishes the pins whose publication time has come and returns their number.
+// The feeds are ordered by the pin id, so the published pin takes the place of its creation time
+// in them rather than of its publication time, the timelines keep the same order.
+func (p *pinCase) PublishScheduledPins(ctx context.Context) (int, error) {
+	pins, err := p.repo.PublishScheduledPins(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("publish scheduled pins: %w", err)
+	}
+
+	for ind := range pins {
+		p.publishHooks(&pins[ind])
+	}
+	return len(pins), nil
+}
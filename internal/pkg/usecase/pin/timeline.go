@@ -0,0 +1,26 @@
+package pin
+
+import (
+	"context"
+	"time"
+)
+
+const _timeoutTimeline = time.Minute
+
+func (p *pinCase) pushToTimelines(authorID, pinID int) {
+	ctx, cancel := context.WithTimeout(context.Background(), _timeoutTimeline)
+	defer cancel()
+
+	if err := p.timeline.PushPin(ctx, authorID, pinID); err != nil {
+		p.log.Warn(err.Error())
+	}
+}
+
+func (p *pinCase) removeFromTimelines(pinID int) {
+	ctx, cancel := context.WithTimeout(context.Background(), _timeoutTimeline)
+	defer cancel()
+
+	if err := p.timeline.RemovePin(ctx, pinID); err != nil {
+		p.log.Warn(err.Error())
+	}
+}
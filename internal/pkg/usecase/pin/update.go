@@ -3,8 +3,12 @@ package pin
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/pin"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/image"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/linkpreview"
 )
 
 //go:generate easyjson update.go
@@ -15,9 +19,24 @@ type PinUpdateData struct {
 	Description *string  `json:"description"`
 	Public      *bool    `json:"public"`
 	Tags        []string `json:"tags"`
+	// Draft set to false publishes the draft, right away or at PublishAt
+	Draft     *bool      `json:"draft"`
+	PublishAt *time.Time `json:"publish_at"`
+	// SourceURL set to the empty string removes the link of the pin
+	SourceURL *string `json:"source_url"`
+	// Pictures replace all the images of the pin when they are set
+	Pictures []Picture `json:"-"`
 }
 
 func (p *pinCase) EditPinByID(ctx context.Context, pinID, userID int, updateData *PinUpdateData) error {
+	editedPin, err := p.repo.GetPinByID(ctx, pinID, false)
+	if err != nil {
+		return fmt.Errorf("get the pin to edit: %w", err)
+	}
+	if editedPin.Author.ID != userID || editedPin.DeletedAt.Valid {
+		return ErrForbiddenAction
+	}
+
 	data := pin.S{}
 	if updateData.Title != nil {
 		data["title"] = *updateData.Title
@@ -28,9 +47,49 @@ func (p *pinCase) EditPinByID(ctx context.Context, pinID, userID int, updateData
 	if updateData.Public != nil {
 		data["public"] = *updateData.Public
 	}
-	err := p.repo.EditPin(ctx, pinID, userID, data, updateData.Tags)
-	if err != nil {
+	if updateData.PublishAt != nil {
+		// only the draft or the scheduled pin can be rescheduled, the published pin stays visible
+		if editedPin.Published() || !updateData.PublishAt.After(time.Now()) || updateData.Draft != nil && *updateData.Draft {
+			return ErrBadPublishTime
+		}
+		data["draft"] = false
+		data["publish_at"] = *updateData.PublishAt
+	} else if updateData.Draft != nil {
+		data["draft"] = *updateData.Draft
+		if !*updateData.Draft {
+			data["publish_at"] = pin.PublishNow
+		}
+	}
+
+	if updateData.SourceURL != nil {
+		if *updateData.SourceURL == "" {
+			data["source_url"] = nil
+		} else if _, err := linkpreview.ParseURL(*updateData.SourceURL); err != nil {
+			return ErrBadSourceURL
+		} else {
+			data["source_url"] = *updateData.SourceURL
+		}
+	}
+
+	var images []string
+	if updateData.Pictures != nil {
+		if images, err = p.uploadPictures(ctx, updateData.Pictures); err != nil {
+			if err == image.ErrExplicitImage || err == ErrPicturesNumber {
+				return err
+			}
+			return fmt.Errorf("edit pin by id: %w", err)
+		}
+	}
+
+	if err = p.repo.EditPin(ctx, pinID, userID, data, updateData.Tags, images); err != nil {
+		if err == repository.ErrNoDataAffected {
+			return ErrForbiddenAction
+		}
 		return fmt.Errorf("edit pin by id: %w", err)
 	}
+
+	if updateData.SourceURL != nil && *updateData.SourceURL != "" {
+		go p.fetchPreview(pinID, *updateData.SourceURL)
+	}
 	return nil
 }
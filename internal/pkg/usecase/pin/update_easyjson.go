@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -89,6 +90,38 @@ func easyjson2d86586fDecodeGithubComGoParkMailRu20232ONDTeamInternalPkgUsecasePi
 				}
 				in.Delim(']')
 			}
+		case "draft":
+			if in.IsNull() {
+				in.Skip()
+				out.Draft = nil
+			} else {
+				if out.Draft == nil {
+					out.Draft = new(bool)
+				}
+				*out.Draft = bool(in.Bool())
+			}
+		case "publish_at":
+			if in.IsNull() {
+				in.Skip()
+				out.PublishAt = nil
+			} else {
+				if out.PublishAt == nil {
+					out.PublishAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PublishAt).UnmarshalJSON(data))
+				}
+			}
+		case "source_url":
+			if in.IsNull() {
+				in.Skip()
+				out.SourceURL = nil
+			} else {
+				if out.SourceURL == nil {
+					out.SourceURL = new(string)
+				}
+				*out.SourceURL = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -146,6 +179,33 @@ func easyjson2d86586fEncodeGithubComGoParkMailRu20232ONDTeamInternalPkgUsecasePi
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"draft\":"
+		out.RawString(prefix)
+		if in.Draft == nil {
+			out.RawString("null")
+		} else {
+			out.Bool(bool(*in.Draft))
+		}
+	}
+	{
+		const prefix string = ",\"publish_at\":"
+		out.RawString(prefix)
+		if in.PublishAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.PublishAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"source_url\":"
+		out.RawString(prefix)
+		if in.SourceURL == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.SourceURL))
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -3,7 +3,10 @@ package pin
 import (
 	"context"
 	"testing"
+	"time"
 
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	repository "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/pin"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/pin/mock"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
@@ -23,7 +26,7 @@ func TestEditPinByID(t *testing.T) {
 	}
 
 	repo := mock.NewMockRepository(ctrl)
-	pinCase := New(log, nil, repo)
+	pinCase := New(log, nil, repo, nil, nil, nil, nil)
 	pinID, userID := 123, 1
 	tags := []string{"new", "tag"}
 
@@ -35,11 +38,68 @@ func TestEditPinByID(t *testing.T) {
 	}
 
 	repo.EXPECT().
-		EditPin(ctx, pinID, repository.S{
+		GetPinByID(ctx, pinID, false).
+		Return(&entity.Pin{ID: pinID, Author: &user.User{ID: userID}}, nil).
+		Times(1)
+	repo.EXPECT().
+		EditPin(ctx, pinID, userID, repository.S{
 			"title":       "",
 			"description": "",
 			"public":      false,
-		}, tags).
+		}, tags, nil).
+		Return(nil).
+		Times(1)
+
+	err = pinCase.EditPinByID(ctx, pinID, userID, updateData)
+	require.NoError(t, err)
+
+	// the pictures of the other user's pin are not uploaded
+	repo.EXPECT().
+		GetPinByID(ctx, pinID, false).
+		Return(&entity.Pin{ID: pinID, Author: &user.User{ID: userID + 1}}, nil).
+		Times(1)
+
+	updateData.Pictures = []Picture{{MimeType: "image/webp", Size: 45}}
+	err = pinCase.EditPinByID(ctx, pinID, userID, updateData)
+	require.ErrorIs(t, err, ErrForbiddenAction)
+}
+
+func TestEditPinByIDReschedule(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	pinCase := New(log, nil, repo, nil, nil, nil, nil)
+	pinID, userID := 123, 1
+	publishAt := time.Now().Add(time.Hour)
+	updateData := &PinUpdateData{PublishAt: &publishAt}
+
+	// the published pin isn't hidden by the publication time
+	repo.EXPECT().
+		GetPinByID(ctx, pinID, false).
+		Return(&entity.Pin{ID: pinID, Author: &user.User{ID: userID}}, nil).
+		Times(1)
+
+	err = pinCase.EditPinByID(ctx, pinID, userID, updateData)
+	require.ErrorIs(t, err, ErrBadPublishTime)
+
+	draft := &entity.Pin{ID: pinID, Author: &user.User{ID: userID}, Draft: true}
+	repo.EXPECT().
+		GetPinByID(ctx, pinID, false).
+		Return(draft, nil).
+		Times(1)
+	repo.EXPECT().
+		EditPin(ctx, pinID, userID, repository.S{
+			"draft":      false,
+			"publish_at": publishAt,
+		}, nil, nil).
 		Return(nil).
 		Times(1)
 
@@ -4,15 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
+	"time"
 
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
 	userEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/pin"
+	previewRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/preview"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/image"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/linkpreview"
 	log "github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
-	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/validator/image/check"
 )
 
 var ErrBadMIMEType = errors.New("bad mime type")
@@ -20,7 +23,7 @@ var ErrBadMIMEType = errors.New("bad mime type")
 //go:generate mockgen -destination=./mock/pin_mock.go -package=mock -source=usecase.go Usecase
 type Usecase interface {
 	ViewFeedPin(ctx context.Context, userID int, cfg pin.FeedPinConfig) (pin.FeedPin, error)
-	CreateNewPin(ctx context.Context, pin *entity.Pin, mimeTypePicture string, sizePicture int64, picture io.Reader) error
+	CreateNewPin(ctx context.Context, pin *entity.Pin, pictures []Picture) error
 	DeletePinFromUser(ctx context.Context, pinID, userID int) error
 	SetLikeFromUser(ctx context.Context, pinID, userID int) (int, error)
 	CheckUserHasSetLike(ctx context.Context, pinID, userID int) (bool, error)
@@ -29,42 +32,102 @@ type Usecase interface {
 	ViewAnPin(ctx context.Context, pinID, userID int) (*entity.Pin, error)
 	IsAvailablePinForFixOnBoard(ctx context.Context, pinID, userID int) error
 	IsAvailableBatchPinForFixOnBoard(ctx context.Context, pinID []int, userID int) error
+	PublishScheduledPins(ctx context.Context) (int, error)
+	OpenSourceURL(ctx context.Context, pinID, userID int) (string, error)
+}
+
+// users checks the email of the author of the new pin and the access of the viewer to the profile of the pin author.
+type users interface {
+	user.EmailVerifier
+	CheckProfileAccess(ctx context.Context, ownerID, viewerID int) (bool, error)
+}
+
+type timeline interface {
+	GetFollowingFeed(ctx context.Context, userID int, cfg pin.FeedPinConfig) (pin.FeedPin, error)
+	PushPin(ctx context.Context, authorID, pinID int) error
+	RemovePin(ctx context.Context, pinID int) error
+}
+
+type previews interface {
+	FetchPreview(ctx context.Context, pinID int, sourceURL string) error
+	GetPreview(ctx context.Context, pinID int) (*pin.LinkPreview, error)
+}
+
+type tracker interface {
+	Track(pinID int, event analytics.EventType)
 }
 
 type pinCase struct {
 	image.Usecase
-	log  *log.Logger
-	repo repo.Repository
+	log      *log.Logger
+	repo     repo.Repository
+	users    users
+	timeline timeline
+	previews previews
+	tracker  tracker
+
+	trackIsEnable bool
 }
 
-func New(log *log.Logger, imgCase image.Usecase, repo repo.Repository) *pinCase {
+func New(log *log.Logger, imgCase image.Usecase, repo repo.Repository, users users, timeline timeline, previews previews, tracker tracker) *pinCase {
 	return &pinCase{
-		Usecase: imgCase,
-		log:     log,
-		repo:    repo,
+		Usecase:  imgCase,
+		log:      log,
+		repo:     repo,
+		users:    users,
+		timeline: timeline,
+		previews: previews,
+		tracker:  tracker,
+
+		trackIsEnable: tracker != nil,
 	}
 }
 
-func (p *pinCase) CreateNewPin(ctx context.Context, pin *entity.Pin, mimeTypePicture string, sizePicture int64, picture io.Reader) error {
-	picturePin, err := p.UploadImage(ctx, "pins/", mimeTypePicture, sizePicture, picture, check.BothSidesFallIntoRange(100, 6000))
+func (p *pinCase) CreateNewPin(ctx context.Context, pin *entity.Pin, pictures []Picture) error {
+	if pin.PublishAt.Valid && (pin.Draft || !pin.PublishAt.Time.After(time.Now())) {
+		return ErrBadPublishTime
+	}
+	if pin.SourceURL.Valid {
+		if _, err := linkpreview.ParseURL(pin.SourceURL.String); err != nil {
+			return ErrBadSourceURL
+		}
+	}
+
+	if err := p.users.CheckEmailVerified(ctx, pin.Author.ID); err != nil {
+		return fmt.Errorf("create new pin: %w", err)
+	}
+
+	images, err := p.uploadPictures(ctx, pictures)
 	if err != nil {
-		if err == image.ErrExplicitImage {
+		if err == image.ErrExplicitImage || err == ErrPicturesNumber {
 			return err
 		}
-		return fmt.Errorf("uploading an avatar when creating pin: %w", err)
+		return fmt.Errorf("uploading pictures when creating pin: %w", err)
 	}
-	pin.Picture = picturePin
+	pin.Images = images
+	pin.Picture = images[0]
 
 	err = p.repo.AddNewPin(ctx, pin)
 	if err != nil {
 		return fmt.Errorf("add new pin: %w", err)
 	}
 
+	if pin.Published() {
+		p.publishHooks(pin)
+	}
+	if pin.SourceURL.Valid {
+		go p.fetchPreview(pin.ID, pin.SourceURL.String)
+	}
 	return nil
 }
 
 func (p *pinCase) DeletePinFromUser(ctx context.Context, pinID, userID int) error {
-	return p.repo.DeletePin(ctx, pinID, userID)
+	if err := p.repo.DeletePin(ctx, pinID, userID); err != nil {
+		return err
+	}
+
+	go p.removeFromTimelines(pinID)
+	return nil
 }
 
 func (p *pinCase) ViewAnPin(ctx context.Context, pinID, userID int) (*entity.Pin, error) {
@@ -85,11 +148,32 @@ func (p *pinCase) ViewAnPin(ctx context.Context, pinID, userID int) (*entity.Pin
 	if err != nil {
 		p.log.Error(err.Error())
 	}
+	pin.Images, err = p.repo.GetImagesByPinID(ctx, pinID)
+	if err != nil {
+		p.log.Error(err.Error())
+	}
+	pin.CountSave, err = p.repo.GetCountSaveByPinID(ctx, pinID)
+	if err != nil {
+		p.log.Error(err.Error())
+	}
+	pin.Attribution, err = p.repo.GetAttributionByPinID(ctx, pinID)
+	if err != nil {
+		p.log.Error(err.Error())
+	}
+	if p.trackIsEnable && pin.Author.ID != userID {
+		p.tracker.Track(pinID, analytics.EventCloseup)
+	}
+	if pin.SourceURL.Valid {
+		pin.Preview, err = p.previews.GetPreview(ctx, pinID)
+		if err != nil && !errors.As(err, new(*previewRepo.ErrNoPreview)) {
+			p.log.Error(err.Error())
+		}
+	}
 
 	return pin, nil
 }
 
-func (p *pinCase) ViewFeedPin(ctx context.Context, userID int, cfg pin.FeedPinConfig) (pin.FeedPin, error) {
+func (p *pinCase) ViewFeedPin(ctx context.Context, userID int, cfg pin.FeedPinConfig) (feed pin.FeedPin, err error) {
 	if cfg.Count > 1000 || cfg.Count <= 0 {
 		return pin.FeedPin{}, ErrForbiddenAction
 	}
@@ -101,11 +185,50 @@ func (p *pinCase) ViewFeedPin(ctx context.Context, userID int, cfg pin.FeedPinCo
 		return pin.FeedPin{}, ErrForbiddenAction
 	}
 
+	if cfg.FollowedBoards && userID == userEntity.UserUnknown {
+		return pin.FeedPin{}, ErrForbiddenAction
+	}
+
 	if !hasBoard && (userID == userEntity.UserUnknown || !hasUser || userID != user) && cfg.Protection != pin.FeedProtectionPublic {
 		return pin.FeedPin{}, ErrForbiddenAction
 	}
 
-	return p.repo.GetFeedPins(ctx, cfg)
+	if cfg.Following {
+		// with nothing to follow the home feed falls back to the public one
+		if cfg.Following, err = p.hasFollowingSources(ctx, userID); err != nil {
+			return pin.FeedPin{}, err
+		}
+	}
+
+	if userID != userEntity.UserUnknown {
+		cfg.SetViewer(userID)
+	}
+	if cfg.Following {
+		feed, err = p.timeline.GetFollowingFeed(ctx, userID, cfg)
+	} else {
+		feed, err = p.repo.GetFeedPins(ctx, cfg)
+	}
+
+	// the author looking through the feeds does not make impressions on their own pins
+	if err == nil && p.trackIsEnable {
+		for _, pin := range feed.Pins {
+			if pin.Author == nil || pin.Author.ID != userID {
+				p.tracker.Track(pin.ID, analytics.EventImpression)
+			}
+		}
+	}
+	return feed, err
+}
+
+func (p *pinCase) hasFollowingSources(ctx context.Context, userID int) (bool, error) {
+	if userID == userEntity.UserUnknown {
+		return false, nil
+	}
+	has, err := p.repo.HasFollowingSources(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("view following feed: %w", err)
+	}
+	return has, nil
 }
 
 func (p *pinCase) GetAuthorIdOfThePin(ctx context.Context, pinID int) (int, error) {
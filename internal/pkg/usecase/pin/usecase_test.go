@@ -4,15 +4,21 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/analytics"
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/pin/mock"
+	mockAnalytics "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/analytics/mock"
 	mockImage "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/image/mock"
+	mockTimeline "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/timeline/mock"
+	userUsecase "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user"
+	mockUser "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/user/mock"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 )
 
@@ -28,7 +34,7 @@ func TestSelectNewPins(t *testing.T) {
 	}
 
 	repo := mock.NewMockRepository(ctrl)
-	pinCase := New(log, nil, repo)
+	pinCase := New(log, nil, repo, nil, nil, nil, nil)
 	count, minID, maxID := 3, 2, 7
 	wantPins := []entity.Pin{{ID: 9}, {ID: 8}, {ID: 1}}
 	wantMin, wantMax := 1, 9
@@ -70,7 +76,7 @@ func TestSelectUserPins(t *testing.T) {
 	}
 
 	repo := mock.NewMockRepository(ctrl)
-	pinCase := New(log, nil, repo)
+	pinCase := New(log, nil, repo, nil, nil, nil, nil)
 	count, minID, maxID := 3, 2, 7
 	wantPins := []entity.Pin{{ID: 9}, {ID: 8}, {ID: 1}}
 	wantMin, wantMax := 1, 9
@@ -115,26 +121,47 @@ func TestCreateNewPin(t *testing.T) {
 
 	repo := mock.NewMockRepository(ctrl)
 	imgCase := mockImage.NewMockUsecase(ctrl)
-	pinCase := New(log, imgCase, repo)
+	verifier := mockUser.NewMockUsecase(ctrl)
+	pinCase := New(log, imgCase, repo, verifier, nil, nil, nil)
 	mimeType, size := "image/webp", int64(45)
 	filename := "filename.webp"
 	pin := &entity.Pin{
-		ID: 34,
+		ID:     34,
+		Author: &user.User{ID: 16},
 	}
 
+	verifier.EXPECT().
+		CheckEmailVerified(ctx, 16).
+		Return(nil).
+		Times(1)
+
 	imgCase.EXPECT().
 		UploadImage("pins/", mimeType, size, nil, gomock.Any()).
 		Return(filename, nil).
 		Times(1)
 
 	pin.Picture = filename
+	pin.Images = []string{filename}
 	repo.EXPECT().
 		AddNewPin(ctx, pin).
 		Return(nil).
 		Times(1)
 
-	err = pinCase.CreateNewPin(ctx, pin, "image/webp", size, nil)
+	err = pinCase.CreateNewPin(ctx, pin, []Picture{{MimeType: mimeType, Size: size}})
 	require.NoError(t, err)
+
+	wantErr := &userUsecase.ErrEmailNotVerified{}
+	verifier.EXPECT().
+		CheckEmailVerified(ctx, 16).
+		Return(wantErr).
+		Times(1)
+
+	err = pinCase.CreateNewPin(ctx, pin, []Picture{{MimeType: mimeType, Size: size}})
+	require.ErrorIs(t, err, wantErr)
+
+	pin.SetSourceURL("javascript:alert(1)")
+	err = pinCase.CreateNewPin(ctx, pin, []Picture{{MimeType: mimeType, Size: size}})
+	require.ErrorIs(t, err, ErrBadSourceURL)
 }
 
 func TestDeletePinFromUser(t *testing.T) {
@@ -149,7 +176,7 @@ func TestDeletePinFromUser(t *testing.T) {
 	}
 
 	repo := mock.NewMockRepository(ctrl)
-	pinCase := New(log, nil, repo)
+	pinCase := New(log, nil, repo, nil, nil, nil, nil)
 	pinID, userID := 8, 16
 
 	wantErr := errors.New("returned err")
@@ -174,10 +201,13 @@ func TestViewAnPin(t *testing.T) {
 	}
 
 	repo := mock.NewMockRepository(ctrl)
-	pinCase := New(log, nil, repo)
+	users := mockUser.NewMockUsecase(ctrl)
+	pinCase := New(log, nil, repo, users, nil, nil, nil)
 	pinID, userID := 44, 90
 	countLike := 22
 	tags := []entity.Tag{{Title: "good"}, {Title: "home"}}
+	countSave := 3
+	attribution := []entity.Attribution{{PinID: 12, Author: &user.User{ID: 7, Username: "green"}}}
 	wantPin := entity.Pin{
 		Title:  pgtype.Text{String: "someone else 's public pin", Valid: true},
 		Author: &user.User{ID: 100},
@@ -187,13 +217,142 @@ func TestViewAnPin(t *testing.T) {
 	*actualPin = wantPin
 
 	repo.EXPECT().GetPinByID(ctx, pinID, true).Return(actualPin, nil).Times(1)
+	users.EXPECT().CheckProfileAccess(ctx, 100, userID).Return(true, nil).Times(1)
 	repo.EXPECT().GetCountLikeByPinID(ctx, pinID).Return(countLike, nil).Times(1)
 	repo.EXPECT().GetTagsByPinID(ctx, pinID).Return(tags, nil).Times(1)
+	repo.EXPECT().GetImagesByPinID(ctx, pinID).Return([]string{"/pic1", "/pic2"}, nil).Times(1)
+	repo.EXPECT().GetCountSaveByPinID(ctx, pinID).Return(countSave, nil).Times(1)
+	repo.EXPECT().GetAttributionByPinID(ctx, pinID).Return(attribution, nil).Times(1)
 
 	wantPin.CountLike = countLike
 	wantPin.Tags = tags
+	wantPin.Images = []string{"/pic1", "/pic2"}
+	wantPin.CountSave = countSave
+	wantPin.Attribution = attribution
 
 	actualPin, actualErr := pinCase.ViewAnPin(ctx, pinID, userID)
 	require.NoError(t, actualErr)
 	require.Equal(t, wantPin, *actualPin)
 }
+
+func TestPublishScheduledPins(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	pinCase := New(log, nil, repo, nil, nil, nil, nil)
+
+	repo.EXPECT().
+		PublishScheduledPins(ctx).
+		Return([]entity.Pin{{ID: 3, Author: &user.User{ID: 7}}, {ID: 5, Author: &user.User{ID: 7}}}, nil).
+		Times(1)
+
+	published, err := pinCase.PublishScheduledPins(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, published)
+
+	draft := &entity.Pin{Author: &user.User{ID: 7}, Draft: true}
+	draft.SetPublishAt(time.Now().Add(time.Hour))
+	err = pinCase.CreateNewPin(ctx, draft, []Picture{{MimeType: "image/webp", Size: 45}})
+	require.ErrorIs(t, err, ErrBadPublishTime)
+
+	tooMany := make([]Picture, entity.MaxImages+1)
+	verifier := mockUser.NewMockUsecase(ctrl)
+	verifier.EXPECT().CheckEmailVerified(ctx, 7).Return(nil).Times(1)
+	pinCase = New(log, nil, repo, verifier, nil)
+	err = pinCase.CreateNewPin(ctx, &entity.Pin{Author: &user.User{ID: 7}}, tooMany)
+	require.ErrorIs(t, err, ErrPicturesNumber)
+}
+
+func TestViewFollowingFeed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	timelineCase := mockTimeline.NewMockUsecase(ctrl)
+	pinCase := New(log, nil, repo, nil, timelineCase, nil, nil)
+	userID := 7
+	wantFeed := entity.FeedPin{Pins: []entity.Pin{{ID: 9}, {ID: 4}}}
+
+	newCfg := func() entity.FeedPinConfig {
+		return entity.FeedPinConfig{Count: 20, Protection: entity.FeedProtectionPublic, Following: true}
+	}
+
+	// the user with the followed sources gets the timeline
+	repo.EXPECT().HasFollowingSources(ctx, userID).Return(true, nil).Times(1)
+	wantCfg := newCfg()
+	wantCfg.SetViewer(userID)
+	timelineCase.EXPECT().GetFollowingFeed(ctx, userID, wantCfg).Return(wantFeed, nil).Times(1)
+
+	feed, err := pinCase.ViewFeedPin(ctx, userID, newCfg())
+	require.NoError(t, err)
+	require.Equal(t, wantFeed, feed)
+
+	// with nothing to follow the feed falls back to the public one
+	repo.EXPECT().HasFollowingSources(ctx, userID).Return(false, nil).Times(1)
+	wantCfg.Following = false
+	repo.EXPECT().GetFeedPins(ctx, wantCfg).Return(wantFeed, nil).Times(1)
+
+	feed, err = pinCase.ViewFeedPin(ctx, userID, newCfg())
+	require.NoError(t, err)
+	require.Equal(t, wantFeed, feed)
+
+	// the unknown user gets the public feed without the check
+	wantCfg = newCfg()
+	wantCfg.Following = false
+	repo.EXPECT().GetFeedPins(ctx, wantCfg).Return(wantFeed, nil).Times(1)
+
+	feed, err = pinCase.ViewFeedPin(ctx, user.UserUnknown, newCfg())
+	require.NoError(t, err)
+	require.Equal(t, wantFeed, feed)
+
+	repo.EXPECT().HasFollowingSources(ctx, userID).Return(false, errors.New("db is down")).Times(1)
+	_, err = pinCase.ViewFeedPin(ctx, userID, newCfg())
+	require.Error(t, err)
+}
+
+func TestViewFeedPinImpressions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	tracker := mockAnalytics.NewMockUsecase(ctrl)
+	pinCase := New(log, nil, repo, nil, nil, nil, tracker)
+	userID := 7
+
+	cfg := entity.FeedPinConfig{Count: 20, Protection: entity.FeedProtectionPublic}
+	wantCfg := cfg
+	wantCfg.SetViewer(userID)
+	repo.EXPECT().GetFeedPins(ctx, wantCfg).Return(entity.FeedPin{Pins: []entity.Pin{
+		{ID: 9, Author: &user.User{ID: 3}},
+		{ID: 8, Author: &user.User{ID: userID}},
+		{ID: 4, Author: &user.User{ID: 5}},
+	}}, nil).Times(1)
+	// the own pin of the viewer in the public feed is not an impression
+	tracker.EXPECT().Track(9, analytics.EventImpression).Times(1)
+	tracker.EXPECT().Track(4, analytics.EventImpression).Times(1)
+
+	_, err = pinCase.ViewFeedPin(ctx, userID, cfg)
+	require.NoError(t, err)
+}
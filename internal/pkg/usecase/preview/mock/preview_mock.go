@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pin "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	linkpreview "github.com/go-park-mail-ru/2023_2_OND_team/pkg/linkpreview"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// FetchPreview mocks base method.
+func (m *MockUsecase) FetchPreview(ctx context.Context, pinID int, sourceURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPreview", ctx, pinID, sourceURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FetchPreview indicates an expected call of FetchPreview.
+func (mr *MockUsecaseMockRecorder) FetchPreview(ctx, pinID, sourceURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPreview", reflect.TypeOf((*MockUsecase)(nil).FetchPreview), ctx, pinID, sourceURL)
+}
+
+// GetPreview mocks base method.
+func (m *MockUsecase) GetPreview(ctx context.Context, pinID int) (*pin.LinkPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreview", ctx, pinID)
+	ret0, _ := ret[0].(*pin.LinkPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreview indicates an expected call of GetPreview.
+func (mr *MockUsecaseMockRecorder) GetPreview(ctx, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreview", reflect.TypeOf((*MockUsecase)(nil).GetPreview), ctx, pinID)
+}
+
+// Mockfetcher is a mock of fetcher interface.
+type Mockfetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockfetcherMockRecorder
+}
+
+// MockfetcherMockRecorder is the mock recorder for Mockfetcher.
+type MockfetcherMockRecorder struct {
+	mock *Mockfetcher
+}
+
+// NewMockfetcher creates a new mock instance.
+func NewMockfetcher(ctrl *gomock.Controller) *Mockfetcher {
+	mock := &Mockfetcher{ctrl: ctrl}
+	mock.recorder = &MockfetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockfetcher) EXPECT() *MockfetcherMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *Mockfetcher) Fetch(ctx context.Context, rawURL string) (linkpreview.Preview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", ctx, rawURL)
+	ret0, _ := ret[0].(linkpreview.Preview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockfetcherMockRecorder) Fetch(ctx, rawURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*Mockfetcher)(nil).Fetch), ctx, rawURL)
+}
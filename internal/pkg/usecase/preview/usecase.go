@@ -0,0 +1,62 @@
+package preview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/microcosm-cc/bluemonday"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	previewRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/preview"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/linkpreview"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+//go:generate mockgen -destination=./mock/preview_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	FetchPreview(ctx context.Context, pinID int, sourceURL string) error
+	GetPreview(ctx context.Context, pinID int) (*pin.LinkPreview, error)
+}
+
+type fetcher interface {
+	Fetch(ctx context.Context, rawURL string) (linkpreview.Preview, error)
+}
+
+type previewCase struct {
+	log       *logger.Logger
+	repo      previewRepo.Repository
+	fetcher   fetcher
+	sanitizer *bluemonday.Policy
+}
+
+func New(log *logger.Logger, repo previewRepo.Repository, fetcher fetcher, sanitizer *bluemonday.Policy) *previewCase {
+	return &previewCase{
+		log:       log,
+		repo:      repo,
+		fetcher:   fetcher,
+		sanitizer: sanitizer,
+	}
+}
+
+// FetchPreview loads the page by the source url of the pin and saves its preview.
+func (p *previewCase) FetchPreview(ctx context.Context, pinID int, sourceURL string) error {
+	page, err := p.fetcher.Fetch(ctx, sourceURL)
+	if err != nil {
+		return fmt.Errorf("fetch link preview for pin %d: %w", pinID, err)
+	}
+
+	preview := pin.LinkPreview{
+		URL:         sourceURL,
+		Title:       p.sanitizer.Sanitize(page.Title),
+		Description: p.sanitizer.Sanitize(page.Description),
+		Image:       page.Image,
+	}
+	if err = p.repo.SetPreview(ctx, pinID, preview); err != nil {
+		return fmt.Errorf("fetch link preview for pin %d: %w", pinID, err)
+	}
+	return nil
+}
+
+func (p *previewCase) GetPreview(ctx context.Context, pinID int) (*pin.LinkPreview, error) {
+	return p.repo.GetPreview(ctx, pinID)
+}
@@ -0,0 +1,59 @@
+package preview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/preview/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/linkpreview"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+type stubFetcher map[string]linkpreview.Preview
+
+func (s stubFetcher) Fetch(_ context.Context, rawURL string) (linkpreview.Preview, error) {
+	preview, ok := s[rawURL]
+	if !ok {
+		return linkpreview.Preview{}, linkpreview.ErrNoPreview
+	}
+	return preview, nil
+}
+
+func TestFetchPreview(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	fetcher := stubFetcher{
+		"https://example.com/pie": {
+			URL:         "https://example.com/pie",
+			Title:       "Apple <b>pie</b>",
+			Description: `The classic recipe<script>alert("x")</script>`,
+			Image:       "https://example.com/pie.jpg",
+		},
+	}
+	uc := New(log, repo, fetcher, bluemonday.StrictPolicy())
+
+	repo.EXPECT().SetPreview(ctx, 3, pin.LinkPreview{
+		URL:         "https://example.com/pie",
+		Title:       "Apple pie",
+		Description: "The classic recipe",
+		Image:       "https://example.com/pie.jpg",
+	}).Return(nil).Times(1)
+	require.NoError(t, uc.FetchPreview(ctx, 3, "https://example.com/pie"))
+
+	err = uc.FetchPreview(ctx, 4, "https://example.com/empty")
+	require.ErrorIs(t, err, linkpreview.ErrNoPreview)
+}
@@ -0,0 +1,15 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/notification"
+)
+
+func (n *notificationClient) NotifyNewPinsOnBoard(ctx context.Context, boardID int) error {
+	if err := n.publishNotify(ctx, entity.NotifyBoardPins, boardID); err != nil {
+		return fmt.Errorf("notify new pins on board: %w", err)
+	}
+	return nil
+}
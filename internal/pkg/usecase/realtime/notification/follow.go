@@ -0,0 +1,22 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/notification"
+)
+
+func (n *notificationClient) NotifyFollowRequest(ctx context.Context, requestID int) error {
+	if err := n.publishNotify(ctx, entity.NotifyFollowRequest, requestID); err != nil {
+		return fmt.Errorf("notify follow request: %w", err)
+	}
+	return nil
+}
+
+func (n *notificationClient) NotifyFollowApproved(ctx context.Context, requestID int) error {
+	if err := n.publishNotify(ctx, entity.NotifyFollowApproved, requestID); err != nil {
+		return fmt.Errorf("notify follow approved: %w", err)
+	}
+	return nil
+}
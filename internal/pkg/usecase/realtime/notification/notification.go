@@ -16,6 +16,9 @@ var ErrNotifierNotRegistered = errors.New("notifier with this type not registere
 
 type Usecase interface {
 	NotifyCommentLeftOnPin(ctx context.Context, commentID int) error
+	NotifyFollowRequest(ctx context.Context, requestID int) error
+	NotifyFollowApproved(ctx context.Context, requestID int) error
+	NotifyNewPinsOnBoard(ctx context.Context, boardID int) error
 }
 
 type notificationClient struct {
@@ -99,3 +102,30 @@ func (n *notificationClient) pipelineNotify(chRecv <-chan realtime.Pack, chSend
 		chSend <- msg
 	}
 }
+
+func (n *notificationClient) publishNotify(ctx context.Context, t entity.NotifyType, entityID int) error {
+	notifier, ok := n.notifiers[t]
+	if !ok {
+		n.log.Error(ErrNotifierNotRegistered.Error())
+		return ErrNotifierNotRegistered
+	}
+
+	chanName, data, err := notifier.ChannelNameForPublishWithData(ctx, entityID)
+	if err != nil {
+		n.log.Error(err.Error())
+		return fmt.Errorf("receive channel name for publish: %w", err)
+	}
+
+	err = n.client.Publish(ctx, chanName, &rt.Message_Content{
+		Content: &rt.EventMap{
+			Type: int64(t),
+			M:    data,
+		},
+	})
+	if err != nil {
+		n.log.Error(err.Error())
+		return fmt.Errorf("publish to client: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package recommendation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	recRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/recommendation"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+const (
+	_maxCandidates = 100
+	_cacheLifetime = 30 * time.Minute
+)
+
+//go:generate mockgen -destination=./mock/recommendation_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	GetRecommendations(ctx context.Context, userID, count, offset int) ([]user.Recommendation, error)
+	InvalidateRecommendations(ctx context.Context, userID int) error
+}
+
+type recommendationCase struct {
+	log       *logger.Logger
+	repo      recRepo.Repository
+	cache     recRepo.CacheRepository
+	sanitizer *bluemonday.Policy
+}
+
+func New(log *logger.Logger, repo recRepo.Repository, cache recRepo.CacheRepository, sanitizer *bluemonday.Policy) *recommendationCase {
+	return &recommendationCase{
+		log:       log,
+		repo:      repo,
+		cache:     cache,
+		sanitizer: sanitizer,
+	}
+}
+
+// GetRecommendations returns the page of the accounts suggested to the user to follow.
+// The whole ranked list is computed once and kept in the cache until it expires
+// or the social graph of the user changes.
+func (r *recommendationCase) GetRecommendations(ctx context.Context, userID, count, offset int) ([]user.Recommendation, error) {
+	recs, err := r.cache.GetRecommendations(ctx, userID)
+	if err != nil {
+		if !errors.As(err, new(*recRepo.ErrNotCached)) {
+			r.log.Warn(err.Error())
+		}
+
+		recs, err = r.repo.GetCandidates(ctx, userID, _maxCandidates)
+		if err != nil {
+			return nil, fmt.Errorf("get recommendations: %w", err)
+		}
+		if err = r.cache.SetRecommendations(ctx, userID, recs, _cacheLifetime); err != nil {
+			r.log.Warn(err.Error())
+		}
+	}
+
+	if offset >= len(recs) {
+		return []user.Recommendation{}, nil
+	}
+	recs = recs[offset:min(offset+count, len(recs))]
+	for i := range recs {
+		recs[i].Sanitize(r.sanitizer)
+	}
+	return recs, nil
+}
+
+func (r *recommendationCase) InvalidateRecommendations(ctx context.Context, userID int) error {
+	if err := r.cache.DeleteRecommendations(ctx, userID); err != nil {
+		return fmt.Errorf("invalidate recommendations: %w", err)
+	}
+	return nil
+}
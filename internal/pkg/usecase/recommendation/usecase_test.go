@@ -0,0 +1,59 @@
+package recommendation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	recRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/recommendation"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/recommendation/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+func TestGetRecommendations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	cache := mock.NewMockCacheRepository(ctrl)
+	uc := New(log, repo, cache, bluemonday.UGCPolicy())
+
+	candidates := []user.Recommendation{
+		{ID: 7, Username: "baobab", Score: 9},
+		{ID: 4, Username: "green", Score: 5},
+		{ID: 3, Username: "peter", Score: 2},
+	}
+
+	cache.EXPECT().GetRecommendations(ctx, 1).Return(nil, &recRepo.ErrNotCached{}).Times(1)
+	repo.EXPECT().GetCandidates(ctx, 1, _maxCandidates).Return(candidates, nil).Times(1)
+	cache.EXPECT().SetRecommendations(ctx, 1, candidates, _cacheLifetime).Return(nil).Times(1)
+	recs, err := uc.GetRecommendations(ctx, 1, 2, 0)
+	require.NoError(t, err)
+	require.Equal(t, candidates[:2], recs)
+
+	cache.EXPECT().GetRecommendations(ctx, 1).Return(candidates, nil).Times(2)
+	recs, err = uc.GetRecommendations(ctx, 1, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, candidates[2:], recs)
+
+	recs, err = uc.GetRecommendations(ctx, 1, 2, 5)
+	require.NoError(t, err)
+	require.Empty(t, recs)
+
+	cache.EXPECT().GetRecommendations(ctx, 2).Return(nil, &recRepo.ErrNotCached{}).Times(1)
+	repo.EXPECT().GetCandidates(ctx, 2, _maxCandidates).Return(nil, errors.New("storage is down")).Times(1)
+	_, err = uc.GetRecommendations(ctx, 2, 2, 0)
+	require.Error(t, err)
+}
@@ -2,6 +2,8 @@ package session
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
@@ -12,38 +14,70 @@ import (
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 )
 
-const SessionLifeTime = 30 * 24 * time.Hour
+const (
+	SessionLifeTime    = 30 * 24 * time.Hour
+	SessionIdleTimeout = 7 * 24 * time.Hour
+)
 
-const lenSessionKey = 16
+const (
+	lenSessionKey = 16
+	lenSessionID  = 16
+)
 
-var ErrExpiredSession = errors.New("session lifetime expired")
+var (
+	ErrExpiredSession  = errors.New("session lifetime expired")
+	ErrSessionNotFound = errors.New("session not found")
+)
 
 //go:generate mockgen -destination=./mock/session_mock.go -package=mock -source=manager.go SessionManager
 type SessionManager interface {
-	CreateNewSessionForUser(ctx context.Context, userID int) (*session.Session, error)
+	CreateNewSessionForUser(ctx context.Context, userID int, meta session.Metadata) (*session.Session, error)
 	GetUserIDBySessionKey(ctx context.Context, sessionKey string) (int, error)
+	RefreshSession(ctx context.Context, sessionKey string, meta session.Metadata) (*session.Session, error)
 	DeleteUserSession(ctx context.Context, key string) error
+	DeleteUserSessionByID(ctx context.Context, userID int, sessionID string) error
+	GetUserSessions(ctx context.Context, userID int) ([]*session.Session, error)
+	DeleteAllUserSessions(ctx context.Context, userID int) error
+	DeleteOtherUserSessions(ctx context.Context, userID int, currentKey string) error
 }
 
 type SessManager struct {
-	log  *logger.Logger
-	repo repo.Repository
+	log             *logger.Logger
+	repo            repo.Repository
+	idleTimeout     time.Duration
+	absoluteTimeout time.Duration
 }
 
-func New(log *logger.Logger, repo repo.Repository) *SessManager {
-	return &SessManager{log, repo}
+func New(log *logger.Logger, repo repo.Repository, opts ...Option) *SessManager {
+	sm := &SessManager{
+		log:             log,
+		repo:            repo,
+		idleTimeout:     SessionIdleTimeout,
+		absoluteTimeout: SessionLifeTime,
+	}
+	for _, opt := range opts {
+		opt.apply(sm)
+	}
+	if sm.idleTimeout > sm.absoluteTimeout {
+		sm.idleTimeout = sm.absoluteTimeout
+	}
+	return sm
 }
 
-func (sm *SessManager) CreateNewSessionForUser(ctx context.Context, userID int) (*session.Session, error) {
+func (sm *SessManager) CreateNewSessionForUser(ctx context.Context, userID int, meta session.Metadata) (*session.Session, error) {
 	sessionKey, err := crypto.NewRandomString(lenSessionKey)
 	if err != nil {
 		return nil, fmt.Errorf("session key generation for new session: %w", err)
 	}
 
+	now := time.Now().UTC()
+	meta.CreatedAt, meta.LastSeen = now, now
 	session := &session.Session{
-		Key:    sessionKey,
-		UserID: userID,
-		Expire: time.Now().UTC().Add(SessionLifeTime),
+		ID:       sessionIDFromKey(sessionKey),
+		Key:      sessionKey,
+		UserID:   userID,
+		Expire:   now.Add(sm.idleTimeout),
+		Metadata: meta,
 	}
 	err = sm.repo.AddSession(ctx, session)
 	if err != nil {
@@ -60,6 +94,99 @@ func (sm *SessManager) GetUserIDBySessionKey(ctx context.Context, sessionKey str
 	return session.UserID, nil
 }
 
+// RefreshSession extends the lifetime of the session by the idle timeout,
+// but not beyond the absolute timeout counted from the session creation.
+func (sm *SessManager) RefreshSession(ctx context.Context, sessionKey string, meta session.Metadata) (*session.Session, error) {
+	sess, err := sm.repo.GetSessionByKey(ctx, sessionKey)
+	if err != nil {
+		return nil, fmt.Errorf("getting a session for refresh by a manager: %w", err)
+	}
+
+	now := time.Now().UTC()
+	deadline := sess.Expire
+	if !sess.CreatedAt.IsZero() {
+		deadline = sess.CreatedAt.Add(sm.absoluteTimeout)
+	}
+	if !deadline.After(now) {
+		if err = sm.repo.DeleteSessionByKey(ctx, sessionKey); err != nil {
+			sm.log.Warn(err.Error())
+		}
+		return nil, ErrExpiredSession
+	}
+
+	sess.ID = sessionIDFromKey(sessionKey)
+	sess.Expire = now.Add(sm.idleTimeout)
+	if sess.Expire.After(deadline) {
+		sess.Expire = deadline
+	}
+	sess.UserAgent, sess.IP, sess.LastSeen = meta.UserAgent, meta.IP, now
+
+	err = sm.repo.TouchSession(ctx, sess)
+	if err != nil {
+		return nil, fmt.Errorf("refreshing a session by a manager: %w", err)
+	}
+	return sess, nil
+}
+
 func (sm *SessManager) DeleteUserSession(ctx context.Context, key string) error {
 	return sm.repo.DeleteSessionByKey(ctx, key)
 }
+
+func (sm *SessManager) DeleteUserSessionByID(ctx context.Context, userID int, sessionID string) error {
+	sessions, err := sm.GetUserSessions(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("deleting user session by id: %w", err)
+	}
+
+	for _, s := range sessions {
+		if s.ID == sessionID {
+			return sm.repo.DeleteSessionByKey(ctx, s.Key)
+		}
+	}
+	return ErrSessionNotFound
+}
+
+func (sm *SessManager) GetUserSessions(ctx context.Context, userID int) ([]*session.Session, error) {
+	sessions, err := sm.repo.GetSessionsForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting user sessions by a manager: %w", err)
+	}
+
+	for _, s := range sessions {
+		s.ID = sessionIDFromKey(s.Key)
+	}
+	return sessions, nil
+}
+
+func (sm *SessManager) DeleteAllUserSessions(ctx context.Context, userID int) error {
+	err := sm.repo.DeleteAllSessionForUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("deleting all user sessions by a manager: %w", err)
+	}
+	return nil
+}
+
+// DeleteOtherUserSessions ends all sessions of the user except the one with the current key.
+func (sm *SessManager) DeleteOtherUserSessions(ctx context.Context, userID int, currentKey string) error {
+	sessions, err := sm.repo.GetSessionsForUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("deleting other user sessions by a manager: %w", err)
+	}
+
+	for _, s := range sessions {
+		if s.Key == currentKey {
+			continue
+		}
+		if err = sm.repo.DeleteSessionByKey(ctx, s.Key); err != nil {
+			return fmt.Errorf("deleting other user sessions by a manager: %w", err)
+		}
+	}
+	return nil
+}
+
+// sessionIDFromKey returns an opaque identifier of the session
+// which can be shown to the user instead of the session key.
+func sessionIDFromKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])[:lenSessionID]
+}
@@ -7,7 +7,9 @@ import (
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/session"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/ramrepo"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/session/mock"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
@@ -35,10 +37,16 @@ func TestCreateNewSessionForUser(t *testing.T) {
 		Times(1)
 
 	expUserID := 32
-	s, err := sm.CreateNewSessionForUser(ctx, expUserID)
+	expMeta := entity.Metadata{UserAgent: "Mozilla/5.0", IP: "127.0.0.1"}
+	s, err := sm.CreateNewSessionForUser(ctx, expUserID, expMeta)
 	require.NoError(t, err)
 	require.NotNil(t, s)
 	require.Equal(t, expUserID, s.UserID)
+	require.Equal(t, expMeta.UserAgent, s.UserAgent)
+	require.Equal(t, expMeta.IP, s.IP)
+	require.False(t, s.CreatedAt.IsZero())
+	require.Equal(t, sessionIDFromKey(s.Key), s.ID)
+	require.NotEqual(t, s.Key, s.ID)
 
 	expErr := errors.New("err")
 	sessRepo.EXPECT().
@@ -46,7 +54,7 @@ func TestCreateNewSessionForUser(t *testing.T) {
 		Return(expErr).
 		Times(1)
 
-	s, err = sm.CreateNewSessionForUser(ctx, 0)
+	s, err = sm.CreateNewSessionForUser(ctx, 0, entity.Metadata{})
 	require.ErrorIs(t, err, expErr)
 	require.Nil(t, s)
 }
@@ -76,6 +84,235 @@ func TestDeleteUserSession(t *testing.T) {
 	err = sm.DeleteUserSession(ctx, expKey)
 	require.ErrorIs(t, err, expErr)
 }
+
+func TestGetUserSessions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessRepo := mock.NewMockRepository(ctrl)
+
+	sm := New(log, sessRepo)
+	expUserID := 12
+	expSessions := []*entity.Session{
+		{Key: "first-key", UserID: expUserID},
+		{Key: "second-key", UserID: expUserID},
+	}
+
+	sessRepo.EXPECT().
+		GetSessionsForUser(ctx, expUserID).
+		Return(expSessions, nil).
+		Times(1)
+
+	sessions, err := sm.GetUserSessions(ctx, expUserID)
+	require.NoError(t, err)
+	require.Equal(t, expSessions, sessions)
+	for _, s := range sessions {
+		require.Equal(t, sessionIDFromKey(s.Key), s.ID)
+	}
+
+	expErr := errors.New("err")
+	sessRepo.EXPECT().
+		GetSessionsForUser(ctx, expUserID).
+		Return(nil, expErr).
+		Times(1)
+
+	sessions, err = sm.GetUserSessions(ctx, expUserID)
+	require.ErrorIs(t, err, expErr)
+	require.Nil(t, sessions)
+}
+
+func TestDeleteUserSessionByID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessRepo := mock.NewMockRepository(ctrl)
+
+	sm := New(log, sessRepo)
+	expUserID := 12
+	sessions := []*entity.Session{
+		{Key: "first-key", UserID: expUserID},
+		{Key: "second-key", UserID: expUserID},
+	}
+
+	sessRepo.EXPECT().
+		GetSessionsForUser(ctx, expUserID).
+		Return(sessions, nil).
+		Times(2)
+	sessRepo.EXPECT().
+		DeleteSessionByKey(ctx, "second-key").
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, sm.DeleteUserSessionByID(ctx, expUserID, sessionIDFromKey("second-key")))
+	require.ErrorIs(t, sm.DeleteUserSessionByID(ctx, expUserID, "unknown"), ErrSessionNotFound)
+}
+
+func TestRefreshSession(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessRepo := mock.NewMockRepository(ctrl)
+
+	idle, absolute := time.Hour, 24*time.Hour
+	sm := New(log, sessRepo, IdleTimeout(idle), AbsoluteTimeout(absolute))
+	expKey := "session-key"
+	expMeta := entity.Metadata{UserAgent: "Mozilla/5.0", IP: "127.0.0.1"}
+
+	testCases := []struct {
+		name      string
+		createdAt time.Time
+		expExpire time.Duration
+		expErr    error
+	}{
+		{
+			name:      "session is extended by idle timeout",
+			createdAt: time.Now().UTC().Add(-time.Hour),
+			expExpire: idle,
+		},
+		{
+			name:      "session is not extended beyond absolute timeout",
+			createdAt: time.Now().UTC().Add(-absolute + 10*time.Minute),
+			expExpire: 10 * time.Minute,
+		},
+		{
+			name:      "session with exceeded absolute timeout is deleted",
+			createdAt: time.Now().UTC().Add(-absolute - time.Minute),
+			expErr:    ErrExpiredSession,
+		},
+	}
+
+	for _, tCase := range testCases {
+		t.Run(tCase.name, func(t *testing.T) {
+			sessRepo.EXPECT().
+				GetSessionByKey(ctx, expKey).
+				Return(&entity.Session{
+					Key:      expKey,
+					UserID:   1,
+					Expire:   time.Now().UTC().Add(time.Minute),
+					Metadata: entity.Metadata{CreatedAt: tCase.createdAt},
+				}, nil).
+				Times(1)
+
+			if tCase.expErr != nil {
+				sessRepo.EXPECT().DeleteSessionByKey(ctx, expKey).Return(nil).Times(1)
+
+				sess, err := sm.RefreshSession(ctx, expKey, expMeta)
+				require.ErrorIs(t, err, tCase.expErr)
+				require.Nil(t, sess)
+				return
+			}
+
+			sessRepo.EXPECT().TouchSession(ctx, gomock.Any()).Return(nil).Times(1)
+
+			sess, err := sm.RefreshSession(ctx, expKey, expMeta)
+			require.NoError(t, err)
+			require.Equal(t, 1, sess.UserID)
+			require.Equal(t, expMeta.UserAgent, sess.UserAgent)
+			require.Equal(t, expMeta.IP, sess.IP)
+			require.WithinDuration(t, time.Now().UTC().Add(tCase.expExpire), sess.Expire, time.Second)
+		})
+	}
+}
+
+func TestNewClampsIdleTimeout(t *testing.T) {
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sm := New(log, nil, IdleTimeout(48*time.Hour), AbsoluteTimeout(time.Hour))
+	require.Equal(t, time.Hour, sm.idleTimeout)
+	require.Equal(t, time.Hour, sm.absoluteTimeout)
+}
+
+func TestDeleteAllUserSessions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessRepo := mock.NewMockRepository(ctrl)
+
+	sm := New(log, sessRepo)
+	expUserID := 12
+	expErr := errors.New("err")
+
+	sessRepo.EXPECT().
+		DeleteAllSessionForUser(ctx, expUserID).
+		Return(nil).
+		Times(1)
+	require.NoError(t, sm.DeleteAllUserSessions(ctx, expUserID))
+
+	sessRepo.EXPECT().
+		DeleteAllSessionForUser(ctx, expUserID).
+		Return(expErr).
+		Times(1)
+	require.ErrorIs(t, sm.DeleteAllUserSessions(ctx, expUserID), expErr)
+}
+
+func TestDeleteOtherUserSessions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessRepo := mock.NewMockRepository(ctrl)
+
+	sm := New(log, sessRepo)
+	expUserID := 12
+	sessions := []*entity.Session{
+		{Key: "first-key", UserID: expUserID},
+		{Key: "current-key", UserID: expUserID},
+		{Key: "third-key", UserID: expUserID},
+	}
+
+	sessRepo.EXPECT().
+		GetSessionsForUser(ctx, expUserID).
+		Return(sessions, nil).
+		Times(1)
+	sessRepo.EXPECT().
+		DeleteSessionByKey(ctx, "first-key").
+		Return(nil).
+		Times(1)
+	sessRepo.EXPECT().
+		DeleteSessionByKey(ctx, "third-key").
+		Return(nil).
+		Times(1)
+
+	require.NoError(t, sm.DeleteOtherUserSessions(ctx, expUserID, "current-key"))
+}
+
 func TestGetUserIDBySessionKey(t *testing.T) {
 	log, err := logger.New(logger.RFC3339FormatTime())
 	if err != nil {
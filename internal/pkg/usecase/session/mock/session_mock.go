@@ -36,18 +36,46 @@ func (m *MockSessionManager) EXPECT() *MockSessionManagerMockRecorder {
 }
 
 // CreateNewSessionForUser mocks base method.
-func (m *MockSessionManager) CreateNewSessionForUser(ctx context.Context, userID int) (*session.Session, error) {
+func (m *MockSessionManager) CreateNewSessionForUser(ctx context.Context, userID int, meta session.Metadata) (*session.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNewSessionForUser", ctx, userID)
+	ret := m.ctrl.Call(m, "CreateNewSessionForUser", ctx, userID, meta)
 	ret0, _ := ret[0].(*session.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateNewSessionForUser indicates an expected call of CreateNewSessionForUser.
-func (mr *MockSessionManagerMockRecorder) CreateNewSessionForUser(ctx, userID interface{}) *gomock.Call {
+func (mr *MockSessionManagerMockRecorder) CreateNewSessionForUser(ctx, userID, meta interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewSessionForUser", reflect.TypeOf((*MockSessionManager)(nil).CreateNewSessionForUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewSessionForUser", reflect.TypeOf((*MockSessionManager)(nil).CreateNewSessionForUser), ctx, userID, meta)
+}
+
+// DeleteAllUserSessions mocks base method.
+func (m *MockSessionManager) DeleteAllUserSessions(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllUserSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllUserSessions indicates an expected call of DeleteAllUserSessions.
+func (mr *MockSessionManagerMockRecorder) DeleteAllUserSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllUserSessions", reflect.TypeOf((*MockSessionManager)(nil).DeleteAllUserSessions), ctx, userID)
+}
+
+// DeleteOtherUserSessions mocks base method.
+func (m *MockSessionManager) DeleteOtherUserSessions(ctx context.Context, userID int, currentKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOtherUserSessions", ctx, userID, currentKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOtherUserSessions indicates an expected call of DeleteOtherUserSessions.
+func (mr *MockSessionManagerMockRecorder) DeleteOtherUserSessions(ctx, userID, currentKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOtherUserSessions", reflect.TypeOf((*MockSessionManager)(nil).DeleteOtherUserSessions), ctx, userID, currentKey)
 }
 
 // DeleteUserSession mocks base method.
@@ -64,6 +92,20 @@ func (mr *MockSessionManagerMockRecorder) DeleteUserSession(ctx, key interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSession", reflect.TypeOf((*MockSessionManager)(nil).DeleteUserSession), ctx, key)
 }
 
+// DeleteUserSessionByID mocks base method.
+func (m *MockSessionManager) DeleteUserSessionByID(ctx context.Context, userID int, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSessionByID", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserSessionByID indicates an expected call of DeleteUserSessionByID.
+func (mr *MockSessionManagerMockRecorder) DeleteUserSessionByID(ctx, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessionByID", reflect.TypeOf((*MockSessionManager)(nil).DeleteUserSessionByID), ctx, userID, sessionID)
+}
+
 // GetUserIDBySessionKey mocks base method.
 func (m *MockSessionManager) GetUserIDBySessionKey(ctx context.Context, sessionKey string) (int, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +120,33 @@ func (mr *MockSessionManagerMockRecorder) GetUserIDBySessionKey(ctx, sessionKey
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDBySessionKey", reflect.TypeOf((*MockSessionManager)(nil).GetUserIDBySessionKey), ctx, sessionKey)
 }
+
+// GetUserSessions mocks base method.
+func (m *MockSessionManager) GetUserSessions(ctx context.Context, userID int) ([]*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSessions", ctx, userID)
+	ret0, _ := ret[0].([]*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSessions indicates an expected call of GetUserSessions.
+func (mr *MockSessionManagerMockRecorder) GetUserSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessions", reflect.TypeOf((*MockSessionManager)(nil).GetUserSessions), ctx, userID)
+}
+
+// RefreshSession mocks base method.
+func (m *MockSessionManager) RefreshSession(ctx context.Context, sessionKey string, meta session.Metadata) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSession", ctx, sessionKey, meta)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshSession indicates an expected call of RefreshSession.
+func (mr *MockSessionManagerMockRecorder) RefreshSession(ctx, sessionKey, meta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSession", reflect.TypeOf((*MockSessionManager)(nil).RefreshSession), ctx, sessionKey, meta)
+}
@@ -0,0 +1,27 @@
+package session
+
+import "time"
+
+type Option interface {
+	apply(*SessManager)
+}
+
+type funcOption func(*SessManager)
+
+func (f funcOption) apply(sm *SessManager) {
+	f(sm)
+}
+
+// IdleTimeout sets the time after which an unused session expires.
+func IdleTimeout(timeout time.Duration) Option {
+	return funcOption(func(sm *SessManager) {
+		sm.idleTimeout = timeout
+	})
+}
+
+// AbsoluteTimeout sets the time after which a session expires regardless of its use.
+func AbsoluteTimeout(timeout time.Duration) Option {
+	return funcOption(func(sm *SessManager) {
+		sm.absoluteTimeout = timeout
+	})
+}
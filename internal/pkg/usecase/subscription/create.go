@@ -1,15 +1,52 @@
 package subscription
 
-import "context"
+import (
+	"context"
+	"fmt"
+)
 
-func (u *subscriptionUsecase) SubscribeToUser(ctx context.Context, from, to int) error {
+// SubscribeToUser subscribes to the public profile at once, for the private one
+// it sends the subscription request and returns requested set to true.
+func (u *subscriptionUsecase) SubscribeToUser(ctx context.Context, from, to int) (bool, error) {
 	if from == to {
-		return &ErrSelfSubscription{}
+		return false, &ErrSelfSubscription{}
 	}
 
-	if err := u.userRepo.CheckUserExistence(ctx, to); err != nil {
-		return err
+	private, err := u.userRepo.IsPrivate(ctx, to)
+	if err != nil {
+		return false, err
 	}
 
-	return u.subRepo.CreateSubscriptionUser(ctx, from, to)
+	if err := u.blocks.CheckBlocked(ctx, from, to); err != nil {
+		return false, err
+	}
+
+	if !private {
+		if err := u.subRepo.CreateSubscriptionUser(ctx, from, to); err != nil {
+			return false, err
+		}
+		u.invalidateFollowing(ctx, from)
+		return false, nil
+	}
+
+	requestID, err := u.subRepo.CreateFollowRequest(ctx, from, to)
+	if err != nil {
+		return false, fmt.Errorf("send subscription request: %w", err)
+	}
+
+	if u.notifyIsEnable {
+		go u.notify(u.notifyCase.NotifyFollowRequest, requestID)
+	}
+	return true, nil
+}
+
+// invalidateFollowing drops the cached recommendations and the following feed timeline of the user
+// whose subscriptions have changed, the failure isn't critical, the cache expires anyway.
+func (u *subscriptionUsecase) invalidateFollowing(ctx context.Context, userID int) {
+	if err := u.recs.InvalidateRecommendations(ctx, userID); err != nil {
+		u.log.Warn(err.Error())
+	}
+	if err := u.timelines.InvalidateTimeline(ctx, userID); err != nil {
+		u.log.Warn(err.Error())
+	}
 }
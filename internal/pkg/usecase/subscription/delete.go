@@ -1,7 +1,13 @@
 package subscription
 
-import "context"
+import (
+	"context"
+	"errors"
 
+	subRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/subscription"
+)
+
+// UnsubscribeFromUser removes the subscription or, if there is none, cancels the pending subscription request.
 func (u *subscriptionUsecase) UnsubscribeFromUser(ctx context.Context, from, to int) error {
 	if from == to {
 		return &ErrSelfUnsubscription{}
@@ -11,5 +17,19 @@ func (u *subscriptionUsecase) UnsubscribeFromUser(ctx context.Context, from, to
 		return err
 	}
 
-	return u.subRepo.DeleteSubscriptionUser(ctx, from, to)
+	err := u.subRepo.DeleteSubscriptionUser(ctx, from, to)
+	if err == nil {
+		u.invalidateFollowing(ctx, from)
+		return nil
+	}
+	if !errors.As(err, new(*subRepo.ErrNonExistingSubscription)) {
+		return err
+	}
+
+	if errCancel := u.subRepo.CancelFollowRequest(ctx, from, to); errCancel == nil {
+		return nil
+	} else if !errors.As(errCancel, new(*subRepo.ErrNonExistingFollowRequest)) {
+		return errCancel
+	}
+	return err
 }
@@ -37,3 +37,13 @@ func (e *ErrInvalidFilter) Error() string {
 func (e *ErrInvalidFilter) Type() errPkg.Type {
 	return errPkg.ErrInvalidInput
 }
+
+type ErrPrivateProfile struct{}
+
+func (e *ErrPrivateProfile) Error() string {
+	return "subscriptions of a private profile are available only to its subscribers"
+}
+
+func (e *ErrPrivateProfile) Type() errPkg.Type {
+	return errPkg.ErrNoAccess
+}
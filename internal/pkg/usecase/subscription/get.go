@@ -8,11 +8,13 @@ import (
 )
 
 func (u *subscriptionUsecase) GetSubscriptionInfoForUser(ctx context.Context, subOpts *userEntity.SubscriptionOpts) ([]userEntity.SubscriptionUser, error) {
-	if err := u.userRepo.CheckUserExistence(ctx, subOpts.UserID); err != nil {
+	currUserID, _ := ctx.Value(auth.KeyCurrentUserID).(int)
+	if ok, err := u.userRepo.CheckProfileAccess(ctx, subOpts.UserID, currUserID); err != nil {
 		return nil, err
+	} else if !ok {
+		return nil, &ErrPrivateProfile{}
 	}
 
-	currUserID, _ := ctx.Value(auth.KeyCurrentUserID).(int)
 	var (
 		users []userEntity.SubscriptionUser
 		err   error
@@ -35,3 +37,26 @@ func (u *subscriptionUsecase) GetSubscriptionInfoForUser(ctx context.Context, su
 
 	return users, nil
 }
+
+// GetKnownFollowers returns the first followers of the user that the current user is subscribed to
+// and the total number of such followers.
+func (u *subscriptionUsecase) GetKnownFollowers(ctx context.Context, userID, count int) ([]userEntity.SubscriptionUser, int, error) {
+	currUserID, _ := ctx.Value(auth.KeyCurrentUserID).(int)
+	if currUserID == 0 || currUserID == userID {
+		return []userEntity.SubscriptionUser{}, 0, nil
+	}
+
+	if err := u.userRepo.CheckUserExistence(ctx, userID); err != nil {
+		return nil, 0, err
+	}
+
+	users, total, err := u.subRepo.GetKnownFollowers(ctx, userID, currUserID, count)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for id := range users {
+		users[id].Sanitize(u.sanitizer)
+	}
+	return users, total, nil
+}
@@ -35,6 +35,65 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ApproveFollowRequest mocks base method.
+func (m *MockUsecase) ApproveFollowRequest(ctx context.Context, userID, requestID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveFollowRequest", ctx, userID, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveFollowRequest indicates an expected call of ApproveFollowRequest.
+func (mr *MockUsecaseMockRecorder) ApproveFollowRequest(ctx, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFollowRequest", reflect.TypeOf((*MockUsecase)(nil).ApproveFollowRequest), ctx, userID, requestID)
+}
+
+// DenyFollowRequest mocks base method.
+func (m *MockUsecase) DenyFollowRequest(ctx context.Context, userID, requestID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyFollowRequest", ctx, userID, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DenyFollowRequest indicates an expected call of DenyFollowRequest.
+func (mr *MockUsecaseMockRecorder) DenyFollowRequest(ctx, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyFollowRequest", reflect.TypeOf((*MockUsecase)(nil).DenyFollowRequest), ctx, userID, requestID)
+}
+
+// GetFollowRequests mocks base method.
+func (m *MockUsecase) GetFollowRequests(ctx context.Context, userID, count, lastID int) ([]user.FollowRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowRequests", ctx, userID, count, lastID)
+	ret0, _ := ret[0].([]user.FollowRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowRequests indicates an expected call of GetFollowRequests.
+func (mr *MockUsecaseMockRecorder) GetFollowRequests(ctx, userID, count, lastID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowRequests", reflect.TypeOf((*MockUsecase)(nil).GetFollowRequests), ctx, userID, count, lastID)
+}
+
+// GetKnownFollowers mocks base method.
+func (m *MockUsecase) GetKnownFollowers(ctx context.Context, userID, count int) ([]user.SubscriptionUser, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKnownFollowers", ctx, userID, count)
+	ret0, _ := ret[0].([]user.SubscriptionUser)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKnownFollowers indicates an expected call of GetKnownFollowers.
+func (mr *MockUsecaseMockRecorder) GetKnownFollowers(ctx, userID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKnownFollowers", reflect.TypeOf((*MockUsecase)(nil).GetKnownFollowers), ctx, userID, count)
+}
+
 // GetSubscriptionInfoForUser mocks base method.
 func (m *MockUsecase) GetSubscriptionInfoForUser(ctx context.Context, subOpts *user.SubscriptionOpts) ([]user.SubscriptionUser, error) {
 	m.ctrl.T.Helper()
@@ -51,11 +110,12 @@ func (mr *MockUsecaseMockRecorder) GetSubscriptionInfoForUser(ctx, subOpts inter
 }
 
 // SubscribeToUser mocks base method.
-func (m *MockUsecase) SubscribeToUser(ctx context.Context, from, to int) error {
+func (m *MockUsecase) SubscribeToUser(ctx context.Context, from, to int) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SubscribeToUser", ctx, from, to)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SubscribeToUser indicates an expected call of SubscribeToUser.
@@ -77,3 +137,77 @@ func (mr *MockUsecaseMockRecorder) UnsubscribeFromUser(ctx, from, to interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeFromUser", reflect.TypeOf((*MockUsecase)(nil).UnsubscribeFromUser), ctx, from, to)
 }
+
+// MockblockChecker is a mock of blockChecker interface.
+type MockblockChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockblockCheckerMockRecorder
+}
+
+// MockblockCheckerMockRecorder is the mock recorder for MockblockChecker.
+type MockblockCheckerMockRecorder struct {
+	mock *MockblockChecker
+}
+
+// NewMockblockChecker creates a new mock instance.
+func NewMockblockChecker(ctrl *gomock.Controller) *MockblockChecker {
+	mock := &MockblockChecker{ctrl: ctrl}
+	mock.recorder = &MockblockCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockblockChecker) EXPECT() *MockblockCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckBlocked mocks base method.
+func (m *MockblockChecker) CheckBlocked(ctx context.Context, userID1, userID2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBlocked", ctx, userID1, userID2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckBlocked indicates an expected call of CheckBlocked.
+func (mr *MockblockCheckerMockRecorder) CheckBlocked(ctx, userID1, userID2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBlocked", reflect.TypeOf((*MockblockChecker)(nil).CheckBlocked), ctx, userID1, userID2)
+}
+
+// MockrecommendationInvalidator is a mock of recommendationInvalidator interface.
+type MockrecommendationInvalidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockrecommendationInvalidatorMockRecorder
+}
+
+// MockrecommendationInvalidatorMockRecorder is the mock recorder for MockrecommendationInvalidator.
+type MockrecommendationInvalidatorMockRecorder struct {
+	mock *MockrecommendationInvalidator
+}
+
+// NewMockrecommendationInvalidator creates a new mock instance.
+func NewMockrecommendationInvalidator(ctrl *gomock.Controller) *MockrecommendationInvalidator {
+	mock := &MockrecommendationInvalidator{ctrl: ctrl}
+	mock.recorder = &MockrecommendationInvalidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrecommendationInvalidator) EXPECT() *MockrecommendationInvalidatorMockRecorder {
+	return m.recorder
+}
+
+// InvalidateRecommendations mocks base method.
+func (m *MockrecommendationInvalidator) InvalidateRecommendations(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateRecommendations", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateRecommendations indicates an expected call of InvalidateRecommendations.
+func (mr *MockrecommendationInvalidatorMockRecorder) InvalidateRecommendations(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateRecommendations", reflect.TypeOf((*MockrecommendationInvalidator)(nil).InvalidateRecommendations), ctx, userID)
+}
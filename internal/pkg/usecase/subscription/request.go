@@ -0,0 +1,49 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+
+	userEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+)
+
+func (u *subscriptionUsecase) GetFollowRequests(ctx context.Context, userID, count, lastID int) ([]userEntity.FollowRequest, error) {
+	requests, err := u.subRepo.GetFollowRequests(ctx, userID, count, lastID)
+	if err != nil {
+		return nil, fmt.Errorf("get subscription requests: %w", err)
+	}
+
+	for i := range requests {
+		requests[i].From.Username = u.sanitizer.Sanitize(requests[i].From.Username)
+	}
+	return requests, nil
+}
+
+func (u *subscriptionUsecase) ApproveFollowRequest(ctx context.Context, userID, requestID int) error {
+	from, err := u.subRepo.ApproveFollowRequest(ctx, requestID, userID)
+	if err != nil {
+		return fmt.Errorf("approve subscription request: %w", err)
+	}
+	u.invalidateFollowing(ctx, from)
+
+	if u.notifyIsEnable {
+		go u.notify(u.notifyCase.NotifyFollowApproved, requestID)
+	}
+	return nil
+}
+
+func (u *subscriptionUsecase) DenyFollowRequest(ctx context.Context, userID, requestID int) error {
+	if err := u.subRepo.DenyFollowRequest(ctx, requestID, userID); err != nil {
+		return fmt.Errorf("deny subscription request: %w", err)
+	}
+	return nil
+}
+
+func (u *subscriptionUsecase) notify(send func(ctx context.Context, requestID int) error, requestID int) {
+	ctx, cancel := context.WithTimeout(context.Background(), _timeoutNotification)
+	defer cancel()
+
+	if err := send(ctx, requestID); err != nil {
+		u.log.Warn(err.Error())
+	}
+}
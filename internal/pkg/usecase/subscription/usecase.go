@@ -2,28 +2,66 @@ package subscription
 
 import (
 	"context"
+	"time"
 
 	userEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	subRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/subscription"
 	uRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/realtime/notification"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 	"github.com/microcosm-cc/bluemonday"
 )
 
 //go:generate mockgen -destination=./mock/subscription_mock.go -package=mock -source=usecase.go Usecase
 type Usecase interface {
-	SubscribeToUser(ctx context.Context, from, to int) error
+	SubscribeToUser(ctx context.Context, from, to int) (requested bool, err error)
 	UnsubscribeFromUser(ctx context.Context, from, to int) error
 	GetSubscriptionInfoForUser(ctx context.Context, subOpts *userEntity.SubscriptionOpts) ([]userEntity.SubscriptionUser, error)
+	GetKnownFollowers(ctx context.Context, userID, count int) ([]userEntity.SubscriptionUser, int, error)
+	GetFollowRequests(ctx context.Context, userID, count, lastID int) ([]userEntity.FollowRequest, error)
+	ApproveFollowRequest(ctx context.Context, userID, requestID int) error
+	DenyFollowRequest(ctx context.Context, userID, requestID int) error
+}
+
+const _timeoutNotification = 5 * time.Minute
+
+type blockChecker interface {
+	CheckBlocked(ctx context.Context, userID1, userID2 int) error
+}
+
+type recommendationInvalidator interface {
+	InvalidateRecommendations(ctx context.Context, userID int) error
+}
+
+type timelineInvalidator interface {
+	InvalidateTimeline(ctx context.Context, userID int) error
 }
 
 type subscriptionUsecase struct {
-	subRepo   subRepo.Repository
-	userRepo  uRepo.Repository
-	log       *logger.Logger
-	sanitizer *bluemonday.Policy
+	subRepo    subRepo.Repository
+	userRepo   uRepo.Repository
+	blocks     blockChecker
+	recs       recommendationInvalidator
+	timelines  timelineInvalidator
+	notifyCase notification.Usecase
+	log        *logger.Logger
+	sanitizer  *bluemonday.Policy
+
+	notifyIsEnable bool
 }
 
-func New(log *logger.Logger, subRepo subRepo.Repository, uRepo uRepo.Repository, sanitizer *bluemonday.Policy) Usecase {
-	return &subscriptionUsecase{subRepo: subRepo, userRepo: uRepo, log: log, sanitizer: sanitizer}
+func New(log *logger.Logger, subRepo subRepo.Repository, uRepo uRepo.Repository, blocks blockChecker,
+	recs recommendationInvalidator, timelines timelineInvalidator, notifyCase notification.Usecase, sanitizer *bluemonday.Policy) Usecase {
+
+	return &subscriptionUsecase{
+		subRepo:        subRepo,
+		userRepo:       uRepo,
+		blocks:         blocks,
+		recs:           recs,
+		timelines:      timelines,
+		notifyCase:     notifyCase,
+		log:            log,
+		sanitizer:      sanitizer,
+		notifyIsEnable: notifyCase != nil,
+	}
 }
@@ -0,0 +1,178 @@
+package subscription
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/require"
+
+	userEntity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
+	subRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/subscription"
+	subMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/subscription/mock"
+	uRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	userMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+type noBlocks struct{}
+
+func (noBlocks) CheckBlocked(context.Context, int, int) error { return nil }
+
+type noRecommendations struct{}
+
+func (noRecommendations) InvalidateRecommendations(context.Context, int) error { return nil }
+
+// invalidatedTimelines records the users whose timelines have been invalidated.
+type invalidatedTimelines []int
+
+func (t *invalidatedTimelines) InvalidateTimeline(_ context.Context, userID int) error {
+	*t = append(*t, userID)
+	return nil
+}
+
+func TestSubscribeToUser(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subRepository := subMock.NewMockRepository(ctrl)
+	userRepository := userMock.NewMockRepository(ctrl)
+	var timelines invalidatedTimelines
+	uc := New(log, subRepository, userRepository, noBlocks{}, noRecommendations{}, &timelines, nil, bluemonday.UGCPolicy())
+
+	_, err = uc.SubscribeToUser(ctx, 1, 1)
+	require.ErrorAs(t, err, new(*ErrSelfSubscription))
+
+	userRepository.EXPECT().IsPrivate(ctx, 404).Return(false, &uRepo.ErrNonExistingUser{}).Times(1)
+	_, err = uc.SubscribeToUser(ctx, 1, 404)
+	require.ErrorAs(t, err, new(*uRepo.ErrNonExistingUser))
+
+	userRepository.EXPECT().IsPrivate(ctx, 2).Return(false, nil).Times(1)
+	subRepository.EXPECT().CreateSubscriptionUser(ctx, 1, 2).Return(nil).Times(1)
+	requested, err := uc.SubscribeToUser(ctx, 1, 2)
+	require.NoError(t, err)
+	require.False(t, requested)
+	require.Equal(t, invalidatedTimelines{1}, timelines)
+
+	userRepository.EXPECT().IsPrivate(ctx, 3).Return(true, nil).Times(2)
+	subRepository.EXPECT().CreateFollowRequest(ctx, 1, 3).Return(7, nil).Times(1)
+	requested, err = uc.SubscribeToUser(ctx, 1, 3)
+	require.NoError(t, err)
+	require.True(t, requested)
+
+	subRepository.EXPECT().CreateFollowRequest(ctx, 1, 3).Return(0, &subRepo.ErrFollowRequestAlreadyExist{}).Times(1)
+	_, err = uc.SubscribeToUser(ctx, 1, 3)
+	require.ErrorAs(t, err, new(*subRepo.ErrFollowRequestAlreadyExist))
+	// the request doesn't change the subscriptions until it is approved
+	require.Equal(t, invalidatedTimelines{1}, timelines)
+}
+
+func TestUnsubscribeFromUser(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subRepository := subMock.NewMockRepository(ctrl)
+	userRepository := userMock.NewMockRepository(ctrl)
+	var timelines invalidatedTimelines
+	uc := New(log, subRepository, userRepository, noBlocks{}, noRecommendations{}, &timelines, nil, bluemonday.UGCPolicy())
+
+	userRepository.EXPECT().CheckUserExistence(ctx, 2).Return(nil).Times(1)
+	subRepository.EXPECT().DeleteSubscriptionUser(ctx, 1, 2).Return(nil).Times(1)
+	err = uc.UnsubscribeFromUser(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, invalidatedTimelines{1}, timelines)
+
+	userRepository.EXPECT().CheckUserExistence(ctx, 3).Return(nil).Times(2)
+	subRepository.EXPECT().DeleteSubscriptionUser(ctx, 1, 3).Return(&subRepo.ErrNonExistingSubscription{}).Times(2)
+
+	subRepository.EXPECT().CancelFollowRequest(ctx, 1, 3).Return(nil).Times(1)
+	err = uc.UnsubscribeFromUser(ctx, 1, 3)
+	require.NoError(t, err)
+
+	subRepository.EXPECT().CancelFollowRequest(ctx, 1, 3).Return(&subRepo.ErrNonExistingFollowRequest{}).Times(1)
+	err = uc.UnsubscribeFromUser(ctx, 1, 3)
+	require.ErrorAs(t, err, new(*subRepo.ErrNonExistingSubscription))
+	require.Equal(t, invalidatedTimelines{1}, timelines)
+}
+
+func TestGetSubscriptionInfoForUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subRepository := subMock.NewMockRepository(ctrl)
+	userRepository := userMock.NewMockRepository(ctrl)
+	uc := New(log, subRepository, userRepository, noBlocks{}, noRecommendations{}, &invalidatedTimelines{}, nil, bluemonday.UGCPolicy())
+
+	ctx := context.WithValue(context.Background(), auth.KeyCurrentUserID, 1)
+
+	userRepository.EXPECT().CheckProfileAccess(ctx, 3, 1).Return(false, nil).Times(1)
+	_, err = uc.GetSubscriptionInfoForUser(ctx, &userEntity.SubscriptionOpts{UserID: 3, Count: 20, Filter: "subscribers"})
+	require.ErrorAs(t, err, new(*ErrPrivateProfile))
+
+	followers := []userEntity.SubscriptionUser{{ID: 5, Username: "baobab", IsMutual: true}}
+	userRepository.EXPECT().CheckProfileAccess(ctx, 2, 1).Return(true, nil).Times(2)
+	subRepository.EXPECT().GetUserSubscribers(ctx, 2, 20, 0, 1).Return(followers, nil).Times(1)
+	users, err := uc.GetSubscriptionInfoForUser(ctx, &userEntity.SubscriptionOpts{UserID: 2, Count: 20, Filter: "subscribers"})
+	require.NoError(t, err)
+	require.Equal(t, followers, users)
+
+	_, err = uc.GetSubscriptionInfoForUser(ctx, &userEntity.SubscriptionOpts{UserID: 2, Count: 20, Filter: "friends"})
+	require.ErrorAs(t, err, new(*ErrInvalidFilter))
+}
+
+func TestGetKnownFollowers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subRepository := subMock.NewMockRepository(ctrl)
+	userRepository := userMock.NewMockRepository(ctrl)
+	uc := New(log, subRepository, userRepository, noBlocks{}, noRecommendations{}, &invalidatedTimelines{}, nil, bluemonday.UGCPolicy())
+
+	users, total, err := uc.GetKnownFollowers(context.Background(), 2, 3)
+	require.NoError(t, err)
+	require.Empty(t, users)
+	require.Zero(t, total)
+
+	ctx := context.WithValue(context.Background(), auth.KeyCurrentUserID, 1)
+
+	users, total, err = uc.GetKnownFollowers(ctx, 1, 3)
+	require.NoError(t, err)
+	require.Empty(t, users)
+	require.Zero(t, total)
+
+	userRepository.EXPECT().CheckUserExistence(ctx, 404).Return(&uRepo.ErrNonExistingUser{}).Times(1)
+	_, _, err = uc.GetKnownFollowers(ctx, 404, 3)
+	require.ErrorAs(t, err, new(*uRepo.ErrNonExistingUser))
+
+	known := []userEntity.SubscriptionUser{{ID: 5, Username: "baobab", HasSubscribeFromCurUser: true}}
+	userRepository.EXPECT().CheckUserExistence(ctx, 2).Return(nil).Times(1)
+	subRepository.EXPECT().GetKnownFollowers(ctx, 2, 1, 3).Return(known, 4, nil).Times(1)
+	users, total, err = uc.GetKnownFollowers(ctx, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, known, users)
+	require.Equal(t, 4, total)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pin "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetFollowingFeed mocks base method.
+func (m *MockUsecase) GetFollowingFeed(ctx context.Context, userID int, cfg pin.FeedPinConfig) (pin.FeedPin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowingFeed", ctx, userID, cfg)
+	ret0, _ := ret[0].(pin.FeedPin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowingFeed indicates an expected call of GetFollowingFeed.
+func (mr *MockUsecaseMockRecorder) GetFollowingFeed(ctx, userID, cfg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowingFeed", reflect.TypeOf((*MockUsecase)(nil).GetFollowingFeed), ctx, userID, cfg)
+}
+
+// InvalidateTimeline mocks base method.
+func (m *MockUsecase) InvalidateTimeline(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateTimeline", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateTimeline indicates an expected call of InvalidateTimeline.
+func (mr *MockUsecaseMockRecorder) InvalidateTimeline(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateTimeline", reflect.TypeOf((*MockUsecase)(nil).InvalidateTimeline), ctx, userID)
+}
+
+// PushPin mocks base method.
+func (m *MockUsecase) PushPin(ctx context.Context, authorID, pinID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushPin", ctx, authorID, pinID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushPin indicates an expected call of PushPin.
+func (mr *MockUsecaseMockRecorder) PushPin(ctx, authorID, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushPin", reflect.TypeOf((*MockUsecase)(nil).PushPin), ctx, authorID, pinID)
+}
+
+// RemovePin mocks base method.
+func (m *MockUsecase) RemovePin(ctx context.Context, pinID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePin", ctx, pinID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePin indicates an expected call of RemovePin.
+func (mr *MockUsecaseMockRecorder) RemovePin(ctx, pinID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePin", reflect.TypeOf((*MockUsecase)(nil).RemovePin), ctx, pinID)
+}
@@ -0,0 +1,214 @@
+package timeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	pinRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/pin"
+	timelineRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/timeline"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+const (
+	// the pins of the authors with more followers are not pushed to the timelines, they are pulled on reading
+	_celebrityFollowers = 10000
+	_timelineLifetime   = 6 * time.Hour
+)
+
+//go:generate mockgen -destination=./mock/timeline_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	GetFollowingFeed(ctx context.Context, userID int, cfg pin.FeedPinConfig) (pin.FeedPin, error)
+	PushPin(ctx context.Context, authorID, pinID int) error
+	RemovePin(ctx context.Context, pinID int) error
+	InvalidateTimeline(ctx context.Context, userID int) error
+}
+
+type timelineCase struct {
+	log   *logger.Logger
+	repo  timelineRepo.Repository
+	cache timelineRepo.CacheRepository
+	pins  pinRepo.Repository
+}
+
+func New(log *logger.Logger, repo timelineRepo.Repository, cache timelineRepo.CacheRepository, pins pinRepo.Repository) *timelineCase {
+	return &timelineCase{
+		log:   log,
+		repo:  repo,
+		cache: cache,
+		pins:  pins,
+	}
+}
+
+// GetFollowingFeed returns the page of the following feed of the user from the timeline kept in the cache
+// merged with the pulled pins: the pins of the followed celebrities, the followed boards and the liked tags.
+// The pins are loaded with the filters of cfg, so the pins deleted, hidden or blocked since they got
+// into the timeline are left out. The timeline is read further until the page is full or the timeline
+// is exhausted, and the cursor is moved past the left out pins, so the short page doesn't end the feed.
+func (t *timelineCase) GetFollowingFeed(ctx context.Context, userID int, cfg pin.FeedPinConfig) (pin.FeedPin, error) {
+	feed := pin.FeedPin{Condition: cfg.Condition}
+	for {
+		pinIDs, ok, err := t.getPinIDs(ctx, userID, cfg.Condition, cfg.Count)
+		if err != nil {
+			return feed, err
+		}
+		if !ok {
+			page, err := t.pins.GetFeedPins(ctx, cfg)
+			feed.Pins = append(feed.Pins, page.Pins...)
+			feed.Condition = page.Condition
+			return feed, err
+		}
+
+		loadCfg := cfg
+		loadCfg.Following = false
+		loadCfg.SetPins(pinIDs)
+		page, err := t.pins.GetFeedPins(ctx, loadCfg)
+		feed.Pins = append(feed.Pins, page.Pins...)
+		if err != nil {
+			return feed, err
+		}
+
+		cfg.Condition = skipPinIDs(cfg.Condition, pinIDs)
+		cfg.Count -= len(page.Pins)
+		feed.Condition = cfg.Condition
+		if cfg.Count <= 0 || len(pinIDs) < loadCfg.Count {
+			return feed, nil
+		}
+	}
+}
+
+// PushPin fans the new pin out to the timelines of the followers of its author.
+func (t *timelineCase) PushPin(ctx context.Context, authorID, pinID int) error {
+	followersIDs, err := t.repo.GetFollowersIDs(ctx, authorID, _celebrityFollowers+1)
+	if err != nil {
+		return fmt.Errorf("push pin to timelines: %w", err)
+	}
+	if len(followersIDs) > _celebrityFollowers {
+		return nil
+	}
+
+	if err = t.cache.PushPin(ctx, followersIDs, pinID); err != nil {
+		return fmt.Errorf("push pin to timelines: %w", err)
+	}
+	return nil
+}
+
+// RemovePin removes the deleted pin from the timelines of the followers of its author.
+func (t *timelineCase) RemovePin(ctx context.Context, pinID int) error {
+	deletedPin, err := t.pins.GetPinByID(ctx, pinID, false)
+	if err != nil {
+		return fmt.Errorf("remove pin from timelines: %w", err)
+	}
+	if !deletedPin.DeletedAt.Valid {
+		return nil
+	}
+
+	followersIDs, err := t.repo.GetFollowersIDs(ctx, deletedPin.Author.ID, _celebrityFollowers+1)
+	if err != nil {
+		return fmt.Errorf("remove pin from timelines: %w", err)
+	}
+	if len(followersIDs) > _celebrityFollowers {
+		return nil
+	}
+
+	if err = t.cache.RemovePin(ctx, followersIDs, pinID); err != nil {
+		return fmt.Errorf("remove pin from timelines: %w", err)
+	}
+	return nil
+}
+
+// InvalidateTimeline drops the timeline of the user whose subscriptions have changed,
+// it is built again on the next read.
+func (t *timelineCase) InvalidateTimeline(ctx context.Context, userID int) error {
+	if err := t.cache.DeleteTimeline(ctx, userID); err != nil {
+		return fmt.Errorf("invalidate timeline: %w", err)
+	}
+	return nil
+}
+
+// getPinIDs reads the ids of the next page of the following feed from the timeline and the pulled sources,
+// it reports false when the page can't be served by the timeline.
+func (t *timelineCase) getPinIDs(ctx context.Context, userID int, cond pin.Condition, count int) ([]int, bool, error) {
+	pinIDs, truncated, err := t.cache.GetTimeline(ctx, userID, cond, count)
+	if err != nil {
+		if !errors.As(err, new(*timelineRepo.ErrNotCached)) {
+			t.log.Warn(err.Error())
+			return nil, false, nil
+		}
+
+		if pinIDs, truncated, err = t.rebuildTimeline(ctx, userID, cond, count); err != nil {
+			return nil, false, err
+		}
+	}
+	if truncated {
+		return nil, false, nil
+	}
+
+	pulledPinIDs, err := t.repo.GetPulledPinIDs(ctx, userID, _celebrityFollowers, cond, count)
+	if err != nil {
+		return nil, false, fmt.Errorf("get following feed: %w", err)
+	}
+	return mergePinIDs(pinIDs, pulledPinIDs, count), true, nil
+}
+
+func (t *timelineCase) rebuildTimeline(ctx context.Context, userID int, cond pin.Condition, count int) ([]int, bool, error) {
+	pinIDs, err := t.repo.GetFollowedPinIDs(ctx, userID, _celebrityFollowers, timelineRepo.MaxLength)
+	if err != nil {
+		return nil, false, fmt.Errorf("rebuild timeline: %w", err)
+	}
+	if err = t.cache.SetTimeline(ctx, userID, pinIDs, _timelineLifetime); err != nil {
+		t.log.Warn(err.Error())
+	}
+
+	page := selectPage(pinIDs, cond, count)
+	return page, len(page) < count && len(pinIDs) >= timelineRepo.MaxLength, nil
+}
+
+// selectPage picks from the ids sorted in descending order the same page that the cache returns.
+func selectPage(pinIDs []int, cond pin.Condition, count int) []int {
+	page := make([]int, 0, count)
+	for _, pinID := range pinIDs {
+		if len(page) < count && pinID > cond.MaxID {
+			page = append(page, pinID)
+		}
+	}
+	for _, pinID := range pinIDs {
+		if len(page) < count && pinID < cond.MinID {
+			page = append(page, pinID)
+		}
+	}
+	return page
+}
+
+// skipPinIDs moves the cursor past the read ids sorted in descending order the same way the loaded page does.
+func skipPinIDs(cond pin.Condition, pinIDs []int) pin.Condition {
+	if len(pinIDs) == 0 {
+		return cond
+	}
+	if pinIDs[0] > cond.MaxID {
+		cond.MaxID = pinIDs[0]
+	}
+	if last := pinIDs[len(pinIDs)-1]; last < cond.MinID || cond.MinID == 0 {
+		cond.MinID = last
+	}
+	return cond
+}
+
+// mergePinIDs merges two lists of the ids sorted in descending order and keeps the first count unique ones.
+func mergePinIDs(first, second []int, count int) []int {
+	merged := make([]int, 0, min(count, len(first)+len(second)))
+	for len(merged) < count && (len(first) > 0 || len(second) > 0) {
+		var next int
+		if len(second) == 0 || len(first) > 0 && first[0] >= second[0] {
+			next, first = first[0], first[1:]
+		} else {
+			next, second = second[0], second[1:]
+		}
+		if len(merged) == 0 || merged[len(merged)-1] != next {
+			merged = append(merged, next)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,158 @@
+package timeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/pin"
+	pinMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/pin/mock"
+	timelineRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/timeline"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/timeline/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+func TestGetFollowingFeed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	cache := mock.NewMockCacheRepository(ctrl)
+	pins := pinMock.NewMockRepository(ctrl)
+	uc := New(log, repo, cache, pins)
+
+	cfg := pin.FeedPinConfig{Count: 2, Following: true, Protection: pin.FeedProtectionPublic}
+	cfg.SetViewer(1)
+
+	loadCfg := cfg
+	loadCfg.Following = false
+	loadCfg.SetPins([]int{31, 30})
+	wantFeed := pin.FeedPin{
+		Condition: pin.Condition{MinID: 30, MaxID: 31},
+		Pins:      []pin.Pin{{ID: 31}, {ID: 30}},
+	}
+
+	cache.EXPECT().GetTimeline(ctx, 1, pin.Condition{}, 2).Return(nil, false, &timelineRepo.ErrNotCached{}).Times(1)
+	repo.EXPECT().GetFollowedPinIDs(ctx, 1, _celebrityFollowers, timelineRepo.MaxLength).Return([]int{30, 12}, nil).Times(1)
+	cache.EXPECT().SetTimeline(ctx, 1, []int{30, 12}, _timelineLifetime).Return(nil).Times(1)
+	repo.EXPECT().GetPulledPinIDs(ctx, 1, _celebrityFollowers, pin.Condition{}, 2).Return([]int{31}, nil).Times(1)
+	pins.EXPECT().GetFeedPins(ctx, loadCfg).Return(wantFeed, nil).Times(1)
+
+	feed, err := uc.GetFollowingFeed(ctx, 1, cfg)
+	require.NoError(t, err)
+	require.Equal(t, wantFeed, feed)
+}
+
+func TestGetFollowingFeedSkipsDroppedPins(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	cache := mock.NewMockCacheRepository(ctrl)
+	pins := pinMock.NewMockRepository(ctrl)
+	uc := New(log, repo, cache, pins)
+
+	cfg := pin.FeedPinConfig{Count: 2, Following: true, Protection: pin.FeedProtectionPublic}
+	cfg.SetViewer(1)
+
+	// the pin 30 is deleted since it got into the timeline, the page is filled from the rest of the timeline
+	firstCfg := cfg
+	firstCfg.Following = false
+	firstCfg.SetPins([]int{31, 30})
+	secondCfg := firstCfg
+	secondCfg.Condition = pin.Condition{MinID: 30, MaxID: 31}
+	secondCfg.Count = 1
+	secondCfg.SetPins([]int{12})
+
+	cache.EXPECT().GetTimeline(ctx, 1, pin.Condition{}, 2).Return([]int{31, 30}, false, nil).Times(1)
+	repo.EXPECT().GetPulledPinIDs(ctx, 1, _celebrityFollowers, pin.Condition{}, 2).Return(nil, nil).Times(1)
+	pins.EXPECT().GetFeedPins(ctx, firstCfg).
+		Return(pin.FeedPin{Condition: pin.Condition{MinID: 31, MaxID: 31}, Pins: []pin.Pin{{ID: 31}}}, nil).Times(1)
+	cache.EXPECT().GetTimeline(ctx, 1, secondCfg.Condition, 1).Return([]int{12}, false, nil).Times(1)
+	repo.EXPECT().GetPulledPinIDs(ctx, 1, _celebrityFollowers, secondCfg.Condition, 1).Return(nil, nil).Times(1)
+	pins.EXPECT().GetFeedPins(ctx, secondCfg).
+		Return(pin.FeedPin{Condition: pin.Condition{MinID: 12, MaxID: 31}, Pins: []pin.Pin{{ID: 12}}}, nil).Times(1)
+
+	feed, err := uc.GetFollowingFeed(ctx, 1, cfg)
+	require.NoError(t, err)
+	require.Equal(t, pin.FeedPin{Condition: pin.Condition{MinID: 12, MaxID: 31}, Pins: []pin.Pin{{ID: 31}, {ID: 12}}}, feed)
+
+	// the exhausted timeline with all its pins left out still moves the cursor
+	cfg.Condition = pin.Condition{MinID: 12, MaxID: 31}
+	lastCfg := cfg
+	lastCfg.Following = false
+	lastCfg.SetPins([]int{7})
+
+	cache.EXPECT().GetTimeline(ctx, 1, cfg.Condition, 2).Return([]int{7}, false, nil).Times(1)
+	repo.EXPECT().GetPulledPinIDs(ctx, 1, _celebrityFollowers, cfg.Condition, 2).Return(nil, nil).Times(1)
+	pins.EXPECT().GetFeedPins(ctx, lastCfg).Return(pin.FeedPin{Condition: cfg.Condition}, nil).Times(1)
+
+	feed, err = uc.GetFollowingFeed(ctx, 1, cfg)
+	require.NoError(t, err)
+	require.Empty(t, feed.Pins)
+	require.Equal(t, pin.Condition{MinID: 7, MaxID: 31}, feed.Condition)
+}
+
+func TestPushPin(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := mock.NewMockRepository(ctrl)
+	cache := mock.NewMockCacheRepository(ctrl)
+	uc := New(log, repo, cache, nil)
+
+	repo.EXPECT().GetFollowersIDs(ctx, 5, _celebrityFollowers+1).Return([]int{2, 3}, nil).Times(1)
+	cache.EXPECT().PushPin(ctx, []int{2, 3}, 40).Return(nil).Times(1)
+	require.NoError(t, uc.PushPin(ctx, 5, 40))
+
+	celebrityFollowers := make([]int, _celebrityFollowers+1)
+	repo.EXPECT().GetFollowersIDs(ctx, 6, _celebrityFollowers+1).Return(celebrityFollowers, nil).Times(1)
+	require.NoError(t, uc.PushPin(ctx, 6, 41))
+}
+
+func TestInvalidateTimeline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache := mock.NewMockCacheRepository(ctrl)
+	uc := New(log, nil, cache, nil)
+
+	cache.EXPECT().DeleteTimeline(ctx, 4).Return(nil).Times(1)
+	require.NoError(t, uc.InvalidateTimeline(ctx, 4))
+}
+
+func TestMergePinIDs(t *testing.T) {
+	require.Equal(t, []int{9, 7, 5, 4}, mergePinIDs([]int{9, 5, 4, 1}, []int{7, 5}, 4))
+	require.Equal(t, []int{3}, mergePinIDs(nil, []int{3}, 4))
+	require.Equal(t, []int{}, mergePinIDs(nil, nil, 4))
+}
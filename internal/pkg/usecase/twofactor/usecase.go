@@ -0,0 +1,228 @@
+package twofactor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tokenRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/token"
+	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/twofactor"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/totp"
+)
+
+const (
+	Issuer             = "Pinspire"
+	ChallengeLifeTime  = 5 * time.Minute
+	CountRecoveryCodes = 10
+
+	lenRecoveryCode   = 10
+	lenChallengeToken = 32
+
+	// restoreChallengePrefix marks the challenge of the deleted account, the prefix is a part
+	// of the stored token, so it can not be added to or removed from the token by the client.
+	restoreChallengePrefix = "restore."
+)
+
+var (
+	ErrInvalidCode      = errors.New("invalid two-factor authentication code")
+	ErrNotEnrolled      = errors.New("two-factor authentication has not been enrolled")
+	ErrNotEnabled       = errors.New("two-factor authentication is not enabled")
+	ErrInvalidChallenge = errors.New("invalid or expired second factor challenge")
+)
+
+//go:generate mockgen -destination=./mock/twofactor_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	Enroll(ctx context.Context, userID int, account string) (secret, uri string, err error)
+	Activate(ctx context.Context, userID int, code string) (recoveryCodes []string, err error)
+	Disable(ctx context.Context, userID int, code string) error
+	IsEnabled(ctx context.Context, userID int) (bool, error)
+	NewChallenge(ctx context.Context, userID int) (string, error)
+	NewRestoreChallenge(ctx context.Context, userID int) (string, error)
+	PassChallenge(ctx context.Context, token, code string) (int, error)
+}
+
+type twoFactorCase struct {
+	log        *logger.Logger
+	repo       repo.Repository
+	challenges tokenRepo.Repository
+	now        func() time.Time
+}
+
+func New(log *logger.Logger, repo repo.Repository, challenges tokenRepo.Repository) *twoFactorCase {
+	return &twoFactorCase{
+		log:        log,
+		repo:       repo,
+		challenges: challenges,
+		now:        time.Now,
+	}
+}
+
+// Enroll generates a new secret for the user, it is not required
+// on login until the user confirms it with a code in Activate.
+func (t *twoFactorCase) Enroll(ctx context.Context, userID int, account string) (secret, uri string, err error) {
+	secret, err = totp.NewSecret()
+	if err != nil {
+		return "", "", fmt.Errorf("enroll totp: %w", err)
+	}
+
+	if err = t.repo.SetPendingSecret(ctx, userID, secret); err != nil {
+		return "", "", fmt.Errorf("enroll totp: %w", err)
+	}
+	return secret, totp.URI(Issuer, account, secret), nil
+}
+
+// Activate enables the enrolled secret if the code is correct and returns
+// the recovery codes, they are shown to the user only once.
+func (t *twoFactorCase) Activate(ctx context.Context, userID int, code string) ([]string, error) {
+	secret, enabled, err := t.repo.GetSecret(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("activate totp: %w", err)
+	}
+	if enabled {
+		return nil, &repo.ErrAlreadyEnabled{}
+	}
+	if secret == "" {
+		return nil, ErrNotEnrolled
+	}
+	if ok, err := t.useCode(ctx, userID, secret, code); err != nil {
+		return nil, fmt.Errorf("activate totp: %w", err)
+	} else if !ok {
+		return nil, ErrInvalidCode
+	}
+
+	codes := make([]string, 0, CountRecoveryCodes)
+	hashes := make([]string, 0, CountRecoveryCodes)
+	for i := 0; i < CountRecoveryCodes; i++ {
+		code, err := crypto.NewRandomString(lenRecoveryCode)
+		if err != nil {
+			return nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	if err = t.repo.Enable(ctx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("activate totp: %w", err)
+	}
+	return codes, nil
+}
+
+func (t *twoFactorCase) Disable(ctx context.Context, userID int, code string) error {
+	if err := t.verify(ctx, userID, code); err != nil {
+		return fmt.Errorf("disable totp: %w", err)
+	}
+
+	if err := t.repo.Disable(ctx, userID); err != nil {
+		return fmt.Errorf("disable totp: %w", err)
+	}
+	return nil
+}
+
+func (t *twoFactorCase) IsEnabled(ctx context.Context, userID int) (bool, error) {
+	_, enabled, err := t.repo.GetSecret(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("check totp enabled: %w", err)
+	}
+	return enabled, nil
+}
+
+// NewChallenge returns a token which confirms that the user has passed the first factor.
+func (t *twoFactorCase) NewChallenge(ctx context.Context, userID int) (string, error) {
+	return t.newChallenge(ctx, userID, "")
+}
+
+// NewRestoreChallenge is NewChallenge for the deleted account, which is restored
+// only after the challenge is passed.
+func (t *twoFactorCase) NewRestoreChallenge(ctx context.Context, userID int) (string, error) {
+	return t.newChallenge(ctx, userID, restoreChallengePrefix)
+}
+
+// IsRestoreChallenge reports whether the token was issued by NewRestoreChallenge.
+func IsRestoreChallenge(token string) bool {
+	return strings.HasPrefix(token, restoreChallengePrefix)
+}
+
+func (t *twoFactorCase) newChallenge(ctx context.Context, userID int, prefix string) (string, error) {
+	token, err := crypto.NewRandomString(lenChallengeToken)
+	if err != nil {
+		return "", fmt.Errorf("generate second factor challenge: %w", err)
+	}
+	token = prefix + token
+
+	if err = t.challenges.AddToken(ctx, token, userID, ChallengeLifeTime); err != nil {
+		return "", fmt.Errorf("new second factor challenge: %w", err)
+	}
+	return token, nil
+}
+
+// PassChallenge checks the code for the user of the challenge. The challenge can be used only once,
+// so after a wrong code the login starts over. The user is returned even if the code is wrong.
+func (t *twoFactorCase) PassChallenge(ctx context.Context, token, code string) (int, error) {
+	userID, err := t.challenges.TakeUserIDByToken(ctx, token)
+	if errors.Is(err, tokenRepo.ErrNotFoundToken) {
+		return 0, ErrInvalidChallenge
+	}
+	if err != nil {
+		return 0, fmt.Errorf("pass second factor challenge: %w", err)
+	}
+
+	if err = t.verify(ctx, userID, code); err != nil {
+		return userID, fmt.Errorf("pass second factor challenge: %w", err)
+	}
+	return userID, nil
+}
+
+// verify accepts either the current code or one of the unused recovery codes.
+func (t *twoFactorCase) verify(ctx context.Context, userID int, code string) error {
+	secret, enabled, err := t.repo.GetSecret(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return ErrNotEnabled
+	}
+	if ok, err := t.useCode(ctx, userID, secret, code); err != nil || ok {
+		return err
+	}
+
+	var notFound *repo.ErrNotFoundRecoveryCode
+	err = t.repo.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+	if errors.As(err, &notFound) {
+		return ErrInvalidCode
+	}
+	if err != nil {
+		return err
+	}
+	t.log.Info("recovery code is used", logger.F{"user_id", userID})
+	return nil
+}
+
+// useCode reports whether the totp code is valid and has not been used yet, the code of the same
+// or an earlier time step than the last accepted one is rejected, so it can not be replayed.
+func (t *twoFactorCase) useCode(ctx context.Context, userID int, secret, code string) (bool, error) {
+	step, ok := totp.Step(secret, code, t.now())
+	if !ok {
+		return false, nil
+	}
+
+	err := t.repo.UseStep(ctx, userID, step)
+	if errors.As(err, new(*repo.ErrUsedStep)) {
+		return false, ErrInvalidCode
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// hashRecoveryCode uses a fast hash since the recovery codes are random and long enough.
+func hashRecoveryCode(code string) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,162 @@
+package twofactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tokenRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/token"
+	tokenMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/token/mock"
+	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/twofactor"
+	repoMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/twofactor/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/totp"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "JBSWY3DPEHPK3PXP"
+
+func TestActivate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := repoMock.NewMockRepository(ctrl)
+	tc := New(log, repository, tokenMock.NewMockRepository(ctrl))
+
+	code, err := totp.Code(testSecret, time.Now())
+	require.NoError(t, err)
+
+	var hashes []string
+	repository.EXPECT().GetSecret(ctx, 12).Return(testSecret, false, nil).Times(1)
+	repository.EXPECT().UseStep(ctx, 12, gomock.Any()).Return(nil).Times(1)
+	repository.EXPECT().
+		Enable(ctx, 12, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, h []string) error {
+			hashes = h
+			return nil
+		}).
+		Times(1)
+
+	codes, err := tc.Activate(ctx, 12, code)
+	require.NoError(t, err)
+	require.Len(t, codes, CountRecoveryCodes)
+	require.Len(t, hashes, CountRecoveryCodes)
+	for i := range codes {
+		require.Equal(t, hashRecoveryCode(codes[i]), hashes[i])
+	}
+
+	repository.EXPECT().GetSecret(ctx, 12).Return(testSecret, false, nil).Times(1)
+	_, err = tc.Activate(ctx, 12, "000000x")
+	require.ErrorIs(t, err, ErrInvalidCode)
+
+	repository.EXPECT().GetSecret(ctx, 12).Return("", false, nil).Times(1)
+	_, err = tc.Activate(ctx, 12, code)
+	require.ErrorIs(t, err, ErrNotEnrolled)
+
+	repository.EXPECT().GetSecret(ctx, 12).Return(testSecret, true, nil).Times(1)
+	_, err = tc.Activate(ctx, 12, code)
+	require.ErrorAs(t, err, new(*repo.ErrAlreadyEnabled))
+}
+
+func TestPassChallenge(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := repoMock.NewMockRepository(ctrl)
+	challenges := tokenMock.NewMockRepository(ctrl)
+	tc := New(log, repository, challenges)
+
+	code, err := totp.Code(testSecret, time.Now())
+	require.NoError(t, err)
+
+	step, ok := totp.Step(testSecret, code, time.Now())
+	require.True(t, ok)
+
+	challenges.EXPECT().TakeUserIDByToken(ctx, "token").Return(12, nil).Times(1)
+	repository.EXPECT().GetSecret(ctx, 12).Return(testSecret, true, nil).Times(1)
+	repository.EXPECT().UseStep(ctx, 12, step).Return(nil).Times(1)
+
+	userID, err := tc.PassChallenge(ctx, "token", code)
+	require.NoError(t, err)
+	require.Equal(t, 12, userID)
+
+	// the accepted code can not be replayed while it is still valid
+	challenges.EXPECT().TakeUserIDByToken(ctx, "token").Return(12, nil).Times(1)
+	repository.EXPECT().GetSecret(ctx, 12).Return(testSecret, true, nil).Times(1)
+	repository.EXPECT().UseStep(ctx, 12, step).Return(&repo.ErrUsedStep{}).Times(1)
+
+	userID, err = tc.PassChallenge(ctx, "token", code)
+	require.ErrorIs(t, err, ErrInvalidCode)
+	require.Equal(t, 12, userID)
+
+	recoveryCode := "Abcdef1234"
+	challenges.EXPECT().TakeUserIDByToken(ctx, "token").Return(12, nil).Times(1)
+	repository.EXPECT().GetSecret(ctx, 12).Return(testSecret, true, nil).Times(1)
+	repository.EXPECT().UseRecoveryCode(ctx, 12, hashRecoveryCode(recoveryCode)).Return(nil).Times(1)
+
+	userID, err = tc.PassChallenge(ctx, "token", recoveryCode)
+	require.NoError(t, err)
+	require.Equal(t, 12, userID)
+
+	challenges.EXPECT().TakeUserIDByToken(ctx, "token").Return(12, nil).Times(1)
+	repository.EXPECT().GetSecret(ctx, 12).Return(testSecret, true, nil).Times(1)
+	repository.EXPECT().UseRecoveryCode(ctx, 12, hashRecoveryCode(recoveryCode)).
+		Return(&repo.ErrNotFoundRecoveryCode{}).Times(1)
+
+	userID, err = tc.PassChallenge(ctx, "token", recoveryCode)
+	require.ErrorIs(t, err, ErrInvalidCode)
+	require.Equal(t, 12, userID)
+
+	challenges.EXPECT().TakeUserIDByToken(ctx, "expired").Return(0, tokenRepo.ErrNotFoundToken).Times(1)
+
+	_, err = tc.PassChallenge(ctx, "expired", code)
+	require.ErrorIs(t, err, ErrInvalidChallenge)
+}
+
+func TestNewRestoreChallenge(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	challenges := tokenMock.NewMockRepository(ctrl)
+	tc := New(log, repoMock.NewMockRepository(ctrl), challenges)
+
+	var stored []string
+	challenges.EXPECT().
+		AddToken(ctx, gomock.Any(), 12, ChallengeLifeTime).
+		DoAndReturn(func(_ context.Context, token string, _ int, _ time.Duration) error {
+			stored = append(stored, token)
+			return nil
+		}).
+		Times(2)
+
+	token, err := tc.NewChallenge(ctx, 12)
+	require.NoError(t, err)
+	require.False(t, IsRestoreChallenge(token))
+
+	restoreToken, err := tc.NewRestoreChallenge(ctx, 12)
+	require.NoError(t, err)
+	require.True(t, IsRestoreChallenge(restoreToken))
+	require.Equal(t, []string{token, restoreToken}, stored)
+}
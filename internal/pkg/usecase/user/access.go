@@ -0,0 +1,21 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrUserSuspended = errors.New("the account is suspended")
+
+// GetRole returns the global role of the user, the suspended user gets ErrUserSuspended instead.
+func (u *userCase) GetRole(ctx context.Context, userID int) (string, error) {
+	role, suspended, err := u.repo.GetAccess(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("get role of the user: %w", err)
+	}
+	if suspended {
+		return "", ErrUserSuspended
+	}
+	return role, nil
+}
@@ -5,16 +5,15 @@ import (
 	"fmt"
 
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
-	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
 )
 
 func (u *userCase) Register(ctx context.Context, user *entity.User) error {
-	salt, err := crypto.NewRandomString(lenSalt)
+	var err error
+	user.Password, err = u.hashPassword(user.Password)
 	if err != nil {
-		return fmt.Errorf("generating salt for registration: %w", err)
+		return fmt.Errorf("hashing password for registration: %w", err)
 	}
 
-	user.Password = salt + crypto.PasswordHash(user.Password, salt, lenPasswordHash)
 	err = u.repo.AddNewUser(ctx, user)
 	if err != nil {
 		return fmt.Errorf("user registration: %w", err)
@@ -27,10 +26,13 @@ func (u *userCase) Authentication(ctx context.Context, credentials UserCredentia
 	if err != nil {
 		return nil, fmt.Errorf("user authentication: %w", err)
 	}
-	salt := user.Password[:lenSalt]
-	if crypto.PasswordHash(credentials.Password, salt, lenPasswordHash) != user.Password[lenSalt:] {
+	correct, needRehash := u.checkPassword(user.Password, credentials.Password)
+	if !correct {
 		return nil, ErrUserAuthentication
 	}
+	if needRehash {
+		u.rehashPassword(ctx, user.ID, credentials.Password)
+	}
 	user.Password = ""
 	return user, nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	repository "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user/mock"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
@@ -102,6 +103,10 @@ func TestAuthentication(t *testing.T) {
 		GetUserByUsername(ctx, cred.Username).
 		Return(userAuth, nil).
 		Times(1)
+	repoMock.EXPECT().
+		EditUserInfo(ctx, userAuth.ID, gomock.Any()).
+		Return(nil).
+		Times(1)
 
 	actualUser, err = usecase.Authentication(ctx, cred)
 	require.NoError(t, err)
@@ -109,6 +114,59 @@ func TestAuthentication(t *testing.T) {
 	require.Equal(t, userAuth, actualUser)
 }
 
+func TestAuthenticationRehash(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoMock := mock.NewMockRepository(ctrl)
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	weakParams := crypto.Argon2Params{Time: 1, Memory: 1024, Threads: 1, SaltLen: 16, KeyLen: 32}
+	strongParams := crypto.Argon2Params{Time: 2, Memory: 1024, Threads: 1, SaltLen: 16, KeyLen: 32}
+	usecase := New(log, nil, repoMock, PasswordHashParams(strongParams))
+	cred := UserCredentials{
+		Password: "1234",
+		Username: "test",
+	}
+
+	hash, err := crypto.EncodePasswordHash(cred.Password, strongParams)
+	require.NoError(t, err)
+	repoMock.EXPECT().
+		GetUserByUsername(ctx, cred.Username).
+		Return(&user.User{ID: 4, Password: hash}, nil).
+		Times(1)
+
+	_, err = usecase.Authentication(ctx, cred)
+	require.NoError(t, err)
+
+	hash, err = crypto.EncodePasswordHash(cred.Password, weakParams)
+	require.NoError(t, err)
+	var newHash string
+	repoMock.EXPECT().
+		GetUserByUsername(ctx, cred.Username).
+		Return(&user.User{ID: 4, Password: hash}, nil).
+		Times(1)
+	repoMock.EXPECT().
+		EditUserInfo(ctx, 4, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, fields repository.S) error {
+			newHash = fields["password"].(string)
+			return nil
+		}).
+		Times(1)
+
+	_, err = usecase.Authentication(ctx, cred)
+	require.NoError(t, err)
+	correct, params, err := crypto.ComparePasswordHash(newHash, cred.Password)
+	require.NoError(t, err)
+	require.True(t, correct)
+	require.Equal(t, strongParams, params)
+}
+
 func TestFindOutUsernameAndAvatar(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
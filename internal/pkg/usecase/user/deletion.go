@@ -0,0 +1,79 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	repository "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+const DefaultDeletionGracePeriod = 30 * 24 * time.Hour
+
+// DeleteAccount soft-deletes the user with their pins, boards and comments. The account can be
+// restored with RestoreAccount during the grace period, after it the account is purged.
+func (u *userCase) DeleteAccount(ctx context.Context, userID int, password string) error {
+	hash, err := u.repo.GetPasswordByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get password for account deletion: %w", err)
+	}
+	if correct, _ := u.checkPassword(hash, password); !correct {
+		return ErrUserAuthentication
+	}
+
+	if err = u.repo.DeleteUser(ctx, userID, u.now()); err != nil {
+		return fmt.Errorf("delete account: %w", err)
+	}
+	return nil
+}
+
+// AuthenticateDeletedAccount checks the credentials of the deleted account whose grace period
+// has not passed yet. The account stays deleted until RestoreAccount is called.
+func (u *userCase) AuthenticateDeletedAccount(ctx context.Context, credentials UserCredentials) (*entity.User, error) {
+	var notExist *repository.ErrNonExistingUser
+	user, deletedAt, err := u.repo.GetDeletedUserByUsername(ctx, credentials.Username)
+	if errors.As(err, &notExist) {
+		return nil, ErrUserAuthentication
+	}
+	if err != nil {
+		return nil, fmt.Errorf("authenticate deleted account: %w", err)
+	}
+
+	if correct, _ := u.checkPassword(user.Password, credentials.Password); !correct {
+		return nil, ErrUserAuthentication
+	}
+	if u.now().Sub(deletedAt) >= u.deletionGracePeriod {
+		return nil, ErrUserAuthentication
+	}
+	user.Password = ""
+	return user, nil
+}
+
+// RestoreAccount undoes the deletion of the account, the user must be authenticated
+// with AuthenticateDeletedAccount and the second factor if it is enabled.
+func (u *userCase) RestoreAccount(ctx context.Context, userID int) error {
+	var notExist *repository.ErrNonExistingUser
+	err := u.repo.RestoreUser(ctx, userID)
+	if errors.As(err, &notExist) {
+		return ErrUserAuthentication
+	}
+	if err != nil {
+		return fmt.Errorf("restore account: %w", err)
+	}
+	return nil
+}
+
+// PurgeDeletedAccounts removes for good the accounts whose grace period has passed.
+func (u *userCase) PurgeDeletedAccounts(ctx context.Context) (int64, error) {
+	purged, err := u.repo.PurgeUsersDeletedBefore(ctx, u.now().Add(-u.deletionGracePeriod))
+	if err != nil {
+		return 0, fmt.Errorf("purge deleted accounts: %w", err)
+	}
+	if purged > 0 {
+		u.log.Info("deleted accounts have been purged", logger.F{"count", purged})
+	}
+	return purged, nil
+}
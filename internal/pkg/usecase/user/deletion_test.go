@@ -0,0 +1,104 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
+	repository "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+var testHashParams = crypto.Argon2Params{Time: 1, Memory: 1024, Threads: 1, SaltLen: 16, KeyLen: 32}
+
+func TestDeleteAccount(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoMock := mock.NewMockRepository(ctrl)
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	usecase := New(log, nil, repoMock, PasswordHashParams(testHashParams))
+	now := time.Date(2023, time.December, 1, 12, 0, 0, 0, time.UTC)
+	usecase.now = func() time.Time { return now }
+
+	hash, err := crypto.EncodePasswordHash("1234", testHashParams)
+	require.NoError(t, err)
+
+	repoMock.EXPECT().GetPasswordByID(ctx, 4).Return(hash, nil).Times(1)
+	require.ErrorIs(t, usecase.DeleteAccount(ctx, 4, "4321"), ErrUserAuthentication)
+
+	repoMock.EXPECT().GetPasswordByID(ctx, 4).Return(hash, nil).Times(1)
+	repoMock.EXPECT().DeleteUser(ctx, 4, now).Return(nil).Times(1)
+	require.NoError(t, usecase.DeleteAccount(ctx, 4, "1234"))
+}
+
+func TestRestoreAccount(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoMock := mock.NewMockRepository(ctrl)
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	usecase := New(log, nil, repoMock, PasswordHashParams(testHashParams), DeletionGracePeriod(24*time.Hour))
+	now := time.Date(2023, time.December, 1, 12, 0, 0, 0, time.UTC)
+	usecase.now = func() time.Time { return now }
+	cred := UserCredentials{Username: "test", Password: "1234"}
+
+	hash, err := crypto.EncodePasswordHash(cred.Password, testHashParams)
+	require.NoError(t, err)
+
+	repoMock.EXPECT().
+		GetDeletedUserByUsername(ctx, cred.Username).
+		Return(&user.User{ID: 4, Username: cred.Username, Password: hash}, now.Add(-time.Hour), nil).
+		Times(1)
+
+	actualUser, err := usecase.AuthenticateDeletedAccount(ctx, cred)
+	require.NoError(t, err)
+	require.Equal(t, 4, actualUser.ID)
+	require.Empty(t, actualUser.Password)
+
+	repoMock.EXPECT().RestoreUser(ctx, 4).Return(nil).Times(1)
+	require.NoError(t, usecase.RestoreAccount(ctx, 4))
+
+	repoMock.EXPECT().RestoreUser(ctx, 4).Return(&repository.ErrNonExistingUser{}).Times(1)
+	require.ErrorIs(t, usecase.RestoreAccount(ctx, 4), ErrUserAuthentication)
+
+	repoMock.EXPECT().
+		GetDeletedUserByUsername(ctx, cred.Username).
+		Return(&user.User{ID: 4, Username: cred.Username, Password: hash}, now.Add(-25*time.Hour), nil).
+		Times(1)
+
+	_, err = usecase.AuthenticateDeletedAccount(ctx, cred)
+	require.ErrorIs(t, err, ErrUserAuthentication)
+
+	repoMock.EXPECT().
+		GetDeletedUserByUsername(ctx, cred.Username).
+		Return(nil, time.Time{}, &repository.ErrNonExistingUser{}).
+		Times(1)
+
+	_, err = usecase.AuthenticateDeletedAccount(ctx, cred)
+	require.ErrorIs(t, err, ErrUserAuthentication)
+
+	repoMock.EXPECT().PurgeUsersDeletedBefore(ctx, now.Add(-24*time.Hour)).Return(int64(2), nil).Times(1)
+
+	purged, err := usecase.PurgeDeletedAccounts(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), purged)
+}
@@ -10,4 +10,5 @@ type ProfileUpdateData struct {
 	Surname  *string `json:"surname"`
 	AboutMe  *string `json:"about_me"`
 	Password *string `json:"password"`
+	Private  *bool   `json:"private"`
 }
@@ -96,6 +96,16 @@ func easyjsonDdc53814DecodeGithubComGoParkMailRu20232ONDTeamInternalPkgUsecaseUs
 				}
 				*out.Password = string(in.String())
 			}
+		case "private":
+			if in.IsNull() {
+				in.Skip()
+				out.Private = nil
+			} else {
+				if out.Private == nil {
+					out.Private = new(bool)
+				}
+				*out.Private = bool(in.Bool())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -164,6 +174,15 @@ func easyjsonDdc53814EncodeGithubComGoParkMailRu20232ONDTeamInternalPkgUsecaseUs
 			out.String(string(*in.Password))
 		}
 	}
+	{
+		const prefix string = ",\"private\":"
+		out.RawString(prefix)
+		if in.Private == nil {
+			out.RawString("null")
+		} else {
+			out.Bool(bool(*in.Private))
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -37,6 +37,21 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// AuthenticateDeletedAccount mocks base method.
+func (m *MockUsecase) AuthenticateDeletedAccount(ctx context.Context, credentials user0.UserCredentials) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateDeletedAccount", ctx, credentials)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateDeletedAccount indicates an expected call of AuthenticateDeletedAccount.
+func (mr *MockUsecaseMockRecorder) AuthenticateDeletedAccount(ctx, credentials interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateDeletedAccount", reflect.TypeOf((*MockUsecase)(nil).AuthenticateDeletedAccount), ctx, credentials)
+}
+
 // Authentication mocks base method.
 func (m *MockUsecase) Authentication(ctx context.Context, credentials user0.UserCredentials) (*user.User, error) {
 	m.ctrl.T.Helper()
@@ -52,6 +67,63 @@ func (mr *MockUsecaseMockRecorder) Authentication(ctx, credentials interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authentication", reflect.TypeOf((*MockUsecase)(nil).Authentication), ctx, credentials)
 }
 
+// ChangePassword mocks base method.
+func (m *MockUsecase) ChangePassword(ctx context.Context, userID int, oldPassword, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userID, oldPassword, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUsecaseMockRecorder) ChangePassword(ctx, userID, oldPassword, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUsecase)(nil).ChangePassword), ctx, userID, oldPassword, newPassword)
+}
+
+// CheckEmailVerified mocks base method.
+func (m *MockUsecase) CheckEmailVerified(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckEmailVerified", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckEmailVerified indicates an expected call of CheckEmailVerified.
+func (mr *MockUsecaseMockRecorder) CheckEmailVerified(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEmailVerified", reflect.TypeOf((*MockUsecase)(nil).CheckEmailVerified), ctx, userID)
+}
+
+// CheckProfileAccess mocks base method.
+func (m *MockUsecase) CheckProfileAccess(ctx context.Context, ownerID, viewerID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckProfileAccess", ctx, ownerID, viewerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckProfileAccess indicates an expected call of CheckProfileAccess.
+func (mr *MockUsecaseMockRecorder) CheckProfileAccess(ctx, ownerID, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckProfileAccess", reflect.TypeOf((*MockUsecase)(nil).CheckProfileAccess), ctx, ownerID, viewerID)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockUsecase) DeleteAccount(ctx context.Context, userID int, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, userID, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockUsecaseMockRecorder) DeleteAccount(ctx, userID, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockUsecase)(nil).DeleteAccount), ctx, userID, password)
+}
+
 // EditProfileInfo mocks base method.
 func (m *MockUsecase) EditProfileInfo(ctx context.Context, userID int, updateData *user0.ProfileUpdateData) error {
 	m.ctrl.T.Helper()
@@ -113,6 +185,21 @@ func (mr *MockUsecaseMockRecorder) GetProfileInfo(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileInfo", reflect.TypeOf((*MockUsecase)(nil).GetProfileInfo), ctx)
 }
 
+// GetRole mocks base method.
+func (m *MockUsecase) GetRole(ctx context.Context, userID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockUsecaseMockRecorder) GetRole(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockUsecase)(nil).GetRole), ctx, userID)
+}
+
 // GetUserInfo mocks base method.
 func (m *MockUsecase) GetUserInfo(ctx context.Context, userID int) (*user.User, bool, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +217,21 @@ func (mr *MockUsecaseMockRecorder) GetUserInfo(ctx, userID interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockUsecase)(nil).GetUserInfo), ctx, userID)
 }
 
+// PurgeDeletedAccounts mocks base method.
+func (m *MockUsecase) PurgeDeletedAccounts(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedAccounts", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedAccounts indicates an expected call of PurgeDeletedAccounts.
+func (mr *MockUsecaseMockRecorder) PurgeDeletedAccounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedAccounts", reflect.TypeOf((*MockUsecase)(nil).PurgeDeletedAccounts), ctx)
+}
+
 // Register mocks base method.
 func (m *MockUsecase) Register(ctx context.Context, user *user.User) error {
 	m.ctrl.T.Helper()
@@ -144,6 +246,34 @@ func (mr *MockUsecaseMockRecorder) Register(ctx, user interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUsecase)(nil).Register), ctx, user)
 }
 
+// RestoreAccount mocks base method.
+func (m *MockUsecase) RestoreAccount(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAccount indicates an expected call of RestoreAccount.
+func (mr *MockUsecaseMockRecorder) RestoreAccount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAccount", reflect.TypeOf((*MockUsecase)(nil).RestoreAccount), ctx, userID)
+}
+
+// SetPassword mocks base method.
+func (m *MockUsecase) SetPassword(ctx context.Context, userID int, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPassword", ctx, userID, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPassword indicates an expected call of SetPassword.
+func (mr *MockUsecaseMockRecorder) SetPassword(ctx, userID, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPassword", reflect.TypeOf((*MockUsecase)(nil).SetPassword), ctx, userID, password)
+}
+
 // UpdateUserAvatar mocks base method.
 func (m *MockUsecase) UpdateUserAvatar(ctx context.Context, userID int, mimeTypeAvatar string, sizeAvatar int64, avatar io.Reader) error {
 	m.ctrl.T.Helper()
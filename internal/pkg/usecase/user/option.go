@@ -0,0 +1,32 @@
+package user
+
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+)
+
+type Option interface {
+	apply(*userCase)
+}
+
+type funcOption func(*userCase)
+
+func (f funcOption) apply(u *userCase) {
+	f(u)
+}
+
+// PasswordHashParams sets the argon2id parameters for new password hashes,
+// the passwords hashed with weaker parameters are rehashed on login.
+func PasswordHashParams(p crypto.Argon2Params) Option {
+	return funcOption(func(u *userCase) {
+		u.hashParams = p
+	})
+}
+
+// DeletionGracePeriod sets the time during which a deleted account can be restored.
+func DeletionGracePeriod(d time.Duration) Option {
+	return funcOption(func(u *userCase) {
+		u.deletionGracePeriod = d
+	})
+}
@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	repository "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+func (u *userCase) ChangePassword(ctx context.Context, userID int, oldPassword, newPassword string) error {
+	password, err := u.repo.GetPasswordByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get password for change: %w", err)
+	}
+	if correct, _ := u.checkPassword(password, oldPassword); !correct {
+		return ErrUserAuthentication
+	}
+	return u.SetPassword(ctx, userID, newPassword)
+}
+
+func (u *userCase) SetPassword(ctx context.Context, userID int, password string) error {
+	hash, err := u.hashPassword(password)
+	if err != nil {
+		return fmt.Errorf("set password: %w", err)
+	}
+
+	err = u.repo.EditUserInfo(ctx, userID, repository.S{"password": hash})
+	if err != nil {
+		return fmt.Errorf("set password: %w", err)
+	}
+	return nil
+}
+
+func (u *userCase) hashPassword(password string) (string, error) {
+	hash, err := crypto.EncodePasswordHash(password, u.hashParams)
+	if err != nil {
+		return "", fmt.Errorf("hashing password: %w", err)
+	}
+	return hash, nil
+}
+
+// checkPassword compares the password with the stored hash, needRehash is true if the hash
+// was made with weaker parameters than the configured ones.
+func (u *userCase) checkPassword(hash, password string) (correct, needRehash bool) {
+	if !crypto.IsEncodedPasswordHash(hash) {
+		return isCorrectLegacyPassword(hash, password), u.hashParams.StrongerThan(crypto.LegacyArgon2Params)
+	}
+
+	correct, params, err := crypto.ComparePasswordHash(hash, password)
+	if err != nil {
+		u.log.Error(err.Error())
+		return false, false
+	}
+	return correct, u.hashParams.StrongerThan(params)
+}
+
+// rehashPassword replaces the hash of the correct password, a failure does not prevent the login.
+func (u *userCase) rehashPassword(ctx context.Context, userID int, password string) {
+	if err := u.SetPassword(ctx, userID, password); err != nil {
+		u.log.Warn("rehash password: "+err.Error(), logger.F{"user_id", userID})
+		return
+	}
+	u.log.Info("password has been rehashed", logger.F{"user_id", userID})
+}
+
+// isCorrectLegacyPassword checks the hash stored as the salt followed by the hex hash.
+func isCorrectLegacyPassword(hash, password string) bool {
+	if len(hash) < lenSalt {
+		return false
+	}
+	salt := hash[:lenSalt]
+	return crypto.PasswordHash(password, salt, lenPasswordHash) == hash[lenSalt:]
+}
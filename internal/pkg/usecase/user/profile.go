@@ -6,10 +6,11 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/jackc/pgx/v5/pgtype"
+
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/middleware/auth"
 	repository "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
-	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/validator/image/check"
 )
 
@@ -28,9 +29,28 @@ func (u *userCase) UpdateUserAvatar(ctx context.Context, userID int, mimeTypeAva
 	return nil
 }
 
+// GetUserInfo hides the name and the description of the private profile from everyone except its owner and followers.
 func (u *userCase) GetUserInfo(ctx context.Context, userID int) (user *entity.User, isSubscribed bool, subsCount int, err error) {
 	currUserID, _ := ctx.Value(auth.KeyCurrentUserID).(int)
-	return u.repo.GetUserData(ctx, userID, currUserID)
+	user, isSubscribed, subsCount, err = u.repo.GetUserData(ctx, userID, currUserID)
+	if err != nil {
+		return nil, false, 0, err
+	}
+
+	if user.Private && !isSubscribed && user.ID != currUserID {
+		user.Name, user.Surname, user.AboutMe = pgtype.Text{}, pgtype.Text{}, pgtype.Text{}
+	}
+	return user, isSubscribed, subsCount, nil
+}
+
+// CheckProfileAccess reports whether the viewer can see the content of the profile,
+// the content of the private profile is seen only by its owner and approved followers.
+func (u *userCase) CheckProfileAccess(ctx context.Context, ownerID, viewerID int) (bool, error) {
+	ok, err := u.repo.CheckProfileAccess(ctx, ownerID, viewerID)
+	if err != nil {
+		return false, fmt.Errorf("check profile access: %w", err)
+	}
+	return ok, nil
 }
 
 func (u *userCase) GetProfileInfo(ctx context.Context) (user *entity.User, subsCount int, err error) {
@@ -48,6 +68,8 @@ func (u *userCase) EditProfileInfo(ctx context.Context, userID int, updateData *
 	}
 	if updateData.Email != nil {
 		updateFields["email"] = *updateData.Email
+		// the new email has to be verified again
+		updateFields["email_verified_at"] = nil
 	}
 	if updateData.Name != nil {
 		updateFields["name"] = *updateData.Name
@@ -58,13 +80,15 @@ func (u *userCase) EditProfileInfo(ctx context.Context, userID int, updateData *
 	if updateData.AboutMe != nil {
 		updateFields["about_me"] = *updateData.AboutMe
 	}
+	if updateData.Private != nil {
+		updateFields["private"] = *updateData.Private
+	}
 	if updateData.Password != nil {
-		salt, err := crypto.NewRandomString(lenSalt)
+		password, err := u.hashPassword(*updateData.Password)
 		if err != nil {
-			return fmt.Errorf("generating salt for registration: %w", err)
+			return fmt.Errorf("hashing password for profile update: %w", err)
 		}
-
-		updateFields["password"] = salt + crypto.PasswordHash(*updateData.Password, salt, lenPasswordHash)
+		updateFields["password"] = password
 	}
 
 	err := u.repo.EditUserInfo(ctx, userID, updateFields)
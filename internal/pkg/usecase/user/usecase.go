@@ -4,15 +4,18 @@ import (
 	"context"
 	"errors"
 	"io"
+	"time"
 
 	entity "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/entity/user"
 	repo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
 	"github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/usecase/image"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
 	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
 )
 
 var ErrUserAuthentication = errors.New("user authentication")
 
+// the lengths of the salt and the hex hash in the legacy password hashes
 const (
 	lenSalt         = 16
 	lenPasswordHash = 64
@@ -28,18 +31,37 @@ type Usecase interface {
 	GetUserInfo(ctx context.Context, userID int) (user *entity.User, isSubscribed bool, subsCount int, err error)
 	GetProfileInfo(ctx context.Context) (user *entity.User, subsCount int, err error)
 	EditProfileInfo(ctx context.Context, userID int, updateData *ProfileUpdateData) error
+	ChangePassword(ctx context.Context, userID int, oldPassword, newPassword string) error
+	SetPassword(ctx context.Context, userID int, password string) error
+	CheckEmailVerified(ctx context.Context, userID int) error
+	CheckProfileAccess(ctx context.Context, ownerID, viewerID int) (bool, error)
+	GetRole(ctx context.Context, userID int) (string, error)
+	DeleteAccount(ctx context.Context, userID int, password string) error
+	AuthenticateDeletedAccount(ctx context.Context, credentials UserCredentials) (*entity.User, error)
+	RestoreAccount(ctx context.Context, userID int) error
+	PurgeDeletedAccounts(ctx context.Context) (int64, error)
 }
 
 type userCase struct {
 	image.Usecase
-	log  *logger.Logger
-	repo repo.Repository
+	log                 *logger.Logger
+	repo                repo.Repository
+	hashParams          crypto.Argon2Params
+	deletionGracePeriod time.Duration
+	now                 func() time.Time
 }
 
-func New(log *logger.Logger, imgCase image.Usecase, repo repo.Repository) *userCase {
-	return &userCase{
-		Usecase: imgCase,
-		log:     log,
-		repo:    repo,
+func New(log *logger.Logger, imgCase image.Usecase, repo repo.Repository, opts ...Option) *userCase {
+	u := &userCase{
+		Usecase:             imgCase,
+		log:                 log,
+		repo:                repo,
+		hashParams:          crypto.DefaultArgon2Params,
+		deletionGracePeriod: DefaultDeletionGracePeriod,
+		now:                 time.Now,
 	}
+	for _, opt := range opts {
+		opt.apply(u)
+	}
+	return u
 }
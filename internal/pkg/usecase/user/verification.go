@@ -0,0 +1,34 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	errPkg "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/errors"
+)
+
+type ErrEmailNotVerified struct{}
+
+func (e *ErrEmailNotVerified) Error() string {
+	return "the email must be verified to perform this action"
+}
+
+func (e *ErrEmailNotVerified) Type() errPkg.Type {
+	return errPkg.ErrNoAccess
+}
+
+// EmailVerifier is used by other usecases to limit the actions of users with an unverified email.
+type EmailVerifier interface {
+	CheckEmailVerified(ctx context.Context, userID int) error
+}
+
+func (u *userCase) CheckEmailVerified(ctx context.Context, userID int) error {
+	_, verified, err := u.repo.GetEmailVerification(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("check email verified: %w", err)
+	}
+	if !verified {
+		return &ErrEmailNotVerified{}
+	}
+	return nil
+}
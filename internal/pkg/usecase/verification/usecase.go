@@ -0,0 +1,116 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tokenRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/token"
+	userRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/crypto"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/mail"
+)
+
+const (
+	VerificationTokenLifeTime = 24 * time.Hour
+	lenVerificationToken      = 32
+)
+
+var (
+	ErrInvalidVerificationToken = errors.New("invalid or expired email verification token")
+	ErrEmailAlreadyVerified     = errors.New("the email has already been verified")
+)
+
+//go:generate mockgen -destination=./mock/verification_mock.go -package=mock -source=usecase.go Usecase
+type Usecase interface {
+	SendVerification(ctx context.Context, userID int) error
+	ConfirmEmail(ctx context.Context, token string) (int, error)
+}
+
+type verificationCase struct {
+	log       *logger.Logger
+	userRepo  userRepo.Repository
+	tokens    tokenRepo.Repository
+	sender    mail.Sender
+	verifyURL string
+}
+
+// New creates an email verification usecase, verifyURL is the page of the client
+// to which the verification token is appended as a query parameter.
+func New(log *logger.Logger, userRepo userRepo.Repository, tokens tokenRepo.Repository,
+	sender mail.Sender, verifyURL string) *verificationCase {
+	return &verificationCase{
+		log:       log,
+		userRepo:  userRepo,
+		tokens:    tokens,
+		sender:    sender,
+		verifyURL: verifyURL,
+	}
+}
+
+func (v *verificationCase) SendVerification(ctx context.Context, userID int) error {
+	email, verified, err := v.userRepo.GetEmailVerification(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("send email verification: %w", err)
+	}
+	if verified {
+		return ErrEmailAlreadyVerified
+	}
+
+	token, err := crypto.NewRandomString(lenVerificationToken)
+	if err != nil {
+		return fmt.Errorf("generate email verification token: %w", err)
+	}
+
+	// the email is bound to the token, so that the token does not verify
+	// the email the user has changed it to, the user id in the link
+	// is used to find the email when the token is confirmed
+	err = v.tokens.AddToken(ctx, email+":"+token, userID, VerificationTokenLifeTime)
+	if err != nil {
+		return fmt.Errorf("send email verification: %w", err)
+	}
+
+	err = v.sender.Send(ctx, mail.Message{
+		To:      email,
+		Subject: "Pinspire email verification",
+		Body: fmt.Sprintf("To confirm your email follow the link: %s?token=%s\nThe link is valid for %d hours.",
+			v.verifyURL, strconv.Itoa(userID)+"."+token, int(VerificationTokenLifeTime.Hours())),
+	})
+	if err != nil {
+		return fmt.Errorf("send email verification: %w", err)
+	}
+	return nil
+}
+
+func (v *verificationCase) ConfirmEmail(ctx context.Context, token string) (int, error) {
+	userIDPart, token, found := strings.Cut(token, ".")
+	userID, err := strconv.Atoi(userIDPart)
+	if !found || err != nil {
+		return 0, ErrInvalidVerificationToken
+	}
+
+	email, verified, err := v.userRepo.GetEmailVerification(ctx, userID)
+	if err != nil {
+		return 0, ErrInvalidVerificationToken
+	}
+	if verified {
+		return 0, ErrEmailAlreadyVerified
+	}
+
+	_, err = v.tokens.TakeUserIDByToken(ctx, email+":"+token)
+	if errors.Is(err, tokenRepo.ErrNotFoundToken) {
+		return 0, ErrInvalidVerificationToken
+	}
+	if err != nil {
+		return 0, fmt.Errorf("confirm email: %w", err)
+	}
+
+	if err = v.userRepo.SetEmailVerified(ctx, userID, email); err != nil {
+		return 0, fmt.Errorf("confirm email: %w", err)
+	}
+	return userID, nil
+}
@@ -0,0 +1,97 @@
+package verification
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tokenRepo "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/token"
+	tokenMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/token/mock"
+	userMock "github.com/go-park-mail-ru/2023_2_OND_team/internal/pkg/repository/user/mock"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/mail"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type senderStub struct {
+	sent []mail.Message
+}
+
+func (s *senderStub) Send(_ context.Context, msg mail.Message) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func TestSendVerification(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users := userMock.NewMockRepository(ctrl)
+	tokens := tokenMock.NewMockRepository(ctrl)
+	sender := &senderStub{}
+	verifyURL := "https://pinspire.online/email/verify"
+	vc := New(log, users, tokens, sender, verifyURL)
+
+	expEmail := "clickkk@gmail.com"
+	var token string
+	users.EXPECT().GetEmailVerification(ctx, 12).Return(expEmail, false, nil).Times(1)
+	tokens.EXPECT().
+		AddToken(ctx, gomock.Any(), 12, VerificationTokenLifeTime).
+		DoAndReturn(func(_ context.Context, t string, _ int, _ interface{}) error {
+			token = strings.TrimPrefix(t, expEmail+":")
+			return nil
+		}).
+		Times(1)
+
+	require.NoError(t, vc.SendVerification(ctx, 12))
+	require.Len(t, sender.sent, 1)
+	require.Equal(t, expEmail, sender.sent[0].To)
+	require.Contains(t, sender.sent[0].Body, verifyURL+"?token=12."+token)
+
+	users.EXPECT().GetEmailVerification(ctx, 12).Return(expEmail, true, nil).Times(1)
+
+	require.ErrorIs(t, vc.SendVerification(ctx, 12), ErrEmailAlreadyVerified)
+	require.Len(t, sender.sent, 1)
+}
+
+func TestConfirmEmail(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log, err := logger.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users := userMock.NewMockRepository(ctrl)
+	tokens := tokenMock.NewMockRepository(ctrl)
+	vc := New(log, users, tokens, &senderStub{}, "")
+
+	expEmail := "clickkk@gmail.com"
+	users.EXPECT().GetEmailVerification(ctx, 12).Return(expEmail, false, nil).Times(1)
+	tokens.EXPECT().TakeUserIDByToken(ctx, expEmail+":valid").Return(12, nil).Times(1)
+	users.EXPECT().SetEmailVerified(ctx, 12, expEmail).Return(nil).Times(1)
+
+	userID, err := vc.ConfirmEmail(ctx, "12.valid")
+	require.NoError(t, err)
+	require.Equal(t, 12, userID)
+
+	users.EXPECT().GetEmailVerification(ctx, 12).Return("changed@gmail.com", false, nil).Times(1)
+	tokens.EXPECT().TakeUserIDByToken(ctx, "changed@gmail.com:valid").Return(0, tokenRepo.ErrNotFoundToken).Times(1)
+
+	_, err = vc.ConfirmEmail(ctx, "12.valid")
+	require.ErrorIs(t, err, ErrInvalidVerificationToken)
+
+	_, err = vc.ConfirmEmail(ctx, "malformed")
+	require.ErrorIs(t, err, ErrInvalidVerificationToken)
+}
@@ -25,6 +25,19 @@ func NewRandomString(length int) (string, error) {
 	return *(*string)(unsafe.Pointer(&res)), nil
 }
 
+// LegacyArgon2Params are the parameters of the hashes made by PasswordHash,
+// the salt is the string passed as is.
+var LegacyArgon2Params = Argon2Params{
+	Time:    3,
+	Memory:  32 * 1024,
+	Threads: 4,
+	SaltLen: 16,
+	KeyLen:  32,
+}
+
+// PasswordHash returns the hash in the legacy format without the parameters.
+//
+// Deprecated: use EncodePasswordHash, PasswordHash is kept to verify the old hashes.
 func PasswordHash(password, salt string, length int) string {
 	if length <= 0 {
 		return ""
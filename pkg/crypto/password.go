@@ -0,0 +1,95 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+var ErrInvalidPasswordHash = errors.New("invalid encoded password hash")
+
+// Argon2Params are the parameters of argon2id, Memory is measured in KiB.
+type Argon2Params struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+	SaltLen uint32
+	KeyLen  uint32
+}
+
+// DefaultArgon2Params follow the recommendation of RFC 9106 for memory constrained environments.
+var DefaultArgon2Params = Argon2Params{
+	Time:    3,
+	Memory:  64 * 1024,
+	Threads: 4,
+	SaltLen: 16,
+	KeyLen:  32,
+}
+
+// StrongerThan reports whether hashing with p costs more than with other in any parameter.
+func (p Argon2Params) StrongerThan(other Argon2Params) bool {
+	return p.Time > other.Time || p.Memory > other.Memory || p.Threads > other.Threads ||
+		p.SaltLen > other.SaltLen || p.KeyLen > other.KeyLen
+}
+
+// EncodePasswordHash hashes the password with a random salt and returns the PHC string
+// $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<hash>.
+func EncodePasswordHash(password string, p Argon2Params) (string, error) {
+	salt := make([]byte, p.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generate salt: %w", err)
+	}
+
+	hash := argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, p.KeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, p.Memory, p.Time, p.Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(hash)), nil
+}
+
+// IsEncodedPasswordHash reports whether the hash is in the PHC format of EncodePasswordHash.
+func IsEncodedPasswordHash(hash string) bool {
+	return strings.HasPrefix(hash, "$argon2id$")
+}
+
+// ComparePasswordHash checks the password against the hash returned by EncodePasswordHash
+// and returns the parameters with which the hash was made.
+func ComparePasswordHash(encoded, password string) (bool, Argon2Params, error) {
+	p, salt, hash, err := decodePasswordHash(encoded)
+	if err != nil {
+		return false, Argon2Params{}, err
+	}
+
+	actual := argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, p.KeyLen)
+	return subtle.ConstantTimeCompare(actual, hash) == 1, p, nil
+}
+
+func decodePasswordHash(encoded string) (p Argon2Params, salt, hash []byte, err error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return p, nil, nil, ErrInvalidPasswordHash
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, ErrInvalidPasswordHash
+	}
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Time, &p.Threads); err != nil {
+		return p, nil, nil, ErrInvalidPasswordHash
+	}
+	if p.Time == 0 || p.Threads == 0 {
+		return p, nil, nil, ErrInvalidPasswordHash
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return p, nil, nil, ErrInvalidPasswordHash
+	}
+	if hash, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(hash) == 0 {
+		return p, nil, nil, ErrInvalidPasswordHash
+	}
+	p.SaltLen, p.KeyLen = uint32(len(salt)), uint32(len(hash))
+	return p, salt, hash, nil
+}
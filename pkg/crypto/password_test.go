@@ -0,0 +1,42 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComparePasswordHash(t *testing.T) {
+	params := Argon2Params{Time: 1, Memory: 1024, Threads: 2, SaltLen: 8, KeyLen: 16}
+	hash, err := EncodePasswordHash("password", params)
+	require.NoError(t, err)
+	require.True(t, IsEncodedPasswordHash(hash))
+	require.Regexp(t, `^\$argon2id\$v=19\$m=1024,t=1,p=2\$[^$]+\$[^$]+$`, hash)
+
+	correct, actualParams, err := ComparePasswordHash(hash, "password")
+	require.NoError(t, err)
+	require.True(t, correct)
+	require.Equal(t, params, actualParams)
+
+	correct, _, err = ComparePasswordHash(hash, "passwort")
+	require.NoError(t, err)
+	require.False(t, correct)
+
+	for _, invalid := range []string{
+		"",
+		"0123456789abcdef0123",
+		"$argon2i$v=19$m=1024,t=1,p=2$c2FsdA$aGFzaA",
+		"$argon2id$v=16$m=1024,t=1,p=2$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=1024,t=0,p=2$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=1024,t=1,p=2$c2FsdA$",
+	} {
+		_, _, err = ComparePasswordHash(invalid, "password")
+		require.ErrorIs(t, err, ErrInvalidPasswordHash, invalid)
+	}
+}
+
+func TestStrongerThan(t *testing.T) {
+	require.True(t, DefaultArgon2Params.StrongerThan(LegacyArgon2Params))
+	require.False(t, LegacyArgon2Params.StrongerThan(DefaultArgon2Params))
+	require.False(t, DefaultArgon2Params.StrongerThan(DefaultArgon2Params))
+}
@@ -0,0 +1,318 @@
+// Package linkpreview fetches a web page and extracts its preview from
+// the Open Graph and Twitter Card meta tags. The fetcher only connects
+// to public addresses, the checks are made on the resolved address of
+// every connection, so redirects and DNS rebinding do not bypass them.
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	defaultTimeout      = 5 * time.Second
+	defaultMaxBodySize  = 1 << 20
+	defaultMaxRedirects = 3
+
+	maxTitleLen       = 300
+	maxDescriptionLen = 1000
+	maxURLLen         = 2048
+
+	userAgent = "PinspireBot/1.0 (+link preview)"
+)
+
+var (
+	ErrBadURL           = errors.New("url must be absolute with the http or https scheme")
+	ErrForbiddenAddress = errors.New("connection to a non-public address is forbidden")
+	ErrTooManyRedirects = errors.New("too many redirects")
+	ErrNotHTML          = errors.New("response is not an html page")
+	ErrNoPreview        = errors.New("page has no preview data")
+)
+
+// Preview is the page metadata displayed with the link.
+type Preview struct {
+	URL         string
+	Title       string
+	Description string
+	Image       string
+}
+
+type Fetcher struct {
+	client       *http.Client
+	timeout      time.Duration
+	maxBodySize  int64
+	maxRedirects int
+	// isAllowedIP reports whether the fetcher may connect to the address
+	isAllowedIP func(net.IP) bool
+}
+
+func New(opts ...Option) *Fetcher {
+	f := &Fetcher{
+		timeout:      defaultTimeout,
+		maxBodySize:  defaultMaxBodySize,
+		maxRedirects: defaultMaxRedirects,
+		isAllowedIP:  IsPublicIP,
+	}
+	for _, opt := range opts {
+		opt.apply(f)
+	}
+
+	dialer := &net.Dialer{
+		Timeout: f.timeout,
+		Control: f.controlConn,
+	}
+	f.client = &http.Client{
+		Timeout: f.timeout,
+		Transport: &http.Transport{
+			// the proxy from the environment would connect on behalf of the fetcher
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   f.timeout,
+			ResponseHeaderTimeout: f.timeout,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+		CheckRedirect: f.checkRedirect,
+	}
+	return f
+}
+
+// Fetch downloads the page by rawURL and parses its preview. Only the first
+// bytes of the page up to the body size limit are read.
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (Preview, error) {
+	pageURL, err := ParseURL(rawURL)
+	if err != nil {
+		return Preview{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL.String(), nil)
+	if err != nil {
+		return Preview{}, fmt.Errorf("new request for link preview: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return Preview{}, fmt.Errorf("fetch page for link preview: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Preview{}, fmt.Errorf("fetch page for link preview: unexpected status %d", resp.StatusCode)
+	}
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || (mediaType != "text/html" && mediaType != "application/xhtml+xml") {
+		return Preview{}, ErrNotHTML
+	}
+
+	preview := Parse(io.LimitReader(resp.Body, f.maxBodySize), resp.Request.URL)
+	if preview.Title == "" && preview.Description == "" && preview.Image == "" {
+		return Preview{}, ErrNoPreview
+	}
+	preview.URL = pageURL.String()
+	return preview, nil
+}
+
+// ParseURL parses rawURL and checks that it can be fetched.
+func ParseURL(rawURL string) (*url.URL, error) {
+	if len(rawURL) > maxURLLen {
+		return nil, ErrBadURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil {
+		return nil, ErrBadURL
+	}
+	return u, nil
+}
+
+func (f *Fetcher) controlConn(network, address string, _ syscall.RawConn) error {
+	if network != "tcp4" && network != "tcp6" {
+		return ErrForbiddenAddress
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return ErrForbiddenAddress
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !f.isAllowedIP(ip) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+func (f *Fetcher) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) > f.maxRedirects {
+		return ErrTooManyRedirects
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return ErrBadURL
+	}
+	return nil
+}
+
+var nonPublicNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"),
+	mustParseCIDR("192.0.0.0/24"),
+	mustParseCIDR("198.18.0.0/15"),
+	mustParseCIDR("240.0.0.0/4"),
+	mustParseCIDR("64:ff9b::/96"),
+}
+
+// IsPublicIP reports whether ip is a globally routable unicast address.
+func IsPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+// Parse extracts the preview from the head of the html page. The Open Graph
+// tags take precedence over the Twitter Card tags and the title element.
+// The relative image url is resolved against base.
+func Parse(r io.Reader, base *url.URL) Preview {
+	var (
+		og, twitter Preview
+		title       string
+		inTitle     bool
+	)
+
+	z := html.NewTokenizer(r)
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return buildPreview(og, twitter, title, base)
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			switch tok.DataAtom {
+			case atom.Body:
+				return buildPreview(og, twitter, title, base)
+			case atom.Title:
+				inTitle = tt == html.StartTagToken
+			case atom.Meta:
+				key, content := metaAttrs(tok)
+				switch key {
+				case "og:title":
+					og.Title = content
+				case "og:description":
+					og.Description = content
+				case "og:image", "og:image:url", "og:image:secure_url":
+					if og.Image == "" {
+						og.Image = content
+					}
+				case "twitter:title":
+					twitter.Title = content
+				case "twitter:description":
+					twitter.Description = content
+				case "twitter:image", "twitter:image:src":
+					if twitter.Image == "" {
+						twitter.Image = content
+					}
+				case "description":
+					if twitter.Description == "" {
+						twitter.Description = content
+					}
+				}
+			}
+		case html.EndTagToken:
+			tok := z.Token()
+			switch tok.DataAtom {
+			case atom.Head:
+				return buildPreview(og, twitter, title, base)
+			case atom.Title:
+				inTitle = false
+			}
+		case html.TextToken:
+			if inTitle && title == "" {
+				title = string(z.Text())
+			}
+		}
+	}
+}
+
+func metaAttrs(tok html.Token) (key, content string) {
+	for _, attr := range tok.Attr {
+		switch attr.Key {
+		case "property", "name":
+			if key == "" {
+				key = strings.ToLower(strings.TrimSpace(attr.Val))
+			}
+		case "content":
+			content = attr.Val
+		}
+	}
+	return key, content
+}
+
+func buildPreview(og, twitter Preview, title string, base *url.URL) Preview {
+	preview := Preview{
+		Title:       firstNonEmpty(og.Title, twitter.Title, title),
+		Description: firstNonEmpty(og.Description, twitter.Description),
+	}
+	preview.Title = truncate(preview.Title, maxTitleLen)
+	preview.Description = truncate(preview.Description, maxDescriptionLen)
+
+	image := firstNonEmpty(og.Image, twitter.Image)
+	if image == "" {
+		return preview
+	}
+	imageURL, err := url.Parse(image)
+	if err != nil {
+		return preview
+	}
+	if base != nil {
+		imageURL = base.ResolveReference(imageURL)
+	}
+	if _, err := ParseURL(imageURL.String()); err == nil {
+		preview.Image = imageURL.String()
+	}
+	return preview
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// truncate cuts s to at most n runes dropping the invalid utf8 sequences.
+func truncate(s string, n int) string {
+	s = strings.ToValidUTF8(strings.Join(strings.Fields(s), " "), "")
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
@@ -0,0 +1,34 @@
+package linkpreview
+
+import "time"
+
+type Option interface {
+	apply(*Fetcher)
+}
+
+type funcOption func(*Fetcher)
+
+func (f funcOption) apply(fetcher *Fetcher) {
+	f(fetcher)
+}
+
+// Timeout sets the limit for the whole fetch including the redirects.
+func Timeout(d time.Duration) Option {
+	return funcOption(func(f *Fetcher) {
+		f.timeout = d
+	})
+}
+
+// MaxBodySize sets the number of the page bytes read to find the preview.
+func MaxBodySize(n int64) Option {
+	return funcOption(func(f *Fetcher) {
+		f.maxBodySize = n
+	})
+}
+
+// MaxRedirects sets the number of the redirects followed.
+func MaxRedirects(n int) Option {
+	return funcOption(func(f *Fetcher) {
+		f.maxRedirects = n
+	})
+}
@@ -0,0 +1,59 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_OND_team/pkg/logger"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+type logSender struct {
+	log *logger.Logger
+}
+
+// NewLogSender returns a sender which writes emails to the log instead of delivering them.
+func NewLogSender(log *logger.Logger) *logSender {
+	return &logSender{log}
+}
+
+func (s *logSender) Send(_ context.Context, msg Message) error {
+	s.log.Info("send email", logger.F{"to", msg.To}, logger.F{"subject", msg.Subject}, logger.F{"body", msg.Body})
+	return nil
+}
+
+type fileSender struct {
+	dir string
+}
+
+// NewFileSender returns a sender which saves every email as a separate file in the directory.
+func NewFileSender(dir string) (*fileSender, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("new file sender: %w", err)
+	}
+	return &fileSender{dir}, nil
+}
+
+func (s *fileSender) Send(_ context.Context, msg Message) error {
+	name := strconv.FormatInt(time.Now().UnixNano(), 10) + "_" + strings.ReplaceAll(msg.To, "/", "_") + ".eml"
+
+	content := fmt.Sprintf("To: %s\r\nSubject: %s\r\n\r\n%s\r\n", msg.To, msg.Subject, msg.Body)
+	if err := os.WriteFile(filepath.Join(s.dir, name), []byte(content), 0o644); err != nil {
+		return fmt.Errorf("save email to file: %w", err)
+	}
+	return nil
+}
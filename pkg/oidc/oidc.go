@@ -0,0 +1,157 @@
+// Package oidc implements the relying party of the OpenID Connect authorization code flow
+// with PKCE as described in OpenID Connect Core 1.0 and RFC 7636.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const pathDiscovery = "/.well-known/openid-configuration"
+
+var ErrInvalidIDToken = errors.New("invalid id token")
+
+// Config describes the client registered at the provider. The endpoints
+// are discovered from the issuer, the scope openid is always requested.
+type Config struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// Claims are the claims of the verified id token which identify the user.
+type Claims struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type Provider struct {
+	cfg    Config
+	client *http.Client
+	now    func() time.Time
+
+	m      sync.Mutex
+	meta   *metadata
+	oauth2 *oauth2.Config
+	keys   *keySet
+}
+
+// NewProvider does not make requests, the provider metadata is discovered on the first use.
+func NewProvider(cfg Config, client *http.Client) *Provider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	cfg.Issuer = strings.TrimSuffix(cfg.Issuer, "/")
+	return &Provider{
+		cfg:    cfg,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+// NewVerifier returns a random PKCE code verifier.
+func NewVerifier() string {
+	return oauth2.GenerateVerifier()
+}
+
+// AuthCodeURL returns the url of the provider to which the user is redirected to log in.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	cfg, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return cfg.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier), oauth2.SetAuthURLParam("nonce", nonce)), nil
+}
+
+// Exchange redeems the authorization code and returns the claims of the id token
+// after the signature, issuer, audience, expiry and nonce are verified.
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (*Claims, error) {
+	cfg, keys, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := cfg.Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.client), code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("exchange authorization code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("%w: missing in the token response", ErrInvalidIDToken)
+	}
+	return p.verify(ctx, keys, rawIDToken, nonce)
+}
+
+func (p *Provider) discover(ctx context.Context) (*oauth2.Config, *keySet, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	if p.meta != nil {
+		return p.oauth2, p.keys, nil
+	}
+
+	meta := &metadata{}
+	if err := p.getJSON(ctx, p.cfg.Issuer+pathDiscovery, meta); err != nil {
+		return nil, nil, fmt.Errorf("discover provider %s: %w", p.cfg.Name, err)
+	}
+	if strings.TrimSuffix(meta.Issuer, "/") != p.cfg.Issuer {
+		return nil, nil, fmt.Errorf("discover provider %s: issuer %q does not match", p.cfg.Name, meta.Issuer)
+	}
+
+	p.meta = meta
+	p.oauth2 = &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       append([]string{"openid"}, p.cfg.Scopes...),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  meta.AuthorizationEndpoint,
+			TokenURL: meta.TokenEndpoint,
+		},
+	}
+	p.keys = &keySet{uri: meta.JWKSURI, fetch: p.getJSON}
+	return p.oauth2, p.keys, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s: unexpected status %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,187 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+const (
+	testClientID = "pinspire"
+	testKeyID    = "key-1"
+)
+
+// stubIdP is a local provider which issues an id token for every valid code.
+type stubIdP struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]any
+	// challenges are the PKCE challenges of the issued codes
+	challenges map[string]string
+}
+
+func newStubIdP(t *testing.T) *stubIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	idp := &stubIdP{t: t, key: key, challenges: make(map[string]string)}
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathDiscovery, idp.discovery)
+	mux.HandleFunc("/jwks", idp.jwks)
+	mux.HandleFunc("/token", idp.token)
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	idp.claims = map[string]any{
+		"iss":            idp.server.URL,
+		"sub":            "10769150350006150715113082367",
+		"aud":            testClientID,
+		"email":          "green@example.com",
+		"email_verified": true,
+	}
+	return idp
+}
+
+// authorize imitates the login of the user at the provider and returns the code.
+func (idp *stubIdP) authorize(authURL string) string {
+	u, err := url.Parse(authURL)
+	require.NoError(idp.t, err)
+	require.Equal(idp.t, "S256", u.Query().Get("code_challenge_method"))
+
+	code := "code-" + u.Query().Get("state")
+	idp.challenges[code] = u.Query().Get("code_challenge")
+	idp.claims["nonce"] = u.Query().Get("nonce")
+	return code
+}
+
+func (idp *stubIdP) discovery(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(metadata{
+		Issuer:                idp.server.URL,
+		AuthorizationEndpoint: idp.server.URL + "/authorize",
+		TokenEndpoint:         idp.server.URL + "/token",
+		JWKSURI:               idp.server.URL + "/jwks",
+	})
+}
+
+func (idp *stubIdP) jwks(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]any{"keys": []jwk{{
+		Kty: "RSA",
+		Kid: testKeyID,
+		N:   base64.RawURLEncoding.EncodeToString(idp.key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(idp.key.E)).Bytes()),
+	}}})
+}
+
+func (idp *stubIdP) token(w http.ResponseWriter, r *http.Request) {
+	challenge, ok := idp.challenges[r.FormValue("code")]
+	if !ok || oauth2.S256ChallengeFromVerifier(r.FormValue("code_verifier")) != challenge {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+	delete(idp.challenges, r.FormValue("code"))
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"id_token":     idp.sign(),
+	})
+}
+
+func (idp *stubIdP) sign() string {
+	if _, ok := idp.claims["exp"]; !ok {
+		idp.claims["exp"] = time.Now().Add(time.Hour).Unix()
+		idp.claims["iat"] = time.Now().Unix()
+	}
+
+	h, err := json.Marshal(header{Alg: "RS256", Kid: testKeyID})
+	require.NoError(idp.t, err)
+	c, err := json.Marshal(idp.claims)
+	require.NoError(idp.t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, idp.key, crypto.SHA256, digest[:])
+	require.NoError(idp.t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestExchange(t *testing.T) {
+	ctx := context.Background()
+	idp := newStubIdP(t)
+	provider := NewProvider(Config{
+		Name:        "stub",
+		Issuer:      idp.server.URL,
+		ClientID:    testClientID,
+		RedirectURL: "https://pinspire.online/oauth/callback",
+		Scopes:      []string{"email"},
+	}, idp.server.Client())
+
+	verifier := NewVerifier()
+	authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", verifier)
+	require.NoError(t, err)
+
+	claims, err := provider.Exchange(ctx, idp.authorize(authURL), verifier, "nonce")
+	require.NoError(t, err)
+	require.Equal(t, &Claims{
+		Subject:       "10769150350006150715113082367",
+		Email:         "green@example.com",
+		EmailVerified: true,
+	}, claims)
+
+	authURL, err = provider.AuthCodeURL(ctx, "state", "nonce", verifier)
+	require.NoError(t, err)
+	_, err = provider.Exchange(ctx, idp.authorize(authURL), NewVerifier(), "nonce")
+	require.Error(t, err)
+}
+
+func TestExchangeInvalidIDToken(t *testing.T) {
+	ctx := context.Background()
+	idp := newStubIdP(t)
+	provider := NewProvider(Config{Name: "stub", Issuer: idp.server.URL, ClientID: testClientID}, idp.server.Client())
+
+	tests := []struct {
+		name   string
+		nonce  string
+		modify func(claims map[string]any)
+	}{
+		{"wrong nonce", "other", func(map[string]any) {}},
+		{"wrong audience", "nonce", func(c map[string]any) { c["aud"] = []string{"other"} }},
+		{"wrong issuer", "nonce", func(c map[string]any) { c["iss"] = "https://evil.example.com" }},
+		{"expired", "nonce", func(c map[string]any) {
+			c["exp"] = time.Now().Add(-time.Hour).Unix()
+			c["iat"] = time.Now().Add(-2 * time.Hour).Unix()
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verifier := NewVerifier()
+			authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", verifier)
+			require.NoError(t, err)
+			code := idp.authorize(authURL)
+			test.modify(idp.claims)
+
+			_, err = provider.Exchange(ctx, code, verifier, test.nonce)
+			require.ErrorIs(t, err, ErrInvalidIDToken)
+
+			idp.claims["aud"], idp.claims["iss"] = testClientID, idp.server.URL
+			delete(idp.claims, "exp")
+		})
+	}
+}
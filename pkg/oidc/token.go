@@ -0,0 +1,197 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+)
+
+// leeway is the allowed clock skew between the provider and the service.
+const leeway = time.Minute
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type idTokenClaims struct {
+	Issuer            string   `json:"iss"`
+	Subject           string   `json:"sub"`
+	Audience          audience `json:"aud"`
+	Expiry            int64    `json:"exp"`
+	IssuedAt          int64    `json:"iat"`
+	Nonce             string   `json:"nonce"`
+	Email             string   `json:"email"`
+	EmailVerified     flexBool `json:"email_verified"`
+	Name              string   `json:"name"`
+	PreferredUsername string   `json:"preferred_username"`
+}
+
+// audience is either a string or an array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	return json.Unmarshal(b, (*[]string)(a))
+}
+
+func (a audience) contains(clientID string) bool {
+	for _, aud := range a {
+		if aud == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// flexBool is a boolean which some providers send as a string.
+type flexBool bool
+
+func (f *flexBool) UnmarshalJSON(b []byte) error {
+	switch string(b) {
+	case "true", `"true"`:
+		*f = true
+	default:
+		*f = false
+	}
+	return nil
+}
+
+func (p *Provider) verify(ctx context.Context, keys *keySet, rawIDToken, nonce string) (*Claims, error) {
+	parts := strings.Split(rawIDToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed jwt", ErrInvalidIDToken)
+	}
+
+	h := header{}
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, fmt.Errorf("%w: decode header: %s", ErrInvalidIDToken, err.Error())
+	}
+	if h.Alg != "RS256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm %s", ErrInvalidIDToken, h.Alg)
+	}
+
+	key, err := keys.get(ctx, h.Kid)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode signature: %s", ErrInvalidIDToken, err.Error())
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("%w: wrong signature", ErrInvalidIDToken)
+	}
+
+	c := idTokenClaims{}
+	if err = decodeSegment(parts[1], &c); err != nil {
+		return nil, fmt.Errorf("%w: decode claims: %s", ErrInvalidIDToken, err.Error())
+	}
+
+	now := p.now()
+	switch {
+	case strings.TrimSuffix(c.Issuer, "/") != p.cfg.Issuer:
+		return nil, fmt.Errorf("%w: unexpected issuer %s", ErrInvalidIDToken, c.Issuer)
+	case !c.Audience.contains(p.cfg.ClientID):
+		return nil, fmt.Errorf("%w: the client is not in the audience", ErrInvalidIDToken)
+	case now.After(time.Unix(c.Expiry, 0).Add(leeway)):
+		return nil, fmt.Errorf("%w: expired", ErrInvalidIDToken)
+	case now.Before(time.Unix(c.IssuedAt, 0).Add(-leeway)):
+		return nil, fmt.Errorf("%w: issued in the future", ErrInvalidIDToken)
+	case c.Nonce != nonce:
+		return nil, fmt.Errorf("%w: nonce does not match", ErrInvalidIDToken)
+	case c.Subject == "":
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+
+	return &Claims{
+		Subject:           c.Subject,
+		Email:             c.Email,
+		EmailVerified:     bool(c.EmailVerified),
+		Name:              c.Name,
+		PreferredUsername: c.PreferredUsername,
+	}, nil
+}
+
+func decodeSegment(seg string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// keySet caches the signing keys of the provider, they are fetched again
+// when the token is signed with an unknown key after the key rotation.
+type keySet struct {
+	uri   string
+	fetch func(ctx context.Context, url string, v any) error
+
+	m    sync.Mutex
+	keys map[string]*rsa.PublicKey
+}
+
+func (k *keySet) get(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	k.m.Lock()
+	defer k.m.Unlock()
+
+	if key, ok := k.keys[kid]; ok {
+		return key, nil
+	}
+	if err := k.refresh(ctx); err != nil {
+		return nil, err
+	}
+	if key, ok := k.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidIDToken, kid)
+}
+
+func (k *keySet) refresh(ctx context.Context) error {
+	set := struct {
+		Keys []jwk `json:"keys"`
+	}{}
+	if err := k.fetch(ctx, k.uri, &set); err != nil {
+		return fmt.Errorf("fetch provider keys: %w", err)
+	}
+
+	k.keys = make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, key := range set.Keys {
+		if key.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			continue
+		}
+		k.keys[key.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// test vectors from RFC 6238 appendix B for SHA1, truncated to 6 digits
+func TestCode(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+	tests := []struct {
+		unix    int64
+		expCode string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, test := range tests {
+		code, err := Code(secret, time.Unix(test.unix, 0))
+		require.NoError(t, err)
+		require.Equal(t, test.expCode, code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := Code(secret, now)
+	require.NoError(t, err)
+
+	require.True(t, Validate(secret, code, now))
+	require.True(t, Validate(secret, code, now.Add(Period)))
+	require.False(t, Validate(secret, code, now.Add(3*Period)))
+	require.False(t, Validate(secret, "12345", now))
+	require.False(t, Validate("not base32!", code, now))
+}
+
+func TestStep(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	code, err := Code(secret, now)
+	require.NoError(t, err)
+
+	counter := now.Unix() / int64(Period.Seconds())
+	step, ok := Step(secret, code, now)
+	require.True(t, ok)
+	require.Equal(t, counter, step)
+
+	// the step is the one of the code, not of the moment of the check
+	step, ok = Step(secret, code, now.Add(Period))
+	require.True(t, ok)
+	require.Equal(t, counter, step)
+
+	_, ok = Step(secret, code, now.Add(3*Period))
+	require.False(t, ok)
+}